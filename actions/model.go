@@ -65,6 +65,9 @@ type Processor struct {
 	// PreSpanMetrics indicates the processor must run on the node collector
 	// before span metrics when span metrics are enabled.
 	PreSpanMetrics bool `yaml:"preSpanMetrics"`
+	// Signals limits the collector pipelines the processor is added to
+	// (e.g. [TRACES]). Empty means all the signals of the action.
+	Signals []string `yaml:"signals"`
 }
 
 type Field struct {
@@ -54,6 +54,8 @@ type ActionSpecApplyConfiguration struct {
 	DbQueryTemplatization *actions.DbQueryTemplatizationConfig `json:"dbQueryTemplatization,omitempty"`
 	// InferDbAttributes is the config for the InferDbAttributes Action.
 	InferDbAttributes *actions.InferDbAttributesConfig `json:"inferDbAttributes,omitempty"`
+	// BaggageAttributes is the config for the BaggageAttributes Action.
+	BaggageAttributes *actions.BaggageAttributesConfig `json:"baggageAttributes,omitempty"`
 }
 
 // ActionSpecApplyConfiguration constructs a declarative configuration of the ActionSpec type for use with
@@ -175,3 +177,11 @@ func (b *ActionSpecApplyConfiguration) WithInferDbAttributes(value actions.Infer
 	b.InferDbAttributes = &value
 	return b
 }
+
+// WithBaggageAttributes sets the BaggageAttributes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BaggageAttributes field is set to the value of the last call.
+func (b *ActionSpecApplyConfiguration) WithBaggageAttributes(value actions.BaggageAttributesConfig) *ActionSpecApplyConfiguration {
+	b.BaggageAttributes = &value
+	return b
+}
@@ -58,6 +58,11 @@ type ContainerAgentConfigApplyConfiguration struct {
 	Logs    *agentsignalconfig.AgentLogsConfig    `json:"logs,omitempty"`
 	// Configure the log level for the agent itselg
 	AgentDiagnostics *instrumentationrules.AgentDiagnostics `json:"agentDiagnostics,omitempty"`
+	// OpenTelemetry SDK settings for the agent, taken from the container override of the source.
+	Sdk *agentsignalconfig.AgentSdkConfig `json:"sdk,omitempty"`
+	// The agent version channel to serve the agent files of this container from.
+	// empty means the stable channel, which is the agents version of the installed odigos.
+	AgentVersionChannel *common.AgentVersionChannel `json:"agentVersionChannel,omitempty"`
 }
 
 // ContainerAgentConfigApplyConfiguration constructs a declarative configuration of the ContainerAgentConfig type for use with
@@ -167,3 +172,19 @@ func (b *ContainerAgentConfigApplyConfiguration) WithAgentDiagnostics(value inst
 	b.AgentDiagnostics = &value
 	return b
 }
+
+// WithSdk sets the Sdk field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Sdk field is set to the value of the last call.
+func (b *ContainerAgentConfigApplyConfiguration) WithSdk(value agentsignalconfig.AgentSdkConfig) *ContainerAgentConfigApplyConfiguration {
+	b.Sdk = &value
+	return b
+}
+
+// WithAgentVersionChannel sets the AgentVersionChannel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AgentVersionChannel field is set to the value of the last call.
+func (b *ContainerAgentConfigApplyConfiguration) WithAgentVersionChannel(value common.AgentVersionChannel) *ContainerAgentConfigApplyConfiguration {
+	b.AgentVersionChannel = &value
+	return b
+}
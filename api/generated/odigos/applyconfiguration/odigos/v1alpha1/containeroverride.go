@@ -17,6 +17,10 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	agentsignalconfig "github.com/odigos-io/odigos/common/api/agentsignalconfig"
+)
+
 // ContainerOverrideApplyConfiguration represents a declarative configuration of the ContainerOverride type for use
 // with apply.
 type ContainerOverrideApplyConfiguration struct {
@@ -37,6 +41,10 @@ type ContainerOverrideApplyConfiguration struct {
 	// empty means take odigos global config for this setting.
 	// when not empty, the value will override the global config for this container
 	AllowConcurrentAgents *bool `json:"allowConcurrentAgents,omitempty"`
+	// OpenTelemetry SDK settings for the agent in this container,
+	// for pods where containers need different settings (e.g. propagators of an app next to an envoy sidecar).
+	// Agents which read them from environment variables receive them when their pods are restarted.
+	Sdk *agentsignalconfig.AgentSdkConfig `json:"sdk,omitempty"`
 }
 
 // ContainerOverrideApplyConfiguration constructs a declarative configuration of the ContainerOverride type for use with
@@ -76,3 +84,11 @@ func (b *ContainerOverrideApplyConfiguration) WithAllowConcurrentAgents(value bo
 	b.AllowConcurrentAgents = &value
 	return b
 }
+
+// WithSdk sets the Sdk field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Sdk field is set to the value of the last call.
+func (b *ContainerOverrideApplyConfiguration) WithSdk(value agentsignalconfig.AgentSdkConfig) *ContainerOverrideApplyConfiguration {
+	b.Sdk = &value
+	return b
+}
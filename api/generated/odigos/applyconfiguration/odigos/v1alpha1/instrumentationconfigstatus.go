@@ -40,6 +40,11 @@ type InstrumentationConfigStatusApplyConfiguration struct {
 	InstrumentationTime *metav1.Time `json:"instrumentationTime,omitempty"`
 	// Represents the status of odigos MANIFEST injection to existing pods template.
 	PodsManifestInjectionStatus *PodsManifestInjectionStatusApplyConfiguration `json:"podsManifestInjectionStatus,omitempty"`
+	// The effective agents version of each container with an enabled agent.
+	AgentVersions []ContainerAgentVersionApplyConfiguration `json:"agentVersions,omitempty"`
+	// The cpu and memory overhead of the agents, measured by comparing the usage of the containers
+	// before and after the instrumentation time. Only recorded when agent overhead accounting is enabled.
+	AgentOverhead *AgentOverheadApplyConfiguration `json:"agentOverhead,omitempty"`
 }
 
 // InstrumentationConfigStatusApplyConfiguration constructs a declarative configuration of the InstrumentationConfigStatus type for use with
@@ -105,3 +110,24 @@ func (b *InstrumentationConfigStatusApplyConfiguration) WithPodsManifestInjectio
 	b.PodsManifestInjectionStatus = value
 	return b
 }
+
+// WithAgentVersions adds the given value to the AgentVersions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AgentVersions field.
+func (b *InstrumentationConfigStatusApplyConfiguration) WithAgentVersions(values ...*ContainerAgentVersionApplyConfiguration) *InstrumentationConfigStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAgentVersions")
+		}
+		b.AgentVersions = append(b.AgentVersions, *values[i])
+	}
+	return b
+}
+
+// WithAgentOverhead sets the AgentOverhead field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AgentOverhead field is set to the value of the last call.
+func (b *InstrumentationConfigStatusApplyConfiguration) WithAgentOverhead(value *AgentOverheadApplyConfiguration) *InstrumentationConfigStatusApplyConfiguration {
+	b.AgentOverhead = value
+	return b
+}
@@ -61,10 +61,16 @@ type InstrumentationRuleSpecApplyConfiguration struct {
 	EbpfLogCapture *instrumentationrules.EbpfLogCapture `json:"ebpfLogCapture,omitempty"`
 	// Configure network flow and TCP stats metrics for scoped workloads.
 	NetworkMetrics *instrumentationrules.NetworkMetricsConfig `json:"networkMetrics,omitempty"`
+	// Configure continuous profiling (profile types and sampling frequency) for scoped workloads.
+	Profiling *instrumentationrules.Profiling `json:"profiling,omitempty"`
 	// Configure the verbosity of the traces for the library.
 	TraceVerbosity *instrumentationrules.TraceVerbosity `json:"traceVerbosity,omitempty"`
 	// Configure the agent own logging configuration.
 	AgentDiagnostics *instrumentationrules.AgentDiagnostics `json:"agentDiagnostics,omitempty"`
+	// Pin the agents version injected into the scoped workloads to an agent version channel.
+	AgentVersion *instrumentationrules.AgentVersion `json:"agentVersion,omitempty"`
+	// Set the context propagators used by the agents of the scoped workloads.
+	ContextPropagation *instrumentationrules.ContextPropagation `json:"contextPropagation,omitempty"`
 }
 
 // InstrumentationRuleSpecApplyConfiguration constructs a declarative configuration of the InstrumentationRuleSpec type for use with
@@ -197,6 +203,14 @@ func (b *InstrumentationRuleSpecApplyConfiguration) WithNetworkMetrics(value ins
 	return b
 }
 
+// WithProfiling sets the Profiling field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Profiling field is set to the value of the last call.
+func (b *InstrumentationRuleSpecApplyConfiguration) WithProfiling(value instrumentationrules.Profiling) *InstrumentationRuleSpecApplyConfiguration {
+	b.Profiling = &value
+	return b
+}
+
 // WithTraceVerbosity sets the TraceVerbosity field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the TraceVerbosity field is set to the value of the last call.
@@ -212,3 +226,19 @@ func (b *InstrumentationRuleSpecApplyConfiguration) WithAgentDiagnostics(value i
 	b.AgentDiagnostics = &value
 	return b
 }
+
+// WithAgentVersion sets the AgentVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AgentVersion field is set to the value of the last call.
+func (b *InstrumentationRuleSpecApplyConfiguration) WithAgentVersion(value instrumentationrules.AgentVersion) *InstrumentationRuleSpecApplyConfiguration {
+	b.AgentVersion = &value
+	return b
+}
+
+// WithContextPropagation sets the ContextPropagation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ContextPropagation field is set to the value of the last call.
+func (b *InstrumentationRuleSpecApplyConfiguration) WithContextPropagation(value instrumentationrules.ContextPropagation) *InstrumentationRuleSpecApplyConfiguration {
+	b.ContextPropagation = &value
+	return b
+}
@@ -34,6 +34,15 @@ type OdigosOwnMetricsSettingsApplyConfiguration struct {
 	SendToOdigosMetricsStore *bool `json:"sendToOdigosMetricsStore,omitempty"`
 	// time interval for flusing metrics (format: 15s, 1m etc). defaults: 10s
 	Interval *string `json:"interval,omitempty"`
+	// if true, the span metrics calculated by the collector are also sent to the odigos metrics store,
+	// where odigos evaluates them for latency, error rate and throughput anomalies.
+	SendSpanMetricsToOdigosMetricsStore *bool `json:"sendSpanMetricsToOdigosMetricsStore,omitempty"`
+	// if true, the container cpu and memory usage of all the workloads, collected by the kubelet stats receiver,
+	// is also sent to the odigos metrics store, where odigos measures the overhead of the agents.
+	SendWorkloadsKubeletStatsToOdigosMetricsStore *bool `json:"sendWorkloadsKubeletStatsToOdigosMetricsStore,omitempty"`
+	// if true, the cpu and memory runtime metrics the agents report about their own process
+	// are also sent to the odigos metrics store, where odigos measures the overhead of the agents.
+	SendAgentRuntimeMetricsToOdigosMetricsStore *bool `json:"sendAgentRuntimeMetricsToOdigosMetricsStore,omitempty"`
 }
 
 // OdigosOwnMetricsSettingsApplyConfiguration constructs a declarative configuration of the OdigosOwnMetricsSettings type for use with
@@ -65,3 +74,27 @@ func (b *OdigosOwnMetricsSettingsApplyConfiguration) WithInterval(value string)
 	b.Interval = &value
 	return b
 }
+
+// WithSendSpanMetricsToOdigosMetricsStore sets the SendSpanMetricsToOdigosMetricsStore field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SendSpanMetricsToOdigosMetricsStore field is set to the value of the last call.
+func (b *OdigosOwnMetricsSettingsApplyConfiguration) WithSendSpanMetricsToOdigosMetricsStore(value bool) *OdigosOwnMetricsSettingsApplyConfiguration {
+	b.SendSpanMetricsToOdigosMetricsStore = &value
+	return b
+}
+
+// WithSendWorkloadsKubeletStatsToOdigosMetricsStore sets the SendWorkloadsKubeletStatsToOdigosMetricsStore field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SendWorkloadsKubeletStatsToOdigosMetricsStore field is set to the value of the last call.
+func (b *OdigosOwnMetricsSettingsApplyConfiguration) WithSendWorkloadsKubeletStatsToOdigosMetricsStore(value bool) *OdigosOwnMetricsSettingsApplyConfiguration {
+	b.SendWorkloadsKubeletStatsToOdigosMetricsStore = &value
+	return b
+}
+
+// WithSendAgentRuntimeMetricsToOdigosMetricsStore sets the SendAgentRuntimeMetricsToOdigosMetricsStore field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SendAgentRuntimeMetricsToOdigosMetricsStore field is set to the value of the last call.
+func (b *OdigosOwnMetricsSettingsApplyConfiguration) WithSendAgentRuntimeMetricsToOdigosMetricsStore(value bool) *OdigosOwnMetricsSettingsApplyConfiguration {
+	b.SendAgentRuntimeMetricsToOdigosMetricsStore = &value
+	return b
+}
@@ -37,6 +37,8 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &odigosv1alpha1.ActionSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ActionStatus"):
 		return &odigosv1alpha1.ActionStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AgentOverhead"):
+		return &odigosv1alpha1.AgentOverheadApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Attribute"):
 		return &odigosv1alpha1.AttributeApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CollectorsGroup"):
@@ -53,6 +55,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &odigosv1alpha1.CollectorsGroupTraceCorrelationsSettingsApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ContainerAgentConfig"):
 		return &odigosv1alpha1.ContainerAgentConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ContainerAgentOverhead"):
+		return &odigosv1alpha1.ContainerAgentOverheadApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ContainerAgentVersion"):
+		return &odigosv1alpha1.ContainerAgentVersionApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ContainerOverride"):
 		return &odigosv1alpha1.ContainerOverrideApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("CostReductionRule"):
@@ -119,6 +125,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &odigosv1alpha1.SamplingSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SamplingStatus"):
 		return &odigosv1alpha1.SamplingStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ServiceLevelObjective"):
+		return &odigosv1alpha1.ServiceLevelObjectiveApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ServiceLevelObjectiveBurnRate"):
+		return &odigosv1alpha1.ServiceLevelObjectiveBurnRateApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ServiceLevelObjectiveSpec"):
+		return &odigosv1alpha1.ServiceLevelObjectiveSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ServiceLevelObjectiveStatus"):
+		return &odigosv1alpha1.ServiceLevelObjectiveStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Source"):
 		return &odigosv1alpha1.SourceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("SourceSelector"):
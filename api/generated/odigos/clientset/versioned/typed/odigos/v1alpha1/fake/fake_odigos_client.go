@@ -63,6 +63,10 @@ func (c *FakeOdigosV1alpha1) Samplings(namespace string) v1alpha1.SamplingInterf
 	return newFakeSamplings(c, namespace)
 }
 
+func (c *FakeOdigosV1alpha1) ServiceLevelObjectives(namespace string) v1alpha1.ServiceLevelObjectiveInterface {
+	return newFakeServiceLevelObjectives(c, namespace)
+}
+
 func (c *FakeOdigosV1alpha1) Sources(namespace string) v1alpha1.SourceInterface {
 	return newFakeSources(c, namespace)
 }
@@ -35,4 +35,6 @@ type RecommendationExpansion interface{}
 
 type SamplingExpansion interface{}
 
+type ServiceLevelObjectiveExpansion interface{}
+
 type SourceExpansion interface{}
@@ -36,6 +36,7 @@ type OdigosV1alpha1Interface interface {
 	ProcessorsGetter
 	RecommendationsGetter
 	SamplingsGetter
+	ServiceLevelObjectivesGetter
 	SourcesGetter
 }
 
@@ -80,6 +81,10 @@ func (c *OdigosV1alpha1Client) Samplings(namespace string) SamplingInterface {
 	return newSamplings(c, namespace)
 }
 
+func (c *OdigosV1alpha1Client) ServiceLevelObjectives(namespace string) ServiceLevelObjectiveInterface {
+	return newServiceLevelObjectives(c, namespace)
+}
+
 func (c *OdigosV1alpha1Client) Sources(namespace string) SourceInterface {
 	return newSources(c, namespace)
 }
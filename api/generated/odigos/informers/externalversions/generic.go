@@ -70,6 +70,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Odigos().V1alpha1().Recommendations().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("samplings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Odigos().V1alpha1().Samplings().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("servicelevelobjectives"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Odigos().V1alpha1().ServiceLevelObjectives().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("sources"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Odigos().V1alpha1().Sources().Informer()}, nil
 
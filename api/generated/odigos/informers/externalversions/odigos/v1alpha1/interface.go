@@ -41,6 +41,8 @@ type Interface interface {
 	Recommendations() RecommendationInformer
 	// Samplings returns a SamplingInformer.
 	Samplings() SamplingInformer
+	// ServiceLevelObjectives returns a ServiceLevelObjectiveInformer.
+	ServiceLevelObjectives() ServiceLevelObjectiveInformer
 	// Sources returns a SourceInformer.
 	Sources() SourceInformer
 }
@@ -101,6 +103,11 @@ func (v *version) Samplings() SamplingInformer {
 	return &samplingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ServiceLevelObjectives returns a ServiceLevelObjectiveInformer.
+func (v *version) ServiceLevelObjectives() ServiceLevelObjectiveInformer {
+	return &serviceLevelObjectiveInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Sources returns a SourceInformer.
 func (v *version) Sources() SourceInformer {
 	return &sourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -89,6 +89,14 @@ type SamplingListerExpansion interface{}
 // SamplingNamespaceLister.
 type SamplingNamespaceListerExpansion interface{}
 
+// ServiceLevelObjectiveListerExpansion allows custom methods to be added to
+// ServiceLevelObjectiveLister.
+type ServiceLevelObjectiveListerExpansion interface{}
+
+// ServiceLevelObjectiveNamespaceListerExpansion allows custom methods to be added to
+// ServiceLevelObjectiveNamespaceLister.
+type ServiceLevelObjectiveNamespaceListerExpansion interface{}
+
 // SourceListerExpansion allows custom methods to be added to
 // SourceLister.
 type SourceListerExpansion interface{}
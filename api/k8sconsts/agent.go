@@ -3,10 +3,22 @@ package k8sconsts
 const (
 	OdigosAgentsDirectory          = "/var/odigos"
 	OdigletContainerAgentDirectory = "/instrumentations"
-	OdigosAgentMountVolumeName     = "odigos-agent"
+	// directory in the odiglet init container where the agents of non-stable agent version channels are staged
+	OdigletContainerAgentChannelsDirectory = "/agent-channels"
+	OdigosAgentMountVolumeName             = "odigos-agent"
 
 	OdigosOpampExchangeDir    = "/var/odigos/exchange"
 	OdigosOpampUnixSocketPath = "/var/odigos/exchange/exchange.sock"
 
 	OpampUnixSocketEnvName = "ODIGOS_OPAMP_UNIX_SOCKET"
+
+	// ConfigMap in the odigos namespace with user-defined OtelDistribution yaml documents, one per data key.
+	OdigosUserDefinedDistrosConfigMapName = "odigos-otel-distributions"
+	// The agent files of a user-defined distribution are mounted from a pod volume with this prefix and the distribution name,
+	// which is also the name of the init container copying them when they come from an image.
+	OdigosUserDefinedAgentVolumeNamePrefix = "odigos-agent-"
+
+	// The agents of a non-stable agent version channel are copied by an init container, or mounted from an image volume,
+	// named with this prefix and the channel name.
+	OdigosAgentVersionChannelNamePrefix = "odigos-agents-"
 )
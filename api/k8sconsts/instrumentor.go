@@ -21,6 +21,13 @@ const (
 	InstrumentorSourceValidatingWebhookName = "odigos-source-validating-webhook-configuration"
 	InstrumentorContainerName               = "manager"
 
+	// the port of the instrumentor webhook server, which also serves the injection preview
+	InstrumentorWebhookPort = 9443
+	// the path of the pods webhook dry-run, which returns the patch the webhook would apply to a workload's pods
+	InstrumentorInjectionPreviewPath = "/preview--v1-pod"
+	// the path of the pre-flight compatibility report of the workloads in a namespace
+	InstrumentorCompatibilityReportPath = "/compatibility-report"
+
 	InstrumentorWebhookSecretName = "instrumentor-webhooks-cert"
 	InstrumentorWebhookVolumeName = "instrumentor-webhooks-cert"
 
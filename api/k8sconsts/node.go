@@ -3,6 +3,7 @@ package k8sconsts
 const (
 	NodeNameEnvVar = "NODE_NAME"
 	NodeIPEnvVar   = "NODE_IP"
+	PodIPEnvVar    = "POD_IP"
 
 	GKEAutopilotEnvVar = "GKE_AUTOPILOT"
 
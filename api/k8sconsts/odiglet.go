@@ -25,6 +25,11 @@ const (
 	OdigletInstalledLabelValue        = "true"
 	OdigletDefaultHealthProbeBindPort = 55683
 
+	// Odiglet serves on-demand runtime inspection of the pods on its node,
+	// used to report the compatibility of workloads before they are instrumented.
+	OdigletRuntimeInspectionPort = 55684
+	OdigletRuntimeInspectionPath = "/runtime-inspection"
+
 	// ConfigMap used to store custom/updated Go instrumentation offsets
 	GoOffsetsConfigMap   = "odigos-go-offsets"
 	GoOffsetsFileName    = "go_offset_results.json"
@@ -36,6 +36,12 @@ const (
 	// RollbackRecoveryAtAnnotation on the same IC, the recovery has been handled.
 	RollbackRecoveryProcessedAtAnnotation = "odigos.io/rollback-recovery-processed"
 
+	// AgentDebugUntilAnnotation is set on a single pod to temporarily put the agents running in it
+	// into debug mode (verbose SDK logging and 100% sampling).
+	// The value is an RFC3339 timestamp. Once it is in the past, the opamp server reverts the agents
+	// to their regular config, so the annotation does not need to be removed manually.
+	AgentDebugUntilAnnotation = "odigos.io/agent-debug-until"
+
 	// this label is not used in the api server, it is injected only into the controller-runtime cache object,
 	// and allows efficient listing of static pods based on the label.
 	OdigosVirtualStaticPodNameLabel = "odigos.io/virtual-static-pod-name"
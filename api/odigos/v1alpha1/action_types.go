@@ -68,6 +68,9 @@ type ActionSpec struct {
 
 	// InferDbAttributes is the config for the InferDbAttributes Action.
 	InferDbAttributes *actions.InferDbAttributesConfig `json:"inferDbAttributes,omitempty"`
+
+	// BaggageAttributes is the config for the BaggageAttributes Action.
+	BaggageAttributes *actions.BaggageAttributesConfig `json:"baggageAttributes,omitempty"`
 }
 
 type ActionStatus struct {
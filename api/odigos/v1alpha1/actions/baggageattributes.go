@@ -0,0 +1,38 @@
+package actions
+
+import (
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	actionsapi "github.com/odigos-io/odigos/common/api/actions"
+	"github.com/odigos-io/odigos/common/consts"
+)
+
+const ActionNameBaggageAttributes = "BaggageAttributes"
+
+// BaggageAttributesConfig is the action config for copying baggage entries onto the telemetry of sources.
+// Agents which support it record the entries on every span, metric measurement or log record of a request.
+// For other agents, the entries are parsed in the gateway collector from the recorded baggage request header,
+// so only spans which recorded the header (typically server spans) are enriched.
+//
+// +kubebuilder:object:generate=true
+// +kubebuilder:deepcopy-gen=true
+type BaggageAttributesConfig struct {
+	// the scope of services for which this config will be applied.
+	// if empty, the provided config will be applied to all sources.
+	Scopes *k8sconsts.SourcesScopes `json:"scopes,omitempty"`
+
+	actionsapi.BaggageAttributesConfig `json:",inline"`
+}
+
+func (BaggageAttributesConfig) ProcessorType() string {
+	return consts.OdigosBaggageAttributesProcessorType
+}
+
+func (BaggageAttributesConfig) OrderHint() int {
+	return 1
+}
+
+func (BaggageAttributesConfig) CollectorRoles() []k8sconsts.CollectorRole {
+	return []k8sconsts.CollectorRole{
+		k8sconsts.CollectorsRoleClusterGateway,
+	}
+}
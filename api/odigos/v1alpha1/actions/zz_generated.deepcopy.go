@@ -25,6 +25,27 @@ import (
 	apiactions "github.com/odigos-io/odigos/common/api/actions"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BaggageAttributesConfig) DeepCopyInto(out *BaggageAttributesConfig) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = new(k8sconsts.SourcesScopes)
+		(*in).DeepCopyInto(*out)
+	}
+	in.BaggageAttributesConfig.DeepCopyInto(&out.BaggageAttributesConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BaggageAttributesConfig.
+func (in *BaggageAttributesConfig) DeepCopy() *BaggageAttributesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BaggageAttributesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DbQueryTemplatizationConfig) DeepCopyInto(out *DbQueryTemplatizationConfig) {
 	*out = *in
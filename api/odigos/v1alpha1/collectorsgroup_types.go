@@ -101,6 +101,18 @@ type OdigosOwnMetricsSettings struct {
 
 	// time interval for flusing metrics (format: 15s, 1m etc). defaults: 10s
 	Interval string `json:"interval,omitempty"`
+
+	// if true, the span metrics calculated by the collector are also sent to the odigos metrics store,
+	// where odigos evaluates them for latency, error rate and throughput anomalies.
+	SendSpanMetricsToOdigosMetricsStore bool `json:"sendSpanMetricsToOdigosMetricsStore,omitempty"`
+
+	// if true, the container cpu and memory usage of all the workloads, collected by the kubelet stats receiver,
+	// is also sent to the odigos metrics store, where odigos measures the overhead of the agents.
+	SendWorkloadsKubeletStatsToOdigosMetricsStore bool `json:"sendWorkloadsKubeletStatsToOdigosMetricsStore,omitempty"`
+
+	// if true, the cpu and memory runtime metrics the agents report about their own process
+	// are also sent to the odigos metrics store, where odigos measures the overhead of the agents.
+	SendAgentRuntimeMetricsToOdigosMetricsStore bool `json:"sendAgentRuntimeMetricsToOdigosMetricsStore,omitempty"`
 }
 
 type AgentsTelemetrySettings struct {
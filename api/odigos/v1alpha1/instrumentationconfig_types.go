@@ -33,6 +33,9 @@ const (
 	// reports whether the workload associated with the InstrumentationConfig has been rolled out.
 	// the rollout is needed to update the instrumentation done by the Pods webhook.
 	WorkloadRolloutStatusConditionType = "WorkloadRollout"
+	// reports whether the context propagators configured for the containers are used by their agents.
+	// only set when propagators are configured for at least one container with an enabled agent.
+	ContextPropagationStatusConditionType = "ContextPropagation"
 )
 
 // +kubebuilder:validation:Enum=WorkloadSource;NamespaceSource;WorkloadSourceDisabled;NoSource;RetirableError
@@ -77,7 +80,18 @@ const (
 	RuntimeDetectionReasonError RuntimeDetectionReason = "Error"
 )
 
-// +kubebuilder:validation:Enum=EnabledSuccessfully;EnabledWithOtherAgents;WaitingForRuntimeInspection;WaitingForNodeCollector;IgnoredContainer;NoCollectedSignals;InjectionConflict;UnsupportedProgrammingLanguage;NoAvailableAgent;UnsupportedRuntimeVersion;MissingDistroParameter;OtherAgentDetected;RuntimeDetailsUnavailable;CrashLoopBackOff;ImagePullBackOff
+// +kubebuilder:validation:Enum=PropagatorsApplied;UnsupportedPropagators
+type ContextPropagationReason string
+
+const (
+	// all the containers with configured propagators use them.
+	ContextPropagationReasonPropagatorsApplied ContextPropagationReason = "PropagatorsApplied"
+	// some containers are instrumented by agents which cannot be configured with the propagators,
+	// e.g. eBPF agents, which only propagate the w3c trace context.
+	ContextPropagationReasonUnsupportedPropagators ContextPropagationReason = "UnsupportedPropagators"
+)
+
+// +kubebuilder:validation:Enum=EnabledSuccessfully;EnabledWithOtherAgents;WaitingForRuntimeInspection;WaitingForNodeCollector;IgnoredContainer;NoCollectedSignals;InjectionConflict;UnsupportedProgrammingLanguage;NoAvailableAgent;UnsupportedRuntimeVersion;MissingDistroParameter;OtherAgentDetected;RuntimeDetailsUnavailable;CrashLoopBackOff;ImagePullBackOff;RollbackFallbackToEbpf
 type AgentEnabledReason string
 
 const (
@@ -102,6 +116,9 @@ const (
 	// used for the rollback feature, when an application was instrumented and it caused an ImagePullBackOff
 	// We're marking it as that and rolling back the instrumentation
 	AgentEnabledReasonImagePullBackOff AgentEnabledReason = "ImagePullBackOff"
+	// used for the rollback feature, when the eBPF fallback is enabled.
+	// the language SDK agent is rolled back, and the container is instrumented with the generic eBPF distro instead.
+	AgentEnabledReasonRollbackFallbackToEbpf AgentEnabledReason = "RollbackFallbackToEbpf"
 )
 
 // Used to return that an agent should be disabled for a container.
@@ -139,6 +156,8 @@ func AgentInjectionReasonPriority(reason AgentEnabledReason) int {
 		return 0
 	case AgentEnabledReasonEnabledWithOtherAgents:
 		return 5
+	case AgentEnabledReasonRollbackFallbackToEbpf:
+		return 7
 	case AgentEnabledReasonRuntimeDetailsUnavailable:
 		return 10
 	case AgentEnabledReasonWaitingForRuntimeInspection:
@@ -255,6 +274,55 @@ type InstrumentationConfigStatus struct {
 
 	// Represents the status of odigos MANIFEST injection to existing pods template.
 	PodsManifestInjectionStatus *PodsManifestInjectionStatus `json:"podsManifestInjectionStatus,omitempty"`
+
+	// The effective agents version of each container with an enabled agent.
+	AgentVersions []ContainerAgentVersion `json:"agentVersions,omitempty"`
+
+	// The cpu and memory overhead of the agents, measured by comparing the usage of the containers
+	// before and after the instrumentation time. Only recorded when agent overhead accounting is enabled.
+	AgentOverhead *AgentOverhead `json:"agentOverhead,omitempty"`
+}
+
+// AgentOverhead is the result of measuring the resource overhead of the agents in a workload.
+type AgentOverhead struct {
+	// The instrumentation time the usage was compared around.
+	InstrumentationTime metav1.Time `json:"instrumentationTime"`
+	// The length of the windows before and after the instrumentation time the usage was averaged over.
+	Window string `json:"window"`
+	// The time the overhead was measured.
+	MeasurementTime metav1.Time `json:"measurementTime"`
+	// The usage of each container with an enabled agent. Containers without usage recorded in both windows are omitted.
+	Containers []ContainerAgentOverhead `json:"containers,omitempty"`
+	// Free text message explaining why some or all of the containers could not be measured.
+	Message string `json:"message,omitempty"`
+}
+
+// ContainerAgentOverhead is the average resource usage of a container, per pod, before and after its agent was enabled.
+type ContainerAgentOverhead struct {
+	ContainerName string `json:"containerName"`
+	// average cpu usage in millicores.
+	CPUMillicoresBefore int64 `json:"cpuMillicoresBefore"`
+	CPUMillicoresAfter  int64 `json:"cpuMillicoresAfter"`
+	// average memory usage in bytes.
+	MemoryBytesBefore int64 `json:"memoryBytesBefore"`
+	MemoryBytesAfter  int64 `json:"memoryBytesAfter"`
+	// average cpu usage in millicores of the instrumented process after its agent was enabled, as reported by the
+	// runtime metrics of the agent itself. Unlike the kubelet stats, it excludes the other processes of the container.
+	// Unset when the agent reports no runtime metrics.
+	// +optional
+	RuntimeCPUMillicoresAfter *int64 `json:"runtimeCpuMillicoresAfter,omitempty"`
+	// average memory used in bytes by the instrumented process after its agent was enabled, as reported by the
+	// runtime metrics of the agent itself. Unset when the agent reports no runtime metrics.
+	// +optional
+	RuntimeMemoryBytesAfter *int64 `json:"runtimeMemoryBytesAfter,omitempty"`
+}
+
+// ContainerAgentVersion reports the agents version used for a container, and the channel it was selected from.
+type ContainerAgentVersion struct {
+	ContainerName string                     `json:"containerName"`
+	Channel       common.AgentVersionChannel `json:"channel"`
+	// the odigos version of the agents, empty if unknown.
+	Version string `json:"version,omitempty"`
 }
 
 func (in *InstrumentationConfigStatus) GetRuntimeDetailsForContainer(container v1.Container) *RuntimeDetailsByContainer {
@@ -306,6 +374,13 @@ type ContainerAgentConfig struct {
 
 	// Configure the log level for the agent itselg
 	AgentDiagnostics *instrumentationrules.AgentDiagnostics `json:"agentDiagnostics,omitempty"`
+
+	// OpenTelemetry SDK settings for the agent, taken from the container override of the source.
+	Sdk *agentsignalconfig.AgentSdkConfig `json:"sdk,omitempty"`
+
+	// The agent version channel to serve the agent files of this container from.
+	// empty means the stable channel, which is the agents version of the installed odigos.
+	AgentVersionChannel common.AgentVersionChannel `json:"agentVersionChannel,omitempty"`
 }
 
 // Config for the OpenTelemeetry SDKs that should be applied to a workload.
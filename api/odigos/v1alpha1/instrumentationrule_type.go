@@ -95,11 +95,20 @@ type InstrumentationRuleSpec struct {
 	// Configure network flow and TCP stats metrics for scoped workloads.
 	NetworkMetrics *instrumentationrules.NetworkMetricsConfig `json:"networkMetrics,omitempty"`
 
+	// Configure continuous profiling (profile types and sampling frequency) for scoped workloads.
+	Profiling *instrumentationrules.Profiling `json:"profiling,omitempty"`
+
 	// Configure the verbosity of the traces for the library.
 	TraceVerbosity *instrumentationrules.TraceVerbosity `json:"traceVerbosity,omitempty"`
 
 	// Configure the agent own logging configuration.
 	AgentDiagnostics *instrumentationrules.AgentDiagnostics `json:"agentDiagnostics,omitempty"`
+
+	// Pin the agents version injected into the scoped workloads to an agent version channel.
+	AgentVersion *instrumentationrules.AgentVersion `json:"agentVersion,omitempty"`
+
+	// Set the context propagators used by the agents of the scoped workloads.
+	ContextPropagation *instrumentationrules.ContextPropagation `json:"contextPropagation,omitempty"`
 }
 
 // Verify validates the InstrumentationRuleSpec.
@@ -0,0 +1,168 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	commonapisampling "github.com/odigos-io/odigos/common/api/sampling"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:validation:Enum=Latency;Availability
+type ServiceLevelObjectiveType string
+
+const (
+	// ServiceLevelObjectiveTypeLatency counts a call as good when it completes within LatencyThresholdMs.
+	ServiceLevelObjectiveTypeLatency ServiceLevelObjectiveType = "Latency"
+	// ServiceLevelObjectiveTypeAvailability counts a call as good when it does not end with an error status.
+	ServiceLevelObjectiveTypeAvailability ServiceLevelObjectiveType = "Availability"
+)
+
+const (
+	// ServiceLevelObjectiveEvaluatedConditionType is true when the SLI and burn rates were computed from the span metrics.
+	ServiceLevelObjectiveEvaluatedConditionType = "Evaluated"
+	// ServiceLevelObjectiveFastBurnConditionType is true when the error budget burns at least 14.4 times
+	// faster than sustainable over both the last hour and the last 5 minutes.
+	ServiceLevelObjectiveFastBurnConditionType = "FastBurn"
+	// ServiceLevelObjectiveSlowBurnConditionType is true when the error budget burns at least 6 times
+	// faster than sustainable over both the last 6 hours and the last 30 minutes.
+	ServiceLevelObjectiveSlowBurnConditionType = "SlowBurn"
+)
+
+type ServiceLevelObjectiveReason string
+
+const (
+	ServiceLevelObjectiveReasonEvaluated               ServiceLevelObjectiveReason = "Evaluated"
+	ServiceLevelObjectiveReasonInvalidObjective        ServiceLevelObjectiveReason = "InvalidObjective"
+	ServiceLevelObjectiveReasonMetricsStoreUnavailable ServiceLevelObjectiveReason = "MetricsStoreUnavailable"
+	ServiceLevelObjectiveReasonNoData                  ServiceLevelObjectiveReason = "NoData"
+	ServiceLevelObjectiveReasonBurnRateExceeded        ServiceLevelObjectiveReason = "BurnRateExceeded"
+	ServiceLevelObjectiveReasonWithinBudget            ServiceLevelObjectiveReason = "WithinBudget"
+)
+
+// ServiceLevelObjectiveSpec defines the desired state of ServiceLevelObjective
+type ServiceLevelObjectiveSpec struct {
+	// Workload is the source whose server operations are measured.
+	// +kubebuilder:validation:Required
+	Workload k8sconsts.PodWorkload `json:"workload"`
+
+	// limit the objective to a specific operation of the source.
+	// only http server operations are measured, as these are the ones recorded in the span metrics.
+	// if not set, all the server operations of the source are measured together.
+	Operation *commonapisampling.TailSamplingOperationMatcher `json:"operation,omitempty"`
+
+	// Type of the objective, which decides what makes a call good.
+	// +kubebuilder:validation:Required
+	Type ServiceLevelObjectiveType `json:"type"`
+
+	// Target is the percentage of good calls the objective promises, e.g. 99.9.
+	// The error budget is the remaining percentage.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:ExclusiveMinimum=true
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:ExclusiveMaximum=true
+	// +kubebuilder:validation:Maximum=100
+	Target float64 `json:"target"`
+
+	// calls which take longer than this are bad calls.
+	// required for latency objectives, ignored for availability objectives.
+	// +kubebuilder:validation:Minimum=1
+	LatencyThresholdMs *int `json:"latencyThresholdMs,omitempty"`
+
+	// when set, traces of the operation which burn error budget (errors for availability objectives,
+	// calls slower than the threshold for latency objectives) are treated as highly relevant and always kept by tail sampling.
+	KeepBudgetBurningTraces bool `json:"keepBudgetBurningTraces,omitempty"`
+
+	// optional free-form text field that allows you to attach notes
+	// for future context and maintenance.
+	Notes string `json:"notes,omitempty"`
+}
+
+// ServiceLevelObjectiveBurnRate is the rate the error budget was consumed at over one window.
+// A burn rate of 1 consumes exactly the budget, higher values consume it faster.
+type ServiceLevelObjectiveBurnRate struct {
+	// Window is the look-back period of the burn rate, e.g. "1h".
+	Window string `json:"window"`
+	// BurnRate is the ratio of bad calls in the window divided by the error budget ratio.
+	BurnRate float64 `json:"burnRate"`
+}
+
+// ServiceLevelObjectiveStatus defines the observed state of ServiceLevelObjective
+type ServiceLevelObjectiveStatus struct {
+	// SLI is the percentage of good calls over the SLI window.
+	// +optional
+	SLI *float64 `json:"sli,omitempty"`
+
+	// SLIWindow is the period the SLI and the remaining error budget are computed over.
+	// It is bounded by the retention of the odigos metrics store.
+	// +optional
+	SLIWindow string `json:"sliWindow,omitempty"`
+
+	// ErrorBudgetRemaining is the percentage of the error budget left over the SLI window.
+	// It becomes negative once the budget is exhausted.
+	// +optional
+	ErrorBudgetRemaining *float64 `json:"errorBudgetRemaining,omitempty"`
+
+	// BurnRates over the short and long windows of the multi-window alerts.
+	// +optional
+	BurnRates []ServiceLevelObjectiveBurnRate `json:"burnRates,omitempty"`
+
+	// LastEvaluationTime is the time the values in the status were last written.
+	// They are refreshed every few minutes, and right away when a condition changes.
+	// +optional
+	LastEvaluationTime *metav1.Time `json:"lastEvaluationTime,omitempty"`
+
+	// Represents the observations of a service level objective's current state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+}
+
+//+genclient
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:shortName=slo
+//+kubebuilder:metadata:labels=odigos.io/system-object=true
+//+kubebuilder:printcolumn:name="Workload",type=string,JSONPath=`.spec.workload.name`
+//+kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+//+kubebuilder:printcolumn:name="Target",type=number,JSONPath=`.spec.target`
+//+kubebuilder:printcolumn:name="SLI",type=number,JSONPath=`.status.sli`
+
+// ServiceLevelObjective is the Schema for a latency or availability objective of a source operation.
+// Odigos computes the SLI and the burn rates of the objective from its span metrics.
+type ServiceLevelObjective struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceLevelObjectiveSpec   `json:"spec,omitempty"`
+	Status ServiceLevelObjectiveStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ServiceLevelObjectiveList contains a list of ServiceLevelObjective
+type ServiceLevelObjectiveList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceLevelObjective `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ServiceLevelObjective{}, &ServiceLevelObjectiveList{})
+}
@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/odigos-io/odigos/api/k8sconsts"
+	"github.com/odigos-io/odigos/common/api/agentsignalconfig"
 )
 
 var ErrorTooManySources = errors.New("too many Sources found for workload")
@@ -68,6 +69,13 @@ type ContainerOverride struct {
 	// empty means take odigos global config for this setting.
 	// when not empty, the value will override the global config for this container
 	AllowConcurrentAgents *bool `json:"allowConcurrentAgents,omitempty"`
+
+	// OpenTelemetry SDK settings for the agent in this container,
+	// for pods where containers need different settings (e.g. propagators of an app next to an envoy sidecar).
+	// Native agents receive them as environment variables, which apply once their pods are restarted,
+	// and agents connected over OpAMP also receive them in the sdk_config section of their remote config.
+	// eBPF agents apply the settings they support when the process is instrumented. Each field documents where it is not supported.
+	Sdk *agentsignalconfig.AgentSdkConfig `json:"sdk,omitempty"`
 }
 
 type SourceSpec struct {
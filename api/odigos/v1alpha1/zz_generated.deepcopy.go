@@ -151,6 +151,11 @@ func (in *ActionSpec) DeepCopyInto(out *ActionSpec) {
 		*out = new(actions.InferDbAttributesConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BaggageAttributes != nil {
+		in, out := &in.BaggageAttributes, &out.BaggageAttributes
+		*out = new(actions.BaggageAttributesConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActionSpec.
@@ -200,6 +205,30 @@ func (in *AgentDisabledInfo) DeepCopy() *AgentDisabledInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentOverhead) DeepCopyInto(out *AgentOverhead) {
+	*out = *in
+	in.InstrumentationTime.DeepCopyInto(&out.InstrumentationTime)
+	in.MeasurementTime.DeepCopyInto(&out.MeasurementTime)
+	if in.Containers != nil {
+		in, out := &in.Containers, &out.Containers
+		*out = make([]ContainerAgentOverhead, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentOverhead.
+func (in *AgentOverhead) DeepCopy() *AgentOverhead {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentOverhead)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentsTelemetrySettings) DeepCopyInto(out *AgentsTelemetrySettings) {
 	*out = *in
@@ -533,6 +562,11 @@ func (in *ContainerAgentConfig) DeepCopyInto(out *ContainerAgentConfig) {
 		*out = new(instrumentationrules.AgentDiagnostics)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Sdk != nil {
+		in, out := &in.Sdk, &out.Sdk
+		*out = new(agentsignalconfig.AgentSdkConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerAgentConfig.
@@ -545,6 +579,46 @@ func (in *ContainerAgentConfig) DeepCopy() *ContainerAgentConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerAgentOverhead) DeepCopyInto(out *ContainerAgentOverhead) {
+	*out = *in
+	if in.RuntimeCPUMillicoresAfter != nil {
+		in, out := &in.RuntimeCPUMillicoresAfter, &out.RuntimeCPUMillicoresAfter
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RuntimeMemoryBytesAfter != nil {
+		in, out := &in.RuntimeMemoryBytesAfter, &out.RuntimeMemoryBytesAfter
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerAgentOverhead.
+func (in *ContainerAgentOverhead) DeepCopy() *ContainerAgentOverhead {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerAgentOverhead)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerAgentVersion) DeepCopyInto(out *ContainerAgentVersion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerAgentVersion.
+func (in *ContainerAgentVersion) DeepCopy() *ContainerAgentVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerAgentVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerOverride) DeepCopyInto(out *ContainerOverride) {
 	*out = *in
@@ -563,6 +637,11 @@ func (in *ContainerOverride) DeepCopyInto(out *ContainerOverride) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Sdk != nil {
+		in, out := &in.Sdk, &out.Sdk
+		*out = new(agentsignalconfig.AgentSdkConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerOverride.
@@ -948,6 +1027,16 @@ func (in *InstrumentationConfigStatus) DeepCopyInto(out *InstrumentationConfigSt
 		*out = new(PodsManifestInjectionStatus)
 		**out = **in
 	}
+	if in.AgentVersions != nil {
+		in, out := &in.AgentVersions, &out.AgentVersions
+		*out = make([]ContainerAgentVersion, len(*in))
+		copy(*out, *in)
+	}
+	if in.AgentOverhead != nil {
+		in, out := &in.AgentOverhead, &out.AgentOverhead
+		*out = new(AgentOverhead)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstrumentationConfigStatus.
@@ -1310,6 +1399,11 @@ func (in *InstrumentationRuleSpec) DeepCopyInto(out *InstrumentationRuleSpec) {
 		*out = new(instrumentationrules.NetworkMetricsConfig)
 		**out = **in
 	}
+	if in.Profiling != nil {
+		in, out := &in.Profiling, &out.Profiling
+		*out = new(instrumentationrules.Profiling)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TraceVerbosity != nil {
 		in, out := &in.TraceVerbosity, &out.TraceVerbosity
 		*out = new(instrumentationrules.TraceVerbosity)
@@ -1320,6 +1414,16 @@ func (in *InstrumentationRuleSpec) DeepCopyInto(out *InstrumentationRuleSpec) {
 		*out = new(instrumentationrules.AgentDiagnostics)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AgentVersion != nil {
+		in, out := &in.AgentVersion, &out.AgentVersion
+		*out = new(instrumentationrules.AgentVersion)
+		**out = **in
+	}
+	if in.ContextPropagation != nil {
+		in, out := &in.ContextPropagation, &out.ContextPropagation
+		*out = new(instrumentationrules.ContextPropagation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstrumentationRuleSpec.
@@ -1809,6 +1913,147 @@ func (in *ServiceGraphSettings) DeepCopy() *ServiceGraphSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjective) DeepCopyInto(out *ServiceLevelObjective) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjective.
+func (in *ServiceLevelObjective) DeepCopy() *ServiceLevelObjective {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLevelObjective)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceLevelObjective) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjectiveBurnRate) DeepCopyInto(out *ServiceLevelObjectiveBurnRate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjectiveBurnRate.
+func (in *ServiceLevelObjectiveBurnRate) DeepCopy() *ServiceLevelObjectiveBurnRate {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLevelObjectiveBurnRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjectiveList) DeepCopyInto(out *ServiceLevelObjectiveList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceLevelObjective, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjectiveList.
+func (in *ServiceLevelObjectiveList) DeepCopy() *ServiceLevelObjectiveList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLevelObjectiveList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceLevelObjectiveList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjectiveSpec) DeepCopyInto(out *ServiceLevelObjectiveSpec) {
+	*out = *in
+	out.Workload = in.Workload
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(sampling.TailSamplingOperationMatcher)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LatencyThresholdMs != nil {
+		in, out := &in.LatencyThresholdMs, &out.LatencyThresholdMs
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjectiveSpec.
+func (in *ServiceLevelObjectiveSpec) DeepCopy() *ServiceLevelObjectiveSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLevelObjectiveSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceLevelObjectiveStatus) DeepCopyInto(out *ServiceLevelObjectiveStatus) {
+	*out = *in
+	if in.SLI != nil {
+		in, out := &in.SLI, &out.SLI
+		*out = new(float64)
+		**out = **in
+	}
+	if in.ErrorBudgetRemaining != nil {
+		in, out := &in.ErrorBudgetRemaining, &out.ErrorBudgetRemaining
+		*out = new(float64)
+		**out = **in
+	}
+	if in.BurnRates != nil {
+		in, out := &in.BurnRates, &out.BurnRates
+		*out = make([]ServiceLevelObjectiveBurnRate, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastEvaluationTime != nil {
+		in, out := &in.LastEvaluationTime, &out.LastEvaluationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLevelObjectiveStatus.
+func (in *ServiceLevelObjectiveStatus) DeepCopy() *ServiceLevelObjectiveStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceLevelObjectiveStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Source) DeepCopyInto(out *Source) {
 	*out = *in
@@ -46,6 +46,7 @@ var validActionConfigNames = []string{
 	actions.ActionNameExtractAttribute,
 	actions.ActionNameDbQueryTemplatization,
 	actions.ActionNameInferDbAttributes,
+	actions.ActionNameBaggageAttributes,
 }
 
 type ActionsValidator struct {
@@ -146,6 +147,10 @@ func (a *ActionsValidator) validateAction(ctx context.Context, action *v1alpha1.
 		path := field.NewPath("spec").Child("inferDbAttributes")
 		fields[path] = action.Spec.InferDbAttributes
 	}
+	if action.Spec.BaggageAttributes != nil {
+		path := field.NewPath("spec").Child("baggageAttributes")
+		fields[path] = action.Spec.BaggageAttributes
+	}
 
 	if len(fields) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("spec"), fmt.Sprintf("At least one of (%s) must be set", strings.Join(validActionConfigNames, ", "))))
@@ -208,6 +208,44 @@ func TestActionsValidator_ValidateCreate(t *testing.T) {
 			errorType:   field.ErrorTypeInvalid,
 			errorField:  "spec.addClusterInfo", // Should have multiple field errors
 		},
+		{
+			name: "valid BaggageAttributes action",
+			action: &odigosv1.Action{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-action",
+					Namespace: "odigos-system",
+				},
+				Spec: odigosv1.ActionSpec{
+					ActionName: "test-baggage-attributes",
+					Signals:    []common.ObservabilitySignal{common.TracesObservabilitySignal},
+					BaggageAttributes: &odigosactions.BaggageAttributesConfig{
+						BaggageAttributesConfig: actionsapi.BaggageAttributesConfig{
+							BaggageKeys: []string{"tenant_id"},
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "BaggageAttributes action with logs signal",
+			action: &odigosv1.Action{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-action",
+					Namespace: "odigos-system",
+				},
+				Spec: odigosv1.ActionSpec{
+					ActionName: "test-baggage-attributes",
+					Signals:    []common.ObservabilitySignal{common.TracesObservabilitySignal, common.LogsObservabilitySignal},
+					BaggageAttributes: &odigosactions.BaggageAttributesConfig{
+						BaggageAttributesConfig: actionsapi.BaggageAttributesConfig{
+							BaggageKeys: []string{"tenant_id"},
+						},
+					},
+				},
+			},
+			expectError: false,
+		},
 		{
 			name: "non-Action object",
 			action: &odigosv1.Action{
@@ -121,7 +121,7 @@ func TestE2E_ProfilesActions_ActionToRenderedConfig(t *testing.T) {
 	nodeCfg, err := config.MergeConfigs(map[string]config.Config{
 		"processors":                   nodeResults.ProcessorsConfig,
 		"common_application_telemetry": collectorconfig.CommonApplicationTelemetryConfig(&odigosv1.CollectorsGroup{}, false, "odigos-system", nil, common.OnPremOdigosTier),
-		"profiling":                    collectorconfig.ProfilingPipelineConfig("odigos-system", &common.ProfilingConfiguration{Enabled: &on}, nodeResults.ProfilesProcessors),
+		"profiling":                    collectorconfig.ProfilingPipelineConfig("odigos-system", &common.ProfilingConfiguration{Enabled: &on}, nil, nodeResults.ProfilesProcessors),
 	})
 	require.NoError(t, err)
 	assert.Equal(t, []string{
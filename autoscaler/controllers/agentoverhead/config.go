@@ -0,0 +1,37 @@
+package agentoverhead
+
+import (
+	"time"
+
+	"github.com/odigos-io/odigos/common"
+)
+
+const defaultMeasurementWindow = time.Hour
+
+// Config holds the resolved agent overhead accounting settings, with defaults applied.
+type Config struct {
+	MeasurementWindow time.Duration
+	// Retention of the odigos metrics store, the furthest back the usage before the instrumentation time can be read.
+	Retention time.Duration
+}
+
+// ConfigFromOdigosConfig resolves the agent overhead accounting settings from the effective config.
+// The second return value is false when agent overhead accounting is not active.
+func ConfigFromOdigosConfig(cfg *common.OdigosConfiguration) (Config, bool) {
+	if cfg == nil || !common.AgentOverheadAccountingActive(cfg.OdigosOwnTelemetryStore) {
+		return Config{}, false
+	}
+
+	retention := common.MetricsStoreRetention(cfg.OdigosOwnTelemetryStore)
+	window := defaultMeasurementWindow
+	if value := cfg.OdigosOwnTelemetryStore.AgentOverhead.MeasurementWindow; value != "" {
+		if d, err := time.ParseDuration(value); err == nil && d > 0 {
+			window = d
+		}
+	}
+	// the window before the instrumentation time has to be retained when the window after it completes
+	if maxWindow := (retention - rolloutSettleTime) / 2; window > maxWindow {
+		window = maxWindow
+	}
+	return Config{MeasurementWindow: window, Retention: retention}, true
+}
@@ -0,0 +1,181 @@
+package agentoverhead
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/k8sutils/pkg/metricsstore"
+	"github.com/odigos-io/odigos/k8sutils/pkg/workload"
+)
+
+// rolloutSettleTime is skipped after the instrumentation time, so the rollout of the instrumented pods
+// and the warm up of the agents are not counted as overhead.
+const rolloutSettleTime = 10 * time.Minute
+
+// usageQuerier returns the average usage of a container, per pod, over the window ending at end.
+type usageQuerier func(ctx context.Context, metricName string, pw k8sconsts.PodWorkload, containerName string, window time.Duration, end time.Time) (float64, error)
+
+// agentSpansQuerier returns true when the agent of a container reported spans in the window ending at end.
+type agentSpansQuerier func(ctx context.Context, pw k8sconsts.PodWorkload, containerName string, window time.Duration, end time.Time) (bool, error)
+
+// evaluator measures the overhead of the agents of an instrumented workload from the odigos metrics store.
+// The usage of each container is read from its kubelet stats, and the usage of the instrumented process
+// from the runtime metrics of its agent, when the agent reports them.
+// Containers whose agents already reported spans before the instrumentation time have no usage without an agent
+// to compare with, and are not measured.
+type evaluator struct {
+	queryUsage        usageQuerier
+	queryRuntimeUsage usageQuerier
+	queryAgentSpans   agentSpansQuerier
+}
+
+func newEvaluator(api v1.API) *evaluator {
+	return &evaluator{
+		queryUsage: func(ctx context.Context, metricName string, pw k8sconsts.PodWorkload, containerName string, window time.Duration, end time.Time) (float64, error) {
+			return queryUsage(ctx, api, metricName, pw, containerName, window, end)
+		},
+		queryRuntimeUsage: func(ctx context.Context, metricName string, pw k8sconsts.PodWorkload, containerName string, window time.Duration, end time.Time) (float64, error) {
+			return queryRuntimeUsage(ctx, api, metricName, pw, containerName, window, end)
+		},
+		queryAgentSpans: func(ctx context.Context, pw k8sconsts.PodWorkload, containerName string, window time.Duration, end time.Time) (bool, error) {
+			return queryAgentSpans(ctx, api, pw, containerName, window, end)
+		},
+	}
+}
+
+// measurementTime returns the time the overhead of the agents of the workload can be measured at,
+// which is when the window following its instrumentation time completes.
+// The second return value is false when there is nothing to measure.
+func measurementTime(ic *odigosv1.InstrumentationConfig, cfg Config) (time.Time, bool) {
+	instrumentationTime := ic.Status.InstrumentationTime
+	if instrumentationTime == nil {
+		return time.Time{}, false
+	}
+	// the first measurement is kept. The instrumentation time also moves on rollouts of agent changes,
+	// where the usage before it already includes the agent, and comparing it would overwrite the overhead with ~0.
+	if ic.Status.AgentOverhead != nil {
+		return time.Time{}, false
+	}
+	if len(agentEnabledContainers(ic)) == 0 {
+		return time.Time{}, false
+	}
+	return instrumentationTime.Add(rolloutSettleTime + cfg.MeasurementWindow), true
+}
+
+// measure returns the overhead of the agents of the workload at now,
+// or nil when it is already recorded or cannot be measured yet.
+func (e *evaluator) measure(ctx context.Context, ic *odigosv1.InstrumentationConfig, cfg Config, now time.Time) (*odigosv1.AgentOverhead, error) {
+	afterEnd, due := measurementTime(ic, cfg)
+	if !due || now.Before(afterEnd) {
+		return nil, nil
+	}
+	beforeEnd := ic.Status.InstrumentationTime.Time
+
+	overhead := &odigosv1.AgentOverhead{
+		InstrumentationTime: *ic.Status.InstrumentationTime.DeepCopy(),
+		Window:              metricsstore.PromDuration(cfg.MeasurementWindow),
+		MeasurementTime:     metav1.NewTime(now),
+	}
+	if beforeEnd.Add(-cfg.MeasurementWindow).Before(now.Add(-cfg.Retention)) {
+		overhead.Message = fmt.Sprintf("the usage before the instrumentation time is no longer retained by the metrics store, which keeps the last %s",
+			metricsstore.PromDuration(cfg.Retention))
+		return overhead, nil
+	}
+	pw, err := workload.ExtractWorkloadInfoFromRuntimeObjectName(ic.Name, ic.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := podNameSuffixes[pw.Kind]; !ok {
+		overhead.Message = fmt.Sprintf("the overhead of %s workloads cannot be measured, since their pods cannot be matched to their kubelet stats", pw.Kind)
+		return overhead, nil
+	}
+
+	var messages []string
+	for _, containerName := range agentEnabledContainers(ic) {
+		agentRunning, err := e.queryAgentSpans(ctx, pw, containerName, cfg.MeasurementWindow, beforeEnd)
+		if err != nil {
+			return nil, err
+		}
+		if agentRunning {
+			messages = append(messages, fmt.Sprintf("container %s: the agent already reported spans before the instrumentation time", containerName))
+			continue
+		}
+		containerOverhead, err := e.measureContainer(ctx, pw, containerName, cfg.MeasurementWindow, beforeEnd, afterEnd)
+		if errors.Is(err, errNoData) {
+			messages = append(messages, fmt.Sprintf("container %s: %s", containerName, err))
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		overhead.Containers = append(overhead.Containers, *containerOverhead)
+	}
+	overhead.Message = strings.Join(messages, "; ")
+	return overhead, nil
+}
+
+// measureContainer compares the usage of the container in the windows ending at beforeEnd and afterEnd,
+// and adds the usage of the instrumented process in the window ending at afterEnd, when its agent reports it.
+func (e *evaluator) measureContainer(ctx context.Context, pw k8sconsts.PodWorkload, containerName string, window time.Duration,
+	beforeEnd, afterEnd time.Time) (*odigosv1.ContainerAgentOverhead, error) {
+	var usage [4]float64
+	queries := []struct {
+		metricName string
+		end        time.Time
+		period     string
+	}{
+		{cpuUsageMetricName, beforeEnd, "before"},
+		{cpuUsageMetricName, afterEnd, "after"},
+		{memoryUsageMetricName, beforeEnd, "before"},
+		{memoryUsageMetricName, afterEnd, "after"},
+	}
+	for i, q := range queries {
+		value, err := e.queryUsage(ctx, q.metricName, pw, containerName, window, q.end)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s the instrumentation time", err, q.period)
+		}
+		usage[i] = value
+	}
+	containerOverhead := &odigosv1.ContainerAgentOverhead{
+		ContainerName:       containerName,
+		CPUMillicoresBefore: int64(math.Round(usage[0] * 1000)),
+		CPUMillicoresAfter:  int64(math.Round(usage[1] * 1000)),
+		MemoryBytesBefore:   int64(math.Round(usage[2])),
+		MemoryBytesAfter:    int64(math.Round(usage[3])),
+	}
+
+	// the runtime metrics are only reported once the agent runs, so there is no usage before to compare them with
+	cpuSeconds, err := e.queryRuntimeUsage(ctx, runtimeCPUTimeMetricName, pw, containerName, window, afterEnd)
+	if err == nil {
+		containerOverhead.RuntimeCPUMillicoresAfter = ptr.To(int64(math.Round(cpuSeconds * 1000)))
+	} else if !errors.Is(err, errNoData) {
+		return nil, err
+	}
+	memoryBytes, err := e.queryRuntimeUsage(ctx, runtimeMemoryUsedMetricName, pw, containerName, window, afterEnd)
+	if err == nil {
+		containerOverhead.RuntimeMemoryBytesAfter = ptr.To(int64(math.Round(memoryBytes)))
+	} else if !errors.Is(err, errNoData) {
+		return nil, err
+	}
+	return containerOverhead, nil
+}
+
+func agentEnabledContainers(ic *odigosv1.InstrumentationConfig) []string {
+	var names []string
+	for _, container := range ic.Spec.Containers {
+		if container.AgentEnabled {
+			names = append(names, container.ContainerName)
+		}
+	}
+	return names
+}
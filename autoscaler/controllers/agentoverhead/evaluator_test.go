@@ -0,0 +1,175 @@
+package agentoverhead
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+)
+
+var (
+	instrumentationTime = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	hourWindow          = Config{MeasurementWindow: time.Hour, Retention: 24 * time.Hour}
+)
+
+func instrumentedDeployment() *odigosv1.InstrumentationConfig {
+	return &odigosv1.InstrumentationConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "deployment-checkout", Namespace: "shop"},
+		Spec: odigosv1.InstrumentationConfigSpec{
+			Containers: []odigosv1.ContainerAgentConfig{
+				{ContainerName: "app", AgentEnabled: true},
+				{ContainerName: "sidecar", AgentEnabled: false},
+			},
+		},
+		Status: odigosv1.InstrumentationConfigStatus{
+			InstrumentationTime: &metav1.Time{Time: instrumentationTime},
+		},
+	}
+}
+
+// fakeUsage returns the usage of each container and metric, before or after the instrumentation time.
+func fakeUsage(values map[string][2]float64) usageQuerier {
+	return func(ctx context.Context, metricName string, pw k8sconsts.PodWorkload, containerName string, window time.Duration, end time.Time) (float64, error) {
+		value, ok := values[containerName+"/"+metricName]
+		if !ok {
+			return 0, errNoData
+		}
+		if end.After(instrumentationTime) {
+			return value[1], nil
+		}
+		return value[0], nil
+	}
+}
+
+// fakeAgentSpans reports spans of the given containers in every window.
+func fakeAgentSpans(containerNames ...string) agentSpansQuerier {
+	return func(ctx context.Context, pw k8sconsts.PodWorkload, containerName string, window time.Duration, end time.Time) (bool, error) {
+		return slices.Contains(containerNames, containerName), nil
+	}
+}
+
+func TestMeasure_ComparesUsageAroundInstrumentationTime(t *testing.T) {
+	e := &evaluator{queryUsage: fakeUsage(map[string][2]float64{
+		"app/" + cpuUsageMetricName:    {0.2, 0.25},
+		"app/" + memoryUsageMetricName: {200 << 20, 260 << 20},
+	}), queryRuntimeUsage: fakeUsage(nil), queryAgentSpans: fakeAgentSpans()}
+	now := instrumentationTime.Add(2 * time.Hour)
+
+	overhead, err := e.measure(context.Background(), instrumentedDeployment(), hourWindow, now)
+
+	require.NoError(t, err)
+	require.NotNil(t, overhead)
+	require.Equal(t, "1h", overhead.Window)
+	require.True(t, overhead.InstrumentationTime.Equal(&metav1.Time{Time: instrumentationTime}))
+	require.Empty(t, overhead.Message)
+	require.Equal(t, []odigosv1.ContainerAgentOverhead{{
+		ContainerName:       "app",
+		CPUMillicoresBefore: 200,
+		CPUMillicoresAfter:  250,
+		MemoryBytesBefore:   200 << 20,
+		MemoryBytesAfter:    260 << 20,
+	}}, overhead.Containers)
+}
+
+func TestMeasure_WaitsForWindowAfterInstrumentation(t *testing.T) {
+	e := &evaluator{queryUsage: fakeUsage(nil), queryRuntimeUsage: fakeUsage(nil), queryAgentSpans: fakeAgentSpans()}
+	now := instrumentationTime.Add(time.Hour)
+
+	overhead, err := e.measure(context.Background(), instrumentedDeployment(), hourWindow, now)
+
+	require.NoError(t, err)
+	require.Nil(t, overhead)
+}
+
+func TestMeasure_AlreadyRecorded(t *testing.T) {
+	e := &evaluator{queryUsage: fakeUsage(nil), queryRuntimeUsage: fakeUsage(nil), queryAgentSpans: fakeAgentSpans()}
+	ic := instrumentedDeployment()
+	ic.Status.AgentOverhead = &odigosv1.AgentOverhead{InstrumentationTime: metav1.Time{Time: instrumentationTime}}
+
+	overhead, err := e.measure(context.Background(), ic, hourWindow, instrumentationTime.Add(2*time.Hour))
+
+	require.NoError(t, err)
+	require.Nil(t, overhead)
+}
+
+func TestMeasure_KeepsFirstMeasurementAfterAgentRollout(t *testing.T) {
+	e := &evaluator{queryUsage: fakeUsage(nil), queryRuntimeUsage: fakeUsage(nil), queryAgentSpans: fakeAgentSpans()}
+	ic := instrumentedDeployment()
+	ic.Status.AgentOverhead = &odigosv1.AgentOverhead{InstrumentationTime: metav1.Time{Time: instrumentationTime.Add(-24 * time.Hour)}}
+
+	overhead, err := e.measure(context.Background(), ic, hourWindow, instrumentationTime.Add(2*time.Hour))
+
+	require.NoError(t, err)
+	require.Nil(t, overhead)
+}
+
+func TestMeasure_AgentRunningBeforeInstrumentationTime(t *testing.T) {
+	e := &evaluator{queryUsage: fakeUsage(map[string][2]float64{
+		"app/" + cpuUsageMetricName:    {0.25, 0.25},
+		"app/" + memoryUsageMetricName: {260 << 20, 260 << 20},
+	}), queryRuntimeUsage: fakeUsage(nil), queryAgentSpans: fakeAgentSpans("app")}
+
+	overhead, err := e.measure(context.Background(), instrumentedDeployment(), hourWindow, instrumentationTime.Add(2*time.Hour))
+
+	require.NoError(t, err)
+	require.NotNil(t, overhead)
+	require.Empty(t, overhead.Containers)
+	require.Equal(t, "container app: the agent already reported spans before the instrumentation time", overhead.Message)
+}
+
+func TestMeasure_MissingUsage(t *testing.T) {
+	e := &evaluator{queryUsage: fakeUsage(nil), queryRuntimeUsage: fakeUsage(nil), queryAgentSpans: fakeAgentSpans()}
+
+	overhead, err := e.measure(context.Background(), instrumentedDeployment(), hourWindow, instrumentationTime.Add(2*time.Hour))
+
+	require.NoError(t, err)
+	require.NotNil(t, overhead)
+	require.Empty(t, overhead.Containers)
+	require.Equal(t, "container app: no usage recorded before the instrumentation time", overhead.Message)
+}
+
+func TestMeasure_BeforeWindowExpired(t *testing.T) {
+	e := &evaluator{queryUsage: fakeUsage(nil), queryRuntimeUsage: fakeUsage(nil), queryAgentSpans: fakeAgentSpans()}
+
+	overhead, err := e.measure(context.Background(), instrumentedDeployment(), hourWindow, instrumentationTime.Add(48*time.Hour))
+
+	require.NoError(t, err)
+	require.NotNil(t, overhead)
+	require.Empty(t, overhead.Containers)
+	require.Contains(t, overhead.Message, "no longer retained")
+}
+
+func TestMeasure_AddsAgentRuntimeUsage(t *testing.T) {
+	e := &evaluator{queryUsage: fakeUsage(map[string][2]float64{
+		"app/" + cpuUsageMetricName:    {0.2, 0.25},
+		"app/" + memoryUsageMetricName: {200 << 20, 260 << 20},
+	}), queryRuntimeUsage: fakeUsage(map[string][2]float64{
+		"app/" + runtimeCPUTimeMetricName:    {0, 0.24},
+		"app/" + runtimeMemoryUsedMetricName: {0, 180 << 20},
+	}), queryAgentSpans: fakeAgentSpans()}
+
+	overhead, err := e.measure(context.Background(), instrumentedDeployment(), hourWindow, instrumentationTime.Add(2*time.Hour))
+
+	require.NoError(t, err)
+	require.Len(t, overhead.Containers, 1)
+	require.Equal(t, ptr.To[int64](240), overhead.Containers[0].RuntimeCPUMillicoresAfter)
+	require.Equal(t, ptr.To[int64](180<<20), overhead.Containers[0].RuntimeMemoryBytesAfter)
+}
+
+func TestMeasurementTime(t *testing.T) {
+	measureAt, due := measurementTime(instrumentedDeployment(), hourWindow)
+	require.True(t, due)
+	require.Equal(t, instrumentationTime.Add(time.Hour+rolloutSettleTime), measureAt)
+
+	ic := instrumentedDeployment()
+	ic.Spec.Containers[0].AgentEnabled = false
+	_, due = measurementTime(ic, hourWindow)
+	require.False(t, due)
+}
@@ -0,0 +1,97 @@
+package agentoverhead
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/k8sutils/pkg/env"
+	"github.com/odigos-io/odigos/k8sutils/pkg/metricsstore"
+	odigospredicate "github.com/odigos-io/odigos/k8sutils/pkg/predicate"
+	k8sutils "github.com/odigos-io/odigos/k8sutils/pkg/utils"
+)
+
+// InstrumentationConfigReconciler measures the overhead of the agents of each instrumented workload
+// once the window following its instrumentation time completes, and records it in the instrumentation config status.
+// Each workload is measured once. The reconcile is requeued until its measurement time.
+type InstrumentationConfigReconciler struct {
+	client.Client
+	evaluator *evaluator
+}
+
+func (r *InstrumentationConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ic := &odigosv1.InstrumentationConfig{}
+	if err := r.Get(ctx, req.NamespacedName, ic); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	odigosConfiguration, err := k8sutils.GetCurrentOdigosConfiguration(ctx, r.Client)
+	if err != nil {
+		return k8sutils.K8SNoEffectiveConfigErrorHandler(err)
+	}
+	cfg, active := ConfigFromOdigosConfig(&odigosConfiguration)
+	if !active {
+		return ctrl.Result{}, nil
+	}
+
+	measureAt, due := measurementTime(ic, cfg)
+	if !due {
+		return ctrl.Result{}, nil
+	}
+	now := time.Now()
+	if now.Before(measureAt) {
+		return ctrl.Result{RequeueAfter: measureAt.Sub(now)}, nil
+	}
+	overhead, err := r.evaluator.measure(ctx, ic, cfg, now)
+	if err != nil || overhead == nil {
+		return ctrl.Result{}, err
+	}
+
+	// merge the overhead into the status, so the status written by the instrumentor is not overridden
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{"agentOverhead": overhead},
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	err = r.Status().Patch(ctx, ic, client.RawPatch(types.MergePatchType, patch))
+	return ctrl.Result{}, client.IgnoreNotFound(err)
+}
+
+// enqueueAllInstrumentationConfigs requeues every instrumentation config when the effective config changes,
+// so the workloads skipped while agent overhead accounting was disabled are measured once it is enabled.
+func (r *InstrumentationConfigReconciler) enqueueAllInstrumentationConfigs(ctx context.Context, _ client.Object) []reconcile.Request {
+	var ics odigosv1.InstrumentationConfigList
+	if err := r.List(ctx, &ics); err != nil {
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(ics.Items))
+	for i := range ics.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&ics.Items[i])})
+	}
+	return requests
+}
+
+func SetupWithManager(mgr ctrl.Manager) error {
+	api, err := metricsstore.NewAPI(env.GetCurrentNamespace())
+	if err != nil {
+		return err
+	}
+	r := &InstrumentationConfigReconciler{Client: mgr.GetClient(), evaluator: newEvaluator(api)}
+	// the instrumentation time is recorded in the status, so status updates are reconciled as well
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("agentoverhead-instrumentationconfig").
+		For(&odigosv1.InstrumentationConfig{}).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.enqueueAllInstrumentationConfigs),
+			builder.WithPredicates(&odigospredicate.OdigosEffectiveConfigMapPredicate)).
+		Complete(r)
+}
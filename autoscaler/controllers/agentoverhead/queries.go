@@ -0,0 +1,128 @@
+package agentoverhead
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	prommodel "github.com/prometheus/common/model"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	"github.com/odigos-io/odigos/k8sutils/pkg/metricsstore"
+)
+
+// Selectors of the container usage collected by the kubeletstats receiver of the node collectors.
+// The cpu usage is in cores, and the memory usage in bytes.
+const (
+	cpuUsageMetricName    = `container_cpu_usage`
+	memoryUsageMetricName = `container_memory_usage(_bytes)?`
+)
+
+// Selectors of the runtime metrics reported by the agents themselves, which only the java agent reports.
+// The cpu time is in seconds, and the memory used in bytes.
+const (
+	runtimeCPUTimeMetricName    = `jvm_cpu_time(_seconds)?(_total)?`
+	runtimeMemoryUsedMetricName = `jvm_memory_used(_bytes)?`
+)
+
+// errNoData is returned when no usage of the container was recorded in the window.
+var errNoData = errors.New("no usage recorded")
+
+// podNameSuffixes match the suffix the controller of each workload kind appends to the names of its pods.
+// The kubelet stats carry no workload attributes, so the pods of a workload are selected by their names.
+var podNameSuffixes = map[k8sconsts.WorkloadKind]string{
+	k8sconsts.WorkloadKindDeployment:       `-[a-z0-9]+-[a-z0-9]{5}`,
+	k8sconsts.WorkloadKindArgoRollout:      `-[a-z0-9]+-[a-z0-9]{5}`,
+	k8sconsts.WorkloadKindDeploymentConfig: `-[0-9]+-[a-z0-9]{5}`,
+	k8sconsts.WorkloadKindCronJob:          `-[0-9]+-[a-z0-9]{5}`,
+	k8sconsts.WorkloadKindDaemonSet:        `-[a-z0-9]{5}`,
+	k8sconsts.WorkloadKindJob:              `-[a-z0-9]{5}`,
+	k8sconsts.WorkloadKindStatefulSet:      `-[0-9]+`,
+}
+
+// usageQuery returns the expression of the average usage of the container, per pod, over the window ending at the query time.
+func usageQuery(metricName string, pw k8sconsts.PodWorkload, containerName string, window time.Duration) (string, error) {
+	suffix, ok := podNameSuffixes[pw.Kind]
+	if !ok {
+		return "", fmt.Errorf("the pods of %s workloads cannot be matched to their kubelet stats", pw.Kind)
+	}
+	selector := fmt.Sprintf(`__name__=~"%s", k8s.namespace.name=%q, k8s.container.name=%q, k8s.pod.name=~%q`,
+		metricName, pw.Namespace, containerName, regexp.QuoteMeta(pw.Name)+suffix)
+	return fmt.Sprintf(`avg(avg_over_time({%s}[%s]))`, selector, metricsstore.PromDuration(window)), nil
+}
+
+// agentSpansQuery returns the expression of the number of span metrics series reported by the agent of the container
+// over the window ending at the query time.
+// Spans reported by a container in a window mean its agent was already running in the window.
+func agentSpansQuery(pw k8sconsts.PodWorkload, containerName string, window time.Duration) string {
+	return fmt.Sprintf(`count(%s)`, workloadUnion(`count_over_time`, metricsstore.SpanMetricsCallsName, pw, containerName, window))
+}
+
+// runtimeUsageQuery returns the expression of the average usage of the agent of the container, per pod,
+// over the window ending at the query time, from the runtime metrics the agent reports.
+// The runtime metrics are split by memory pool, so they are summed per pod.
+func runtimeUsageQuery(metricName string, pw k8sconsts.PodWorkload, containerName string, window time.Duration) string {
+	function := `avg_over_time`
+	if metricName == runtimeCPUTimeMetricName {
+		function = `rate`
+	}
+	return fmt.Sprintf(`avg(sum by (k8s.pod.name) (%s))`, workloadUnion(function, metricName, pw, containerName, window))
+}
+
+// workloadUnion applies the range function to the series of the metric of the container,
+// joining the series of each of the attributes the workload can be carried in.
+func workloadUnion(function string, metricName string, pw k8sconsts.PodWorkload, containerName string, window time.Duration) string {
+	workloadMatchers := metricsstore.WorkloadMatchers(pw.Kind, pw.Name)
+	ranges := make([]string, 0, len(workloadMatchers))
+	for _, workloadMatcher := range workloadMatchers {
+		ranges = append(ranges, fmt.Sprintf(`%s({__name__=~"%s", k8s.namespace.name=%q, k8s.container.name=%q, %s}[%s])`,
+			function, metricName, pw.Namespace, containerName, workloadMatcher, metricsstore.PromDuration(window)))
+	}
+	return strings.Join(ranges, " or ")
+}
+
+// queryAgentSpans returns true when the agent of the container reported spans in the window ending at end.
+func queryAgentSpans(ctx context.Context, api v1.API, pw k8sconsts.PodWorkload, containerName string, window time.Duration, end time.Time) (bool, error) {
+	val, _, err := api.Query(ctx, agentSpansQuery(pw, containerName, window), end)
+	if err != nil {
+		return false, err
+	}
+	vec, ok := val.(prommodel.Vector)
+	return ok && len(vec) > 0 && vec[0].Value > 0, nil
+}
+
+// queryUsage returns the average usage of the container, per pod, over the window ending at end.
+func queryUsage(ctx context.Context, api v1.API, metricName string, pw k8sconsts.PodWorkload, containerName string, window time.Duration, end time.Time) (float64, error) {
+	query, err := usageQuery(metricName, pw, containerName, window)
+	if err != nil {
+		return 0, err
+	}
+	return queryValue(ctx, api, query, end)
+}
+
+// queryRuntimeUsage returns the average usage of the agent of the container, per pod, over the window ending at end.
+func queryRuntimeUsage(ctx context.Context, api v1.API, metricName string, pw k8sconsts.PodWorkload, containerName string, window time.Duration, end time.Time) (float64, error) {
+	return queryValue(ctx, api, runtimeUsageQuery(metricName, pw, containerName, window), end)
+}
+
+// queryValue returns the single value of the query at end.
+func queryValue(ctx context.Context, api v1.API, query string, end time.Time) (float64, error) {
+	val, _, err := api.Query(ctx, query, end)
+	if err != nil {
+		return 0, err
+	}
+	vec, ok := val.(prommodel.Vector)
+	if !ok || len(vec) == 0 {
+		return 0, errNoData
+	}
+	usage := float64(vec[0].Value)
+	if math.IsNaN(usage) || math.IsInf(usage, 0) {
+		return 0, errNoData
+	}
+	return usage, nil
+}
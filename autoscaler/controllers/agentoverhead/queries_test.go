@@ -0,0 +1,68 @@
+package agentoverhead
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	"github.com/odigos-io/odigos/common"
+)
+
+func TestUsageQuery(t *testing.T) {
+	pw := k8sconsts.PodWorkload{Namespace: "shop", Kind: k8sconsts.WorkloadKindStatefulSet, Name: "cart.v2"}
+
+	query, err := usageQuery(memoryUsageMetricName, pw, "app", time.Hour)
+
+	require.NoError(t, err)
+	require.Equal(t,
+		`avg(avg_over_time({__name__=~"container_memory_usage(_bytes)?", k8s.namespace.name="shop", k8s.container.name="app", k8s.pod.name=~"cart\\.v2-[0-9]+"}[1h]))`,
+		query)
+
+	_, err = usageQuery(cpuUsageMetricName, k8sconsts.PodWorkload{Namespace: "shop", Kind: k8sconsts.WorkloadKindStaticPod, Name: "cart"}, "app", time.Hour)
+	require.Error(t, err)
+}
+
+func TestAgentSpansQuery(t *testing.T) {
+	pw := k8sconsts.PodWorkload{Namespace: "shop", Kind: k8sconsts.WorkloadKindDeployment, Name: "cart"}
+
+	require.Equal(t,
+		`count(count_over_time({__name__=~"traces_span_metrics_calls(_total)?", k8s.namespace.name="shop", k8s.container.name="app", odigos.workload.kind=~"(?i)Deployment", odigos.workload.name="cart"}[1h]) or `+
+			`count_over_time({__name__=~"traces_span_metrics_calls(_total)?", k8s.namespace.name="shop", k8s.container.name="app", k8s.deployment.name="cart"}[1h]))`,
+		agentSpansQuery(pw, "app", time.Hour))
+}
+
+func TestRuntimeUsageQuery(t *testing.T) {
+	pw := k8sconsts.PodWorkload{Namespace: "shop", Kind: k8sconsts.WorkloadKindStatefulSet, Name: "cart"}
+
+	require.Equal(t,
+		`avg(sum by (k8s.pod.name) (rate({__name__=~"jvm_cpu_time(_seconds)?(_total)?", k8s.namespace.name="shop", k8s.container.name="app", odigos.workload.kind=~"(?i)StatefulSet", odigos.workload.name="cart"}[1h]) or `+
+			`rate({__name__=~"jvm_cpu_time(_seconds)?(_total)?", k8s.namespace.name="shop", k8s.container.name="app", k8s.statefulset.name="cart"}[1h])))`,
+		runtimeUsageQuery(runtimeCPUTimeMetricName, pw, "app", time.Hour))
+	require.Contains(t, runtimeUsageQuery(runtimeMemoryUsedMetricName, pw, "app", time.Hour), `avg_over_time({__name__=~"jvm_memory_used(_bytes)?"`)
+}
+
+func TestConfigFromOdigosConfig_CapsWindowToRetention(t *testing.T) {
+	_, active := ConfigFromOdigosConfig(&common.OdigosConfiguration{})
+	require.False(t, active)
+
+	enabled := true
+	cfg, active := ConfigFromOdigosConfig(&common.OdigosConfiguration{
+		OdigosOwnTelemetryStore: &common.OdigosOwnTelemetryConfiguration{
+			AgentOverhead: &common.AgentOverheadConfiguration{Enabled: &enabled, MeasurementWindow: "24h"},
+		},
+	})
+	require.True(t, active)
+	require.Equal(t, common.DefaultMetricsStoreRetention, cfg.Retention)
+	// both windows and the rollout settle time must fit in the retention of the metrics store
+	require.Equal(t, 11*time.Hour+55*time.Minute, cfg.MeasurementWindow)
+
+	cfg, _ = ConfigFromOdigosConfig(&common.OdigosConfiguration{
+		OdigosOwnTelemetryStore: &common.OdigosOwnTelemetryConfiguration{
+			MetricsStoreRetention: "168h",
+			AgentOverhead:         &common.AgentOverheadConfiguration{Enabled: &enabled, MeasurementWindow: "24h"},
+		},
+	})
+	require.Equal(t, 24*time.Hour, cfg.MeasurementWindow)
+}
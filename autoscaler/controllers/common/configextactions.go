@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"slices"
 
+	actionscatalog "github.com/odigos-io/odigos/actions"
 	"github.com/odigos-io/odigos/api/k8sconsts"
 	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
 	odigoscommon "github.com/odigos-io/odigos/common"
@@ -42,9 +43,13 @@ func aggregateConfigExtensionProcessorTypes(actions odigosv1.ActionList) map[str
 	processorToSignals := map[string][]odigoscommon.ObservabilitySignal{}
 	for i := range actions.Items {
 		action := &actions.Items[i]
-		processorTypes := extractConfigExtActions(action)
-		for _, processorType := range processorTypes {
+		for _, processorMetadata := range extractConfigExtActions(action) {
+			processorType := processorMetadata.Type
 			for _, signal := range action.Spec.Signals {
+				// skip signals the processor does not handle in the collector (handled in agents only)
+				if len(processorMetadata.Signals) > 0 && !slices.Contains(processorMetadata.Signals, string(signal)) {
+					continue
+				}
 				// only if this signal is not already in the list for this processor type
 				// the list is short (<5 items) so no problem iterating it
 				if !slices.Contains(processorToSignals[processorType], signal) {
@@ -57,11 +62,11 @@ func aggregateConfigExtensionProcessorTypes(actions odigosv1.ActionList) map[str
 	return processorToSignals
 }
 
-func extractConfigExtActions(action *odigosv1.Action) []string {
+func extractConfigExtActions(action *odigosv1.Action) []actionscatalog.Processor {
 	if action.Spec.Disabled {
-		return []string{}
+		return []actionscatalog.Processor{}
 	}
-	return actionutil.ConfigExtensionProcessorTypes(action)
+	return actionutil.ConfigExtensionProcessors(action)
 }
 
 func actionToConfigExtensionProcessor(processorType string, signals []odigoscommon.ObservabilitySignal) odigosv1.Processor {
@@ -0,0 +1,38 @@
+package common
+
+import (
+	"testing"
+
+	actionscatalog "github.com/odigos-io/odigos/actions"
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	odigosactions "github.com/odigos-io/odigos/api/odigos/v1alpha1/actions"
+	odigoscommon "github.com/odigos-io/odigos/common"
+	actionsapi "github.com/odigos-io/odigos/common/api/actions"
+	"github.com/odigos-io/odigos/common/consts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateConfigExtensionProcessorTypes_ProcessorSignals(t *testing.T) {
+	require.NoError(t, actionscatalog.Load())
+
+	actions := odigosv1.ActionList{Items: []odigosv1.Action{
+		{Spec: odigosv1.ActionSpec{
+			Signals: []odigoscommon.ObservabilitySignal{odigoscommon.TracesObservabilitySignal, odigoscommon.LogsObservabilitySignal},
+			BaggageAttributes: &odigosactions.BaggageAttributesConfig{
+				BaggageAttributesConfig: actionsapi.BaggageAttributesConfig{BaggageKeys: []string{"tenant_id"}},
+			},
+		}},
+		{Spec: odigosv1.ActionSpec{
+			Disabled:   true,
+			Signals:    []odigoscommon.ObservabilitySignal{odigoscommon.TracesObservabilitySignal},
+			PiiMasking: &odigosactions.PiiMaskingConfig{},
+		}},
+	}}
+
+	// the baggage attributes processor handles traces only, logs are promoted in agents
+	processorToSignals := aggregateConfigExtensionProcessorTypes(actions)
+	assert.Equal(t, map[string][]odigoscommon.ObservabilitySignal{
+		consts.OdigosBaggageAttributesProcessorType: {odigoscommon.TracesObservabilitySignal},
+	}, processorToSignals)
+}
@@ -11,6 +11,9 @@ const (
 	ProfilingNodeK8sAttributesProcessor = "k8s_attributes/profiles-node"
 	// ProfilingNodeOdigosProfilesProcessor keeps only profiles for workloads present in odigos_config_k8s (InstrumentationConfig).
 	ProfilingNodeOdigosProfilesProcessor = "odigosprofilesprocessor/profiles-node"
+	// ProfilingNodeScopeFilterProcessor drops the profile types the profiling instrumentation rules
+	// do not request for a container, after the profiles are enriched with their workload.
+	ProfilingNodeScopeFilterProcessor = "filter/profiles-scope"
 	// ProfilingNodeSymbolizeProcessor resolves native (C/C++/Rust) frames the profiler
 	// left as module+offset by reading the on-host ELF symbols. Runs on the node collector
 	// (hostPID + /proc access). Zero-config; interpreted/Go frames pass through untouched.
@@ -69,6 +69,7 @@ func K8sAttributesProfilesProcessorConfig() config.GenericMap {
 				"k8s.daemonset.name",
 				"k8s.job.name",
 				"k8s.cronjob.name",
+				"k8s.container.name",
 				"container.id",
 			},
 		},
@@ -9,11 +9,13 @@ import (
 	apiactions "github.com/odigos-io/odigos/api/actions/v1alpha1"
 	"github.com/odigos-io/odigos/api/k8sconsts"
 	"github.com/odigos-io/odigos/autoscaler/controllers/actions"
+	"github.com/odigos-io/odigos/autoscaler/controllers/agentoverhead"
 	"github.com/odigos-io/odigos/autoscaler/controllers/clustercollector"
 	"github.com/odigos-io/odigos/autoscaler/controllers/loglevel"
 	"github.com/odigos-io/odigos/autoscaler/controllers/metricshandler"
 	"github.com/odigos-io/odigos/autoscaler/controllers/nodecollector"
 	"github.com/odigos-io/odigos/autoscaler/controllers/recommendations"
+	"github.com/odigos-io/odigos/autoscaler/controllers/servicelevelobjectives"
 	"github.com/odigos-io/odigos/common"
 	"github.com/odigos-io/odigos/k8sutils/pkg/env"
 	"k8s.io/apimachinery/pkg/labels"
@@ -157,6 +159,9 @@ func CreateManager(opts KubeManagerOptions) (ctrl.Manager, error) {
 				&odigosv1.Recommendation{}: {
 					Field: nsSelector,
 				},
+				&odigosv1.ServiceLevelObjective{}: {
+					Field: nsSelector,
+				},
 				&odigosv1.InstrumentationConfig{}: {},
 			},
 		},
@@ -196,6 +201,14 @@ func SetupWithManager(mgr manager.Manager, odigosVersion string, tier common.Odi
 		return fmt.Errorf("failed to create recommendations controller: %w", err)
 	}
 
+	if err = servicelevelobjectives.SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("failed to create service level objectives controller: %w", err)
+	}
+
+	if err = agentoverhead.SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("failed to create agent overhead controller: %w", err)
+	}
+
 	return nil
 }
 
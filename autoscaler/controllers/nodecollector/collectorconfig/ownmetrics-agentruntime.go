@@ -0,0 +1,63 @@
+package collectorconfig
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/odigos-io/odigos/common/config"
+)
+
+const (
+	// Pass the runtime metrics the agents report into own-metrics -> victoria metrics.
+	ownAgentRuntimeFilterName      = "filter/own-agent-runtime"
+	ownAgentRuntimeMetricsPipeline = "metrics/own-agent-runtime"
+)
+
+// ownMetricsAgentRuntimeMetricNames are the cpu and memory runtime metrics of the agents,
+// which the agent overhead accounting reads. Only the java agent reports them.
+var ownMetricsAgentRuntimeMetricNames = []string{
+	"jvm.cpu.time",
+	"jvm.memory.used",
+}
+
+func ownMetricsAgentRuntimeProcessorConfig() config.GenericMap {
+	notOurMetric := make([]string, 0, len(ownMetricsAgentRuntimeMetricNames))
+	for _, metricName := range ownMetricsAgentRuntimeMetricNames {
+		notOurMetric = append(notOurMetric, fmt.Sprintf("name != %q", metricName))
+	}
+	return config.GenericMap{
+		ownAgentRuntimeFilterName: config.GenericMap{
+			"error_mode": "ignore",
+			"metrics": config.GenericMap{
+				"metric": []string{strings.Join(notOurMetric, " and ")},
+			},
+		},
+	}
+}
+
+func ownAgentRuntimePipeline() map[string]config.Pipeline {
+	return map[string]config.Pipeline{
+		// Reuses the otlp receiver the agents report their metrics to.
+		ownAgentRuntimeMetricsPipeline: {
+			Receivers:  []string{OTLPInReceiverName},
+			Processors: []string{ownAgentRuntimeFilterName},
+			Exporters:  []string{odigletMetricsExporterName},
+		},
+	}
+}
+
+// AddAgentRuntimeMetricsToOwnMetrics passes the metrics the agents report into own-metrics -> victoria metrics
+// (filtered to the cpu/memory runtime metrics of the agents)
+func AddAgentRuntimeMetricsToOwnMetrics(ownMetricsConfig config.Config) config.Config {
+	if ownMetricsConfig.Processors == nil {
+		ownMetricsConfig.Processors = config.GenericMap{}
+	}
+	maps.Copy(ownMetricsConfig.Processors, ownMetricsAgentRuntimeProcessorConfig())
+
+	if ownMetricsConfig.Service.Pipelines == nil {
+		ownMetricsConfig.Service.Pipelines = map[string]config.Pipeline{}
+	}
+	maps.Copy(ownMetricsConfig.Service.Pipelines, ownAgentRuntimePipeline())
+	return ownMetricsConfig
+}
@@ -0,0 +1,35 @@
+package collectorconfig
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/common/config"
+)
+
+func TestAddAgentRuntimeMetricsToOwnMetrics(t *testing.T) {
+	got := AddAgentRuntimeMetricsToOwnMetrics(OdigletMetricsConfig("odigos-system"))
+
+	if _, exists := got.Receivers[OTLPInReceiverName]; exists {
+		t.Fatal("must not define the otlp receiver in own-metrics domain")
+	}
+	pipeline, exists := got.Service.Pipelines[ownAgentRuntimeMetricsPipeline]
+	if !exists {
+		t.Fatal("missing metrics/own-agent-runtime pipeline")
+	}
+	if len(pipeline.Receivers) != 1 || pipeline.Receivers[0] != OTLPInReceiverName {
+		t.Fatalf("receivers want [%s], got %v", OTLPInReceiverName, pipeline.Receivers)
+	}
+	if len(pipeline.Exporters) != 1 || pipeline.Exporters[0] != odigletMetricsExporterName {
+		t.Fatalf("exporters want [%s], got %v", odigletMetricsExporterName, pipeline.Exporters)
+	}
+	// the odiglet metrics pipeline is kept
+	if _, exists := got.Service.Pipelines[odigletMetricsPipelineName]; !exists {
+		t.Fatal("missing odiglet metrics pipeline")
+	}
+
+	filter := got.Processors[ownAgentRuntimeFilterName].(config.GenericMap)
+	conditions := filter["metrics"].(config.GenericMap)["metric"].([]string)
+	if len(conditions) != 1 || conditions[0] != `name != "jvm.cpu.time" and name != "jvm.memory.used"` {
+		t.Fatalf("unexpected filter conditions %v", conditions)
+	}
+}
@@ -27,7 +27,9 @@ var ownMetricsKubeletContainerNames = []string{
 }
 
 // ownMetricsKubeletProcessorConfig keeps cpu/memory for odiglet, data-collection, and gateway containers.
-func ownMetricsKubeletProcessorConfig(odigosNamespace string) config.GenericMap {
+// With includeWorkloads, cpu/memory of the containers in all the other namespaces is kept as well,
+// so the overhead of the agents can be measured from it.
+func ownMetricsKubeletProcessorConfig(odigosNamespace string, includeWorkloads bool) config.GenericMap {
 	notOurContainer := make([]string, 0, len(ownMetricsKubeletContainerNames))
 	for _, containerName := range ownMetricsKubeletContainerNames {
 		notOurContainer = append(notOurContainer,
@@ -39,15 +41,26 @@ func ownMetricsKubeletProcessorConfig(odigosNamespace string) config.GenericMap
 		notOurMetric = append(notOurMetric, fmt.Sprintf("name != %q", metricName))
 	}
 
+	var dropConditions []string
+	if includeWorkloads {
+		dropConditions = []string{
+			fmt.Sprintf("resource.attributes[%q] == %q and %s",
+				string(semconv.K8SNamespaceNameKey), odigosNamespace, strings.Join(notOurContainer, " and ")),
+			strings.Join(notOurMetric, " and "),
+		}
+	} else {
+		dropConditions = []string{
+			fmt.Sprintf("resource.attributes[%q] != %q", string(semconv.K8SNamespaceNameKey), odigosNamespace),
+			strings.Join(notOurContainer, " and "),
+			strings.Join(notOurMetric, " and "),
+		}
+	}
+
 	return config.GenericMap{
 		ownKubeletFilterName: config.GenericMap{
 			"error_mode": "ignore",
 			"metrics": config.GenericMap{
-				"metric": []string{
-					fmt.Sprintf("resource.attributes[%q] != %q", string(semconv.K8SNamespaceNameKey), odigosNamespace),
-					strings.Join(notOurContainer, " and "),
-					strings.Join(notOurMetric, " and "),
-				},
+				"metric": dropConditions,
 			},
 		},
 	}
@@ -7,7 +7,7 @@ import (
 )
 
 func TestAddKubeletStatsToOwnMetrics_ReusesDestinationReceiver(t *testing.T) {
-	got := AddKubeletStatsToOwnMetrics(OdigletMetricsConfig("odigos-system"), "odigos-system")
+	got := AddKubeletStatsToOwnMetrics(OdigletMetricsConfig("odigos-system"), "odigos-system", false)
 
 	if _, exists := got.Receivers[kubeletstatsReceiverName]; exists {
 		t.Fatal("must not define kubeletstats in own-metrics domain")
@@ -31,7 +31,7 @@ func TestAddKubeletStatsToOwnMetrics_ReusesDestinationReceiver(t *testing.T) {
 }
 
 func TestAddKubeletStatsToOwnMetrics_EmptyConfig(t *testing.T) {
-	got := AddKubeletStatsToOwnMetrics(config.Config{}, "odigos-system")
+	got := AddKubeletStatsToOwnMetrics(config.Config{}, "odigos-system", false)
 	if _, exists := got.Receivers[kubeletstatsReceiverName]; exists {
 		t.Fatal("must not define kubeletstats")
 	}
@@ -39,3 +39,25 @@ func TestAddKubeletStatsToOwnMetrics_EmptyConfig(t *testing.T) {
 		t.Fatal("pipeline must still reference destination kubeletstats")
 	}
 }
+
+func TestAddKubeletStatsToOwnMetrics_IncludeWorkloads(t *testing.T) {
+	odigosOnly := AddKubeletStatsToOwnMetrics(config.Config{}, "odigos-system", false)
+	withWorkloads := AddKubeletStatsToOwnMetrics(config.Config{}, "odigos-system", true)
+
+	conditions := func(c config.Config) []string {
+		filter := c.Processors[ownKubeletFilterName].(config.GenericMap)
+		return filter["metrics"].(config.GenericMap)["metric"].([]string)
+	}
+
+	if !contains(conditions(odigosOnly), `resource.attributes["k8s.namespace.name"] != "odigos-system"`) {
+		t.Fatal("without workloads, metrics outside the odigos namespace must be dropped")
+	}
+	for _, condition := range conditions(withWorkloads) {
+		if condition == `resource.attributes["k8s.namespace.name"] != "odigos-system"` {
+			t.Fatal("with workloads, metrics outside the odigos namespace must be kept")
+		}
+	}
+	if len(conditions(withWorkloads)) != 2 {
+		t.Fatalf("want namespace-scoped container condition and metric name condition, got %v", conditions(withWorkloads))
+	}
+}
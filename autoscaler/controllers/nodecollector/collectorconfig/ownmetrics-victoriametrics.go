@@ -131,12 +131,12 @@ func OdigletMetricsConfig(odigosNamespace string) config.Config {
 }
 
 // AddKubeletStatsToOwnMetrics passes the destination kubeletstats receiver into own-metrics -> victoria metrics
-// (filtered to odigos component cpu/memory)
-func AddKubeletStatsToOwnMetrics(ownMetricsConfig config.Config, odigosNamespace string) config.Config {
+// (filtered to odigos component cpu/memory, and the cpu/memory of the workloads when includeWorkloads is set)
+func AddKubeletStatsToOwnMetrics(ownMetricsConfig config.Config, odigosNamespace string, includeWorkloads bool) config.Config {
 	if ownMetricsConfig.Processors == nil {
 		ownMetricsConfig.Processors = config.GenericMap{}
 	}
-	maps.Copy(ownMetricsConfig.Processors, ownMetricsKubeletProcessorConfig(odigosNamespace))
+	maps.Copy(ownMetricsConfig.Processors, ownMetricsKubeletProcessorConfig(odigosNamespace, includeWorkloads))
 
 	if ownMetricsConfig.Service.Pipelines == nil {
 		ownMetricsConfig.Service.Pipelines = map[string]config.Pipeline{}
@@ -1,15 +1,23 @@
 package collectorconfig
 
 import (
+	"fmt"
+
 	"github.com/odigos-io/odigos/api/k8sconsts"
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
 	commonconf "github.com/odigos-io/odigos/autoscaler/controllers/common"
 	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/api/instrumentationrules"
 	"github.com/odigos-io/odigos/common/config"
 	odigosconsts "github.com/odigos-io/odigos/common/consts"
+	"github.com/odigos-io/odigos/k8sutils/pkg/workload"
 )
 
+// profilingOffCpuThreshold is the probability of recording an off-cpu event when any source requests off-cpu profiles.
+const profilingOffCpuThreshold = 0.01
+
 // ProfilingPipelineConfig builds the node collector profiles domain when profiling is enabled.
-func ProfilingPipelineConfig(odigosNamespace string, profiling *common.ProfilingConfiguration, manifestProcessorNames []string) config.Config {
+func ProfilingPipelineConfig(odigosNamespace string, profiling *common.ProfilingConfiguration, sources *odigosv1.InstrumentationConfigList, manifestProcessorNames []string) config.Config {
 	if !common.ProfilingPipelineActive(profiling) {
 		return config.Config{}
 	}
@@ -35,6 +43,15 @@ func ProfilingPipelineConfig(odigosNamespace string, profiling *common.Profiling
 		commonconf.ProfilingNodeK8sAttributesProcessor,
 		commonconf.ProfilingNodeOdigosProfilesProcessor,
 	}
+	receiverConfig := profilingReceiverConfig(sources)
+	_, offCpuCollected := receiverConfig["off_cpu_threshold"]
+	if dropConditions := profilingScopeDropConditions(sources, offCpuCollected); len(dropConditions) > 0 {
+		processors[commonconf.ProfilingNodeScopeFilterProcessor] = config.GenericMap{
+			"error_mode":         "ignore",
+			"profile_conditions": dropConditions,
+		}
+		pipelineProcessors = append(pipelineProcessors, commonconf.ProfilingNodeScopeFilterProcessor)
+	}
 	// Native symbolization is opt-in (profiling.symbolization.native). When on, the
 	// symbolize processor runs after the keep-filter (only retained profiles are
 	// symbolized) and before service-name enrichment.
@@ -48,7 +65,7 @@ func ProfilingPipelineConfig(odigosNamespace string, profiling *common.Profiling
 
 	return config.Config{
 		Receivers: config.GenericMap{
-			commonconf.ProfilingReceiver: config.GenericMap{},
+			commonconf.ProfilingReceiver: receiverConfig,
 		},
 		Processors: processors,
 		Exporters: config.GenericMap{
@@ -65,3 +82,93 @@ func ProfilingPipelineConfig(odigosNamespace string, profiling *common.Profiling
 		},
 	}
 }
+
+// profilingReceiverConfig tunes the eBPF profiler to what the profiling instrumentation rules request for the sources.
+// All the node collectors share this config, and the profiler samples every process on its node,
+// so it samples at the highest frequency requested for any source in the cluster, and records off-cpu events
+// when any source asks for them. The profile types are scoped by profilingScopeDropConditions.
+// Without any profiling rule, the profiler defaults are used.
+func profilingReceiverConfig(sources *odigosv1.InstrumentationConfigList) config.GenericMap {
+	receiverConfig := config.GenericMap{}
+	if sources == nil {
+		return receiverConfig
+	}
+
+	samplesPerSecond := 0
+	offCpu := false
+	for _, ic := range sources.Items {
+		for _, containerConfig := range ic.Spec.WorkloadCollectorConfig {
+			if containerConfig.Profiling == nil {
+				continue
+			}
+			if containerConfig.Profiling.SamplingFrequencyHz != nil {
+				samplesPerSecond = max(samplesPerSecond, *containerConfig.Profiling.SamplingFrequencyHz)
+			}
+			if containerConfig.Profiling.HasProfileType(instrumentationrules.ProfileTypeOffCpu) {
+				offCpu = true
+			}
+		}
+	}
+
+	if samplesPerSecond > 0 {
+		receiverConfig["samples_per_second"] = samplesPerSecond
+	}
+	if offCpu {
+		receiverConfig["off_cpu_threshold"] = profilingOffCpuThreshold
+	}
+	return receiverConfig
+}
+
+// profilingScopeWorkloadAttributes are the resource attributes the k8s_attributes processor sets with the
+// name of the workload of each kind. The profiles of the workloads of other kinds cannot be scoped, and are kept.
+var profilingScopeWorkloadAttributes = map[k8sconsts.WorkloadKind]string{
+	k8sconsts.WorkloadKindDeployment:  "k8s.deployment.name",
+	k8sconsts.WorkloadKindStatefulSet: "k8s.statefulset.name",
+	k8sconsts.WorkloadKindDaemonSet:   "k8s.daemonset.name",
+	k8sconsts.WorkloadKindJob:         "k8s.job.name",
+	k8sconsts.WorkloadKindCronJob:     "k8s.cronjob.name",
+}
+
+// Sample types the eBPF profiler sets on its cpu and off-cpu profiles.
+const (
+	profilingCpuSampleType    = "samples"
+	profilingOffCpuSampleType = "off_cpu"
+)
+
+// profilingScopeDropConditions returns the profile conditions dropping the profile types each container did not request:
+// the off-cpu profiles of the containers without an offCpu rule, recorded only when another source requests them,
+// and the cpu profiles of the containers whose rules only request off-cpu profiles.
+func profilingScopeDropConditions(sources *odigosv1.InstrumentationConfigList, offCpuCollected bool) []string {
+	if sources == nil {
+		return nil
+	}
+	var conditions []string
+	for i := range sources.Items {
+		ic := &sources.Items[i]
+		pw, err := workload.ExtractWorkloadInfoFromRuntimeObjectName(ic.Name, ic.Namespace)
+		if err != nil {
+			continue
+		}
+		nameAttribute, ok := profilingScopeWorkloadAttributes[pw.Kind]
+		if !ok {
+			continue
+		}
+		profilingByContainer := make(map[string]*instrumentationrules.Profiling, len(ic.Spec.WorkloadCollectorConfig))
+		for _, containerConfig := range ic.Spec.WorkloadCollectorConfig {
+			profilingByContainer[containerConfig.ContainerName] = containerConfig.Profiling
+		}
+		for _, container := range ic.Spec.Containers {
+			// containers without a profiling rule collect cpu profiles only
+			profiling := profilingByContainer[container.ContainerName]
+			containerMatch := fmt.Sprintf(`resource.attributes["k8s.namespace.name"] == %q and resource.attributes[%q] == %q and resource.attributes["k8s.container.name"] == %q`,
+				pw.Namespace, nameAttribute, pw.Name, container.ContainerName)
+			if offCpuCollected && !profiling.HasProfileType(instrumentationrules.ProfileTypeOffCpu) {
+				conditions = append(conditions, fmt.Sprintf(`profile.sample_type.type == %q and %s`, profilingOffCpuSampleType, containerMatch))
+			}
+			if profiling != nil && !profiling.HasProfileType(instrumentationrules.ProfileTypeCpu) {
+				conditions = append(conditions, fmt.Sprintf(`profile.sample_type.type == %q and %s`, profilingCpuSampleType, containerMatch))
+			}
+		}
+	}
+	return conditions
+}
@@ -1,31 +1,36 @@
 package collectorconfig
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/odigos-io/odigos/api/k8sconsts"
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
 	commonconf "github.com/odigos-io/odigos/autoscaler/controllers/common"
 	"github.com/odigos-io/odigos/common"
+	commonapi "github.com/odigos-io/odigos/common/api"
+	"github.com/odigos-io/odigos/common/api/instrumentationrules"
 	"github.com/odigos-io/odigos/common/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestProfilingPipelineConfig_Disabled(t *testing.T) {
-	got := ProfilingPipelineConfig("odigos-system", nil, nil)
+	got := ProfilingPipelineConfig("odigos-system", nil, nil, nil)
 	assert.Empty(t, got.Receivers)
 	assert.Empty(t, got.Processors)
 	assert.Empty(t, got.Exporters)
 	assert.Empty(t, got.Service.Pipelines)
 
 	off := false
-	got = ProfilingPipelineConfig("odigos-system", &common.ProfilingConfiguration{Enabled: &off}, nil)
+	got = ProfilingPipelineConfig("odigos-system", &common.ProfilingConfiguration{Enabled: &off}, nil, nil)
 	assert.Empty(t, got.Service.Pipelines)
 }
 
 func TestProfilingPipelineConfig_Enabled(t *testing.T) {
 	on := true
-	got := ProfilingPipelineConfig("odigos-system", &common.ProfilingConfiguration{Enabled: &on}, nil)
+	got := ProfilingPipelineConfig("odigos-system", &common.ProfilingConfiguration{Enabled: &on}, nil, nil)
 	require.Contains(t, got.Receivers, commonconf.ProfilingReceiver)
 	require.Contains(t, got.Processors, commonconf.ProfilingNodeFilterProcessor)
 	require.Contains(t, got.Processors, commonconf.ProfilingNodeK8sAttributesProcessor)
@@ -62,7 +67,7 @@ func TestProfilingPipelineConfig_Enabled(t *testing.T) {
 func TestProfilingPipelineConfig_UserProcessorsAppended(t *testing.T) {
 	on := true
 	userProcessors := []string{"resource/addclusterinfo", "transform/rename"}
-	got := ProfilingPipelineConfig("odigos-system", &common.ProfilingConfiguration{Enabled: &on}, userProcessors)
+	got := ProfilingPipelineConfig("odigos-system", &common.ProfilingConfiguration{Enabled: &on}, nil, userProcessors)
 
 	pl, ok := got.Service.Pipelines["profiles"]
 	require.True(t, ok)
@@ -88,7 +93,7 @@ func TestProfilingPipelineConfig_NativeSymbolizationDisabled(t *testing.T) {
 	got := ProfilingPipelineConfig("odigos-system", &common.ProfilingConfiguration{
 		Enabled:       &on,
 		Symbolization: &common.ProfilingSymbolizationConfiguration{Native: &off},
-	}, nil)
+	}, nil, nil)
 	require.NotContains(t, got.Processors, commonconf.ProfilingNodeSymbolizeProcessor)
 
 	pl := got.Service.Pipelines["profiles"]
@@ -101,3 +106,70 @@ func TestProfilingPipelineConfig_NativeSymbolizationDisabled(t *testing.T) {
 		odigosTrafficMetricsProcessorName,
 	}, pl.Processors)
 }
+
+func TestProfilingPipelineConfig_ReceiverFromRules(t *testing.T) {
+	on := true
+	low, high := 19, 49
+	sources := &odigosv1.InstrumentationConfigList{Items: []odigosv1.InstrumentationConfig{
+		{Spec: odigosv1.InstrumentationConfigSpec{WorkloadCollectorConfig: []commonapi.ContainerCollectorConfig{
+			{ContainerName: "api", Profiling: &instrumentationrules.Profiling{SamplingFrequencyHz: &low}},
+		}}},
+		{Spec: odigosv1.InstrumentationConfigSpec{WorkloadCollectorConfig: []commonapi.ContainerCollectorConfig{
+			{ContainerName: "worker", Profiling: &instrumentationrules.Profiling{
+				ProfileTypes:        []instrumentationrules.ProfileType{instrumentationrules.ProfileTypeOffCpu},
+				SamplingFrequencyHz: &high,
+			}},
+			{ContainerName: "sidecar"},
+		}}},
+	}}
+
+	got := ProfilingPipelineConfig("odigos-system", &common.ProfilingConfiguration{Enabled: &on}, sources, nil)
+	assert.Equal(t, config.GenericMap{
+		"samples_per_second": high,
+		"off_cpu_threshold":  profilingOffCpuThreshold,
+	}, got.Receivers[commonconf.ProfilingReceiver])
+
+	// sources without profiling rules keep the profiler defaults
+	got = ProfilingPipelineConfig("odigos-system", &common.ProfilingConfiguration{Enabled: &on}, &odigosv1.InstrumentationConfigList{}, nil)
+	assert.Equal(t, config.GenericMap{}, got.Receivers[commonconf.ProfilingReceiver])
+}
+
+func TestProfilingPipelineConfig_ScopesProfileTypes(t *testing.T) {
+	on := true
+	sources := &odigosv1.InstrumentationConfigList{Items: []odigosv1.InstrumentationConfig{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "deployment-checkout", Namespace: "shop"},
+			Spec: odigosv1.InstrumentationConfigSpec{
+				Containers: []odigosv1.ContainerAgentConfig{{ContainerName: "app"}, {ContainerName: "sidecar"}},
+				WorkloadCollectorConfig: []commonapi.ContainerCollectorConfig{
+					{ContainerName: "app", Profiling: &instrumentationrules.Profiling{
+						ProfileTypes: []instrumentationrules.ProfileType{instrumentationrules.ProfileTypeOffCpu},
+					}},
+				},
+			},
+		},
+		{
+			// unscoped source of a kind without a workload attribute on the profiles is kept as is
+			ObjectMeta: metav1.ObjectMeta{Name: "rollout-cart", Namespace: "shop"},
+			Spec: odigosv1.InstrumentationConfigSpec{
+				Containers: []odigosv1.ContainerAgentConfig{{ContainerName: "app"}},
+			},
+		},
+	}}
+
+	got := ProfilingPipelineConfig("odigos-system", &common.ProfilingConfiguration{Enabled: &on}, sources, nil)
+
+	pl := got.Service.Pipelines["profiles"]
+	assert.Contains(t, pl.Processors, commonconf.ProfilingNodeScopeFilterProcessor)
+	// the scope is applied once the profiles carry their workload
+	assert.Greater(t, slices.Index(pl.Processors, commonconf.ProfilingNodeScopeFilterProcessor), slices.Index(pl.Processors, commonconf.ProfilingNodeK8sAttributesProcessor))
+	filterCfg := got.Processors[commonconf.ProfilingNodeScopeFilterProcessor].(config.GenericMap)
+	assert.Equal(t, []string{
+		`profile.sample_type.type == "samples" and resource.attributes["k8s.namespace.name"] == "shop" and resource.attributes["k8s.deployment.name"] == "checkout" and resource.attributes["k8s.container.name"] == "app"`,
+		`profile.sample_type.type == "off_cpu" and resource.attributes["k8s.namespace.name"] == "shop" and resource.attributes["k8s.deployment.name"] == "checkout" and resource.attributes["k8s.container.name"] == "sidecar"`,
+	}, filterCfg["profile_conditions"])
+
+	// without profiling rules nothing is dropped
+	got = ProfilingPipelineConfig("odigos-system", &common.ProfilingConfiguration{Enabled: &on}, &odigosv1.InstrumentationConfigList{Items: sources.Items[1:]}, nil)
+	assert.NotContains(t, got.Processors, commonconf.ProfilingNodeScopeFilterProcessor)
+}
@@ -1,6 +1,7 @@
 package collectorconfig
 
 import (
+	"fmt"
 	"slices"
 
 	"github.com/odigos-io/odigos/common"
@@ -22,6 +23,7 @@ var (
 	spanMetricsResourceRemoveDimensionsProcessorName = "resource/spanmetrics/remove-dimensions"
 	spanMetricsCopyScopeSpanMetricsProcessorName     = "transform/copy-scope-span-metrics"
 	odigosTraceFilterProcessorName                   = "odigos_trace_filter"
+	spanMetricsOdigosMetricsStoreExporterName        = "otlp_http/spanmetrics-odigos-victoriametrics"
 )
 
 func getSpanMetricsConnectorConfig(spanMetricsConfig common.MetricsSourceSpanMetricsConfiguration) config.GenericMap {
@@ -208,3 +210,27 @@ func GetSpanMetricsConfig(spanMetricsConfig common.MetricsSourceSpanMetricsConfi
 
 	return configDomain, additionalTraceExporters, additionalMetricsRecivers, postSpanMetricsProcessorNames
 }
+
+// AddSpanMetricsToOdigosMetricsStore sends the span metrics also to the odigos own metrics store,
+// where the UI evaluates them for anomalies.
+// The metrics are exported directly from the node collector, so they are not mixed into the
+// gateway own-metrics pipeline, which may forward to the user metrics destinations.
+func AddSpanMetricsToOdigosMetricsStore(spanMetricsConfig config.Config, odigosNamespace string) config.Config {
+	if spanMetricsConfig.Exporters == nil {
+		spanMetricsConfig.Exporters = config.GenericMap{}
+	}
+	spanMetricsConfig.Exporters[spanMetricsOdigosMetricsStoreExporterName] = config.GenericMap{
+		"endpoint": fmt.Sprintf("http://odigos-victoriametrics.%s:8428/opentelemetry", odigosNamespace),
+		"retry_on_failure": config.GenericMap{
+			"enabled": false,
+		},
+		"tls": config.GenericMap{
+			"insecure": true,
+		},
+	}
+
+	pipeline := spanMetricsConfig.Service.Pipelines[spanMetricsExportingPipelineName]
+	pipeline.Exporters = append(pipeline.Exporters, spanMetricsOdigosMetricsStoreExporterName)
+	spanMetricsConfig.Service.Pipelines[spanMetricsExportingPipelineName] = pipeline
+	return spanMetricsConfig
+}
@@ -0,0 +1,30 @@
+package collectorconfig
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/config"
+)
+
+func TestAddSpanMetricsToOdigosMetricsStore(t *testing.T) {
+	spanMetricsConfig, _, _, _ := GetSpanMetricsConfig(common.MetricsSourceSpanMetricsConfiguration{})
+	got := AddSpanMetricsToOdigosMetricsStore(spanMetricsConfig, "odigos-system")
+
+	exporter, exists := got.Exporters[spanMetricsOdigosMetricsStoreExporterName]
+	if !exists {
+		t.Fatalf("missing %s exporter", spanMetricsOdigosMetricsStoreExporterName)
+	}
+	wantEndpoint := "http://odigos-victoriametrics.odigos-system:8428/opentelemetry"
+	if endpoint := exporter.(config.GenericMap)["endpoint"]; endpoint != wantEndpoint {
+		t.Fatalf("endpoint want %s, got %v", wantEndpoint, endpoint)
+	}
+
+	pipeline := got.Service.Pipelines[spanMetricsExportingPipelineName]
+	if !contains(pipeline.Exporters, clusterCollectorMetricsExporterName) {
+		t.Fatal("span metrics must still be exported to the cluster collector")
+	}
+	if !contains(pipeline.Exporters, spanMetricsOdigosMetricsStoreExporterName) {
+		t.Fatal("span metrics exporting pipeline must export to the odigos metrics store")
+	}
+}
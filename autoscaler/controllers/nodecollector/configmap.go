@@ -245,6 +245,9 @@ func calculateCollectorConfigDomains(
 			// once finer control is implemented as to what resource attributes are included in the metrics pipeline,
 			// we can send span metrics back into the normal metrics pipeline.
 			// additionalMetricsReceivers = append(additionalMetricsReceivers, additionalSpanMetricsMetricsReceivers...)
+			if metricsConfigSettings.OdigosOwnMetrics != nil && metricsConfigSettings.OdigosOwnMetrics.SendSpanMetricsToOdigosMetricsStore {
+				spanMetricsConfig = collectorconfig.AddSpanMetricsToOdigosMetricsStore(spanMetricsConfig, odigosNamespace)
+			}
 			configDomains["span_metrics"] = spanMetricsConfig
 		}
 
@@ -267,7 +270,12 @@ func calculateCollectorConfigDomains(
 		// Pass only when the user already enabled kubeletstats (metrics destination).
 		// Own-metrics must not start a kubelet scrape on its own.
 		if metricsEnabled && nodeCG.Spec.Metrics.KubeletStats != nil {
-			odigletMetrics = collectorconfig.AddKubeletStatsToOwnMetrics(odigletMetrics, odigosNamespace)
+			odigletMetrics = collectorconfig.AddKubeletStatsToOwnMetrics(odigletMetrics, odigosNamespace,
+				nodeCG.Spec.Metrics.OdigosOwnMetrics.SendWorkloadsKubeletStatsToOdigosMetricsStore)
+		}
+		// The agents only report metrics to the node collector when agents telemetry is collected.
+		if nodeCG.Spec.Metrics.AgentsTelemetry != nil && nodeCG.Spec.Metrics.OdigosOwnMetrics.SendAgentRuntimeMetricsToOdigosMetricsStore {
+			odigletMetrics = collectorconfig.AddAgentRuntimeMetricsToOwnMetrics(odigletMetrics)
 		}
 		configDomains["odiglet_metrics"] = odigletMetrics
 	}
@@ -301,7 +309,7 @@ func calculateCollectorConfigDomains(
 	// The profiling pipeline's receiver is enterprise-only, so community tier never gets one
 	// regardless of what OdigosConfiguration asks for.
 	if tier.IsEnterprise() && odigoscommon.ProfilingPipelineActive(profiling) {
-		configDomains["profiling"] = collectorconfig.ProfilingPipelineConfig(odigosNamespace, profiling, processorsResults.ProfilesProcessors)
+		configDomains["profiling"] = collectorconfig.ProfilingPipelineConfig(odigosNamespace, profiling, sources, processorsResults.ProfilesProcessors)
 	}
 
 	mergedConfig, err := config.MergeConfigs(configDomains)
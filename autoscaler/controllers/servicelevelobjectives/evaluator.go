@@ -0,0 +1,177 @@
+package servicelevelobjectives
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/k8sutils/pkg/metricsstore"
+)
+
+const (
+	evaluationInterval = time.Minute
+
+	// statusRefreshInterval is how often the values in the status are written while the conditions stay the same,
+	// so the objectives are not written on every evaluation.
+	statusRefreshInterval = 10 * time.Minute
+
+	// maxSLIWindow is the budget period the burn rate alert thresholds are defined for.
+	maxSLIWindow = 30 * 24 * time.Hour
+)
+
+// burnAlert is one of the multi-window burn rate alerts: it fires while the burn rate crosses the threshold
+// over both the long window, so it is significant, and the short window, so it is still ongoing.
+type burnAlert struct {
+	conditionType string
+	long          time.Duration
+	short         time.Duration
+	threshold     float64
+}
+
+var burnAlerts = []burnAlert{
+	// 2% of a 30 days budget in an hour
+	{conditionType: odigosv1.ServiceLevelObjectiveFastBurnConditionType, long: time.Hour, short: 5 * time.Minute, threshold: 14.4},
+	// 5% of a 30 days budget in 6 hours
+	{conditionType: odigosv1.ServiceLevelObjectiveSlowBurnConditionType, long: 6 * time.Hour, short: 30 * time.Minute, threshold: 6},
+}
+
+// burnRateWindows are the windows reported in the status, shortest first.
+var burnRateWindows = []time.Duration{5 * time.Minute, 30 * time.Minute, time.Hour, 6 * time.Hour}
+
+// sliWindowFor returns the period the SLI is computed over: the whole retention of the metrics store,
+// up to the budget period.
+func sliWindowFor(retention time.Duration) time.Duration {
+	return min(retention, maxSLIWindow)
+}
+
+// evaluate returns the status of the objective at now.
+// api is nil when the metrics store is disabled.
+func evaluate(ctx context.Context, api v1.API, slo *odigosv1.ServiceLevelObjective, sliWindow time.Duration, now time.Time) odigosv1.ServiceLevelObjectiveStatus {
+	if err := validateSpec(&slo.Spec); err != nil {
+		return notEvaluatedStatus(slo, now, odigosv1.ServiceLevelObjectiveReasonInvalidObjective, err.Error())
+	}
+	if api == nil {
+		return notEvaluatedStatus(slo, now, odigosv1.ServiceLevelObjectiveReasonMetricsStoreUnavailable, "the odigos metrics store is disabled")
+	}
+
+	badRatios := map[time.Duration]float64{}
+	windows := append([]time.Duration{sliWindow}, burnRateWindows...)
+	for _, window := range windows {
+		ratio, err := queryBadRatio(ctx, api, &slo.Spec, window, now)
+		if errors.Is(err, errNoData) {
+			continue
+		}
+		if err != nil {
+			return notEvaluatedStatus(slo, now, odigosv1.ServiceLevelObjectiveReasonMetricsStoreUnavailable, err.Error())
+		}
+		badRatios[window] = ratio
+	}
+	return computeStatus(slo, badRatios, sliWindow, now)
+}
+
+// computeStatus derives the status of the objective from the ratio of bad calls in each window.
+// Windows without calls are missing from badRatios.
+func computeStatus(slo *odigosv1.ServiceLevelObjective, badRatios map[time.Duration]float64, sliWindow time.Duration, now time.Time) odigosv1.ServiceLevelObjectiveStatus {
+	sliBadRatio, ok := badRatios[sliWindow]
+	if !ok {
+		return notEvaluatedStatus(slo, now, odigosv1.ServiceLevelObjectiveReasonNoData,
+			"no calls of the operation were recorded in the span metrics, which are calculated only while a metrics destination is configured")
+	}
+
+	budget := 1 - slo.Spec.Target/100
+	status := *slo.Status.DeepCopy()
+	status.SLI = ptrTo(round(100 * (1 - sliBadRatio)))
+	status.SLIWindow = metricsstore.PromDuration(sliWindow)
+	status.ErrorBudgetRemaining = ptrTo(round(100 * (1 - sliBadRatio/budget)))
+	status.LastEvaluationTime = &metav1.Time{Time: now}
+
+	status.BurnRates = nil
+	for _, window := range burnRateWindows {
+		if ratio, ok := badRatios[window]; ok {
+			status.BurnRates = append(status.BurnRates, odigosv1.ServiceLevelObjectiveBurnRate{
+				Window:   metricsstore.PromDuration(window),
+				BurnRate: round(ratio / budget),
+			})
+		}
+	}
+
+	setCondition(&status, slo, odigosv1.ServiceLevelObjectiveEvaluatedConditionType, metav1.ConditionTrue,
+		odigosv1.ServiceLevelObjectiveReasonEvaluated, fmt.Sprintf("%s SLI is %.4g%% over the last %s", slo.Spec.Type, *status.SLI, status.SLIWindow))
+	for _, alert := range burnAlerts {
+		long, longOk := badRatios[alert.long]
+		short, shortOk := badRatios[alert.short]
+		if !longOk || !shortOk {
+			setCondition(&status, slo, alert.conditionType, metav1.ConditionUnknown, odigosv1.ServiceLevelObjectiveReasonNoData,
+				fmt.Sprintf("no calls recorded in the last %s", metricsstore.PromDuration(alert.short)))
+			continue
+		}
+		longRate, shortRate := long/budget, short/budget
+		message := fmt.Sprintf("burn rate is %.3g over %s and %.3g over %s, the threshold is %g",
+			longRate, metricsstore.PromDuration(alert.long), shortRate, metricsstore.PromDuration(alert.short), alert.threshold)
+		if longRate >= alert.threshold && shortRate >= alert.threshold {
+			setCondition(&status, slo, alert.conditionType, metav1.ConditionTrue, odigosv1.ServiceLevelObjectiveReasonBurnRateExceeded, message)
+		} else {
+			setCondition(&status, slo, alert.conditionType, metav1.ConditionFalse, odigosv1.ServiceLevelObjectiveReasonWithinBudget, message)
+		}
+	}
+	return status
+}
+
+// notEvaluatedStatus clears the computed values, since stale ones would be misleading, and records why.
+func notEvaluatedStatus(slo *odigosv1.ServiceLevelObjective, now time.Time, reason odigosv1.ServiceLevelObjectiveReason, message string) odigosv1.ServiceLevelObjectiveStatus {
+	status := odigosv1.ServiceLevelObjectiveStatus{
+		Conditions:         slo.Status.DeepCopy().Conditions,
+		LastEvaluationTime: &metav1.Time{Time: now},
+	}
+	setCondition(&status, slo, odigosv1.ServiceLevelObjectiveEvaluatedConditionType, metav1.ConditionFalse, reason, message)
+	for _, alert := range burnAlerts {
+		setCondition(&status, slo, alert.conditionType, metav1.ConditionUnknown, reason, "the objective was not evaluated")
+	}
+	return status
+}
+
+// needsStatusWrite reports whether the evaluated status should be written over the current one:
+// when a condition changed, or the values were not refreshed for the refresh interval.
+func needsStatusWrite(current, evaluated *odigosv1.ServiceLevelObjectiveStatus, now time.Time) bool {
+	if current.LastEvaluationTime == nil || now.Sub(current.LastEvaluationTime.Time) >= statusRefreshInterval {
+		return true
+	}
+	if len(current.Conditions) != len(evaluated.Conditions) {
+		return true
+	}
+	for _, condition := range evaluated.Conditions {
+		existing := meta.FindStatusCondition(current.Conditions, condition.Type)
+		if existing == nil || existing.Status != condition.Status || existing.Reason != condition.Reason ||
+			existing.ObservedGeneration != condition.ObservedGeneration {
+			return true
+		}
+	}
+	return false
+}
+
+func setCondition(status *odigosv1.ServiceLevelObjectiveStatus, slo *odigosv1.ServiceLevelObjective, conditionType string,
+	conditionStatus metav1.ConditionStatus, reason odigosv1.ServiceLevelObjectiveReason, message string) {
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             conditionStatus,
+		ObservedGeneration: slo.Generation,
+		Reason:             string(reason),
+		Message:            message,
+	})
+}
+
+// round keeps 4 decimal places, enough for a 99.99% objective without noise in the status.
+func round(value float64) float64 {
+	return math.Round(value*1e4) / 1e4
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
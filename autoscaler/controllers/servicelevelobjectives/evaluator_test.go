@@ -0,0 +1,139 @@
+package servicelevelobjectives
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+)
+
+var now = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+var sliWindow = sliWindowFor(common.DefaultMetricsStoreRetention)
+
+func availabilitySLO(target float64) *odigosv1.ServiceLevelObjective {
+	return &odigosv1.ServiceLevelObjective{
+		ObjectMeta: metav1.ObjectMeta{Name: "checkout-availability", Generation: 2},
+		Spec: odigosv1.ServiceLevelObjectiveSpec{
+			Workload: k8sconsts.PodWorkload{Namespace: "shop", Kind: k8sconsts.WorkloadKindDeployment, Name: "checkout"},
+			Type:     odigosv1.ServiceLevelObjectiveTypeAvailability,
+			Target:   target,
+		},
+	}
+}
+
+func TestComputeStatus_FastBurn(t *testing.T) {
+	slo := availabilitySLO(99)
+	status := computeStatus(slo, map[time.Duration]float64{
+		sliWindow:        0.005,
+		5 * time.Minute:  0.2,
+		30 * time.Minute: 0.05,
+		time.Hour:        0.15,
+		6 * time.Hour:    0.03,
+	}, sliWindow, now)
+
+	require.InDelta(t, 99.5, *status.SLI, 1e-9)
+	require.Equal(t, "24h", status.SLIWindow)
+	require.InDelta(t, 50, *status.ErrorBudgetRemaining, 1e-9)
+	require.Len(t, status.BurnRates, 4)
+	require.Equal(t, "5m", status.BurnRates[0].Window)
+	require.InDelta(t, 20, status.BurnRates[0].BurnRate, 1e-9)
+	require.Equal(t, "6h", status.BurnRates[3].Window)
+
+	evaluated := meta.FindStatusCondition(status.Conditions, odigosv1.ServiceLevelObjectiveEvaluatedConditionType)
+	require.Equal(t, metav1.ConditionTrue, evaluated.Status)
+	require.Equal(t, int64(2), evaluated.ObservedGeneration)
+
+	fastBurn := meta.FindStatusCondition(status.Conditions, odigosv1.ServiceLevelObjectiveFastBurnConditionType)
+	require.Equal(t, metav1.ConditionTrue, fastBurn.Status)
+	require.Equal(t, string(odigosv1.ServiceLevelObjectiveReasonBurnRateExceeded), fastBurn.Reason)
+
+	// 3 over 6h and 5 over 30m are both below 6
+	slowBurn := meta.FindStatusCondition(status.Conditions, odigosv1.ServiceLevelObjectiveSlowBurnConditionType)
+	require.Equal(t, metav1.ConditionFalse, slowBurn.Status)
+}
+
+func TestComputeStatus_ShortWindowRecovered(t *testing.T) {
+	// the long window still burns fast, but the last 5 minutes are fine, so the alert is over
+	status := computeStatus(availabilitySLO(99), map[time.Duration]float64{
+		sliWindow:        0.02,
+		5 * time.Minute:  0,
+		30 * time.Minute: 0.1,
+		time.Hour:        0.2,
+		6 * time.Hour:    0.07,
+	}, sliWindow, now)
+
+	require.InDelta(t, -100, *status.ErrorBudgetRemaining, 1e-9)
+	require.False(t, meta.IsStatusConditionTrue(status.Conditions, odigosv1.ServiceLevelObjectiveFastBurnConditionType))
+	require.True(t, meta.IsStatusConditionTrue(status.Conditions, odigosv1.ServiceLevelObjectiveSlowBurnConditionType))
+}
+
+func TestComputeStatus_NoData(t *testing.T) {
+	slo := availabilitySLO(99)
+	slo.Status.SLI = ptrTo(99.0)
+	slo.Status.BurnRates = []odigosv1.ServiceLevelObjectiveBurnRate{{Window: "5m", BurnRate: 1}}
+
+	status := computeStatus(slo, map[time.Duration]float64{}, sliWindow, now)
+
+	require.Nil(t, status.SLI)
+	require.Empty(t, status.BurnRates)
+	evaluated := meta.FindStatusCondition(status.Conditions, odigosv1.ServiceLevelObjectiveEvaluatedConditionType)
+	require.Equal(t, metav1.ConditionFalse, evaluated.Status)
+	require.Equal(t, string(odigosv1.ServiceLevelObjectiveReasonNoData), evaluated.Reason)
+	require.True(t, meta.IsStatusConditionPresentAndEqual(status.Conditions, odigosv1.ServiceLevelObjectiveFastBurnConditionType, metav1.ConditionUnknown))
+}
+
+func TestComputeStatus_MissingShortWindow(t *testing.T) {
+	status := computeStatus(availabilitySLO(99.9), map[time.Duration]float64{
+		sliWindow:     0.0005,
+		time.Hour:     0.05,
+		6 * time.Hour: 0.001,
+	}, sliWindow, now)
+
+	require.InDelta(t, 50, *status.ErrorBudgetRemaining, 1e-9)
+	require.Len(t, status.BurnRates, 2)
+	require.True(t, meta.IsStatusConditionPresentAndEqual(status.Conditions, odigosv1.ServiceLevelObjectiveFastBurnConditionType, metav1.ConditionUnknown))
+}
+
+func TestSLIWindowFor(t *testing.T) {
+	require.Equal(t, 24*time.Hour, sliWindowFor(24*time.Hour))
+	require.Equal(t, 7*24*time.Hour, sliWindowFor(7*24*time.Hour))
+	require.Equal(t, maxSLIWindow, sliWindowFor(90*24*time.Hour))
+}
+
+func TestNeedsStatusWrite(t *testing.T) {
+	slo := availabilitySLO(99)
+	ratios := map[time.Duration]float64{
+		sliWindow:        0.005,
+		5 * time.Minute:  0.001,
+		30 * time.Minute: 0.001,
+		time.Hour:        0.001,
+		6 * time.Hour:    0.001,
+	}
+	slo.Status = computeStatus(slo, ratios, sliWindow, now)
+
+	// only the values moved: the status is not written until the refresh interval passes
+	ratios[sliWindow] = 0.006
+	later := now.Add(time.Minute)
+	evaluated := computeStatus(slo, ratios, sliWindow, later)
+	require.False(t, needsStatusWrite(&slo.Status, &evaluated, later))
+
+	refresh := now.Add(statusRefreshInterval)
+	evaluated = computeStatus(slo, ratios, sliWindow, refresh)
+	require.True(t, needsStatusWrite(&slo.Status, &evaluated, refresh))
+
+	// a condition changed: the status is written right away
+	ratios[5*time.Minute] = 0.2
+	ratios[time.Hour] = 0.2
+	evaluated = computeStatus(slo, ratios, sliWindow, later)
+	require.True(t, needsStatusWrite(&slo.Status, &evaluated, later))
+
+	// never evaluated
+	require.True(t, needsStatusWrite(&odigosv1.ServiceLevelObjectiveStatus{}, &evaluated, later))
+}
@@ -0,0 +1,116 @@
+package servicelevelobjectives
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	prommodel "github.com/prometheus/common/model"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/k8sutils/pkg/metricsstore"
+)
+
+// errNoData is returned when the span metrics have no calls of the objective operation in the window.
+var errNoData = errors.New("no calls recorded")
+
+// httpMethodLabels are the span metrics dimensions of the http method, in the old and the new semantic conventions.
+var httpMethodLabels = []string{"http.request.method", "http.method"}
+
+// validateSpec returns an error describing why the objective cannot be measured from the span metrics.
+func validateSpec(spec *odigosv1.ServiceLevelObjectiveSpec) error {
+	if spec.Type == odigosv1.ServiceLevelObjectiveTypeLatency && spec.LatencyThresholdMs == nil {
+		return errors.New("latency objectives require latencyThresholdMs")
+	}
+	if spec.Operation != nil && (spec.Operation.KafkaConsumer != nil || spec.Operation.KafkaProducer != nil) {
+		return errors.New("only http server operations are recorded in the span metrics")
+	}
+	if spec.Target <= 0 || spec.Target >= 100 {
+		return fmt.Errorf("target %g must be between 0 and 100", spec.Target)
+	}
+	return nil
+}
+
+// selectors returns the label matchers of the objective operation.
+// Objectives measure server spans, which represent the calls a source serves.
+// A series carries the workload either as the k8s workload name or as the odigos workload attributes,
+// and the method under one of the http method labels, so each combination is a separate selector.
+// The same series matched by several selectors is counted once, since they are joined with "or".
+func selectors(spec *odigosv1.ServiceLevelObjectiveSpec) []string {
+	common := []string{
+		metricsstore.ServerSpanKindMatcher,
+		fmt.Sprintf(`k8s.namespace.name=%q`, spec.Workload.Namespace),
+	}
+	var method string
+	if spec.Operation != nil && spec.Operation.HttpServer != nil {
+		httpServer := spec.Operation.HttpServer
+		if httpServer.Route != "" {
+			common = append(common, fmt.Sprintf(`http.route=%q`, httpServer.Route))
+		} else if httpServer.RoutePrefix != "" {
+			common = append(common, fmt.Sprintf(`http.route=~%q`, regexp.QuoteMeta(httpServer.RoutePrefix)+".*"))
+		}
+		method = httpServer.Method
+	}
+
+	var out []string
+	for _, workloadMatcher := range metricsstore.WorkloadMatchers(spec.Workload.Kind, spec.Workload.Name) {
+		if method == "" {
+			out = append(out, strings.Join(append(append([]string{}, common...), workloadMatcher), ", "))
+			continue
+		}
+		for _, methodLabel := range httpMethodLabels {
+			matchers := append(append([]string{}, common...), workloadMatcher, fmt.Sprintf(`%s=%q`, methodLabel, strings.ToUpper(method)))
+			out = append(out, strings.Join(matchers, ", "))
+		}
+	}
+	return out
+}
+
+// rateOf returns the union of the rates of the metric over all the selectors.
+func rateOf(metricName string, selectors []string, extraMatcher string, window time.Duration) string {
+	rates := make([]string, 0, len(selectors))
+	for _, selector := range selectors {
+		if extraMatcher != "" {
+			selector += ", " + extraMatcher
+		}
+		rates = append(rates, fmt.Sprintf(`rate({__name__=~"%s", %s}[%s])`, metricName, selector, metricsstore.PromDuration(window)))
+	}
+	return strings.Join(rates, " or ")
+}
+
+// badRatioQuery returns the expression of the ratio (0..1) of bad calls of the objective over the window.
+// Latency objectives use the MetricsQL histogram_share of the odigos metrics store,
+// which interpolates the share of calls under the threshold within the histogram buckets.
+func badRatioQuery(spec *odigosv1.ServiceLevelObjectiveSpec, window time.Duration) string {
+	sel := selectors(spec)
+	if spec.Type == odigosv1.ServiceLevelObjectiveTypeLatency {
+		buckets := rateOf(metricsstore.SpanMetricsDurationBucketName, sel, "", window)
+		return fmt.Sprintf(`1 - histogram_share(%d, sum by (le) (%s))`, *spec.LatencyThresholdMs, buckets)
+	}
+	total := rateOf(metricsstore.SpanMetricsCallsName, sel, "", window)
+	errorCalls := rateOf(metricsstore.SpanMetricsCallsName, sel, metricsstore.ErrorStatusMatcher, window)
+	// an operation without errors has no error series at all
+	return fmt.Sprintf(`(sum(%s) or vector(0)) / sum(%s)`, errorCalls, total)
+}
+
+// queryBadRatio returns the ratio of bad calls of the objective over the window.
+func queryBadRatio(ctx context.Context, api v1.API, spec *odigosv1.ServiceLevelObjectiveSpec, window time.Duration, now time.Time) (float64, error) {
+	val, _, err := api.Query(ctx, badRatioQuery(spec, window), now)
+	if err != nil {
+		return 0, err
+	}
+	vec, ok := val.(prommodel.Vector)
+	if !ok || len(vec) == 0 {
+		return 0, errNoData
+	}
+	ratio := float64(vec[0].Value)
+	if math.IsNaN(ratio) || math.IsInf(ratio, 0) {
+		return 0, errNoData
+	}
+	return math.Min(math.Max(ratio, 0), 1), nil
+}
@@ -0,0 +1,62 @@
+package servicelevelobjectives
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	commonapisampling "github.com/odigos-io/odigos/common/api/sampling"
+)
+
+func TestValidateSpec(t *testing.T) {
+	slo := availabilitySLO(99.9)
+	require.NoError(t, validateSpec(&slo.Spec))
+
+	slo.Spec.Type = odigosv1.ServiceLevelObjectiveTypeLatency
+	require.ErrorContains(t, validateSpec(&slo.Spec), "latencyThresholdMs")
+
+	threshold := 250
+	slo.Spec.LatencyThresholdMs = &threshold
+	slo.Spec.Operation = &commonapisampling.TailSamplingOperationMatcher{
+		KafkaConsumer: &commonapisampling.TailSamplingKafkaOperationMatcher{KafkaTopic: "orders"},
+	}
+	require.ErrorContains(t, validateSpec(&slo.Spec), "http server")
+}
+
+func TestSelectors(t *testing.T) {
+	slo := availabilitySLO(99)
+	slo.Spec.Operation = &commonapisampling.TailSamplingOperationMatcher{
+		HttpServer: &commonapisampling.TailSamplingHttpServerOperationMatcher{RoutePrefix: "/api/v1.0", Method: "post"},
+	}
+
+	got := selectors(&slo.Spec)
+
+	// odigos workload attributes or deployment name, times the two http method labels
+	require.Len(t, got, 4)
+	for _, selector := range got {
+		require.Contains(t, selector, `k8s.namespace.name="shop"`)
+		require.Contains(t, selector, `http.route=~"/api/v1\\.0.*"`)
+		require.Contains(t, selector, `="POST"`)
+	}
+	require.Contains(t, got[0], `odigos.workload.name="checkout"`)
+	require.Contains(t, got[2], `k8s.deployment.name="checkout"`)
+}
+
+func TestBadRatioQuery(t *testing.T) {
+	slo := availabilitySLO(99)
+	availability := badRatioQuery(&slo.Spec, time.Hour)
+	require.True(t, strings.HasPrefix(availability, "(sum(rate("))
+	require.Contains(t, availability, `status.code="STATUS_CODE_ERROR"}[1h])`)
+	require.Contains(t, availability, " or vector(0)) / sum(")
+
+	threshold := 250
+	slo.Spec.Type = odigosv1.ServiceLevelObjectiveTypeLatency
+	slo.Spec.LatencyThresholdMs = &threshold
+	latency := badRatioQuery(&slo.Spec, 5*time.Minute)
+	require.True(t, strings.HasPrefix(latency, "1 - histogram_share(250, sum by (le) (rate("))
+	require.Contains(t, latency, "_bucket")
+	require.Contains(t, latency, "[5m])")
+}
@@ -0,0 +1,67 @@
+package servicelevelobjectives
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/k8sutils/pkg/env"
+	"github.com/odigos-io/odigos/k8sutils/pkg/metricsstore"
+	k8sutils "github.com/odigos-io/odigos/k8sutils/pkg/utils"
+)
+
+// ServiceLevelObjectiveReconciler computes the SLI and burn rates of each service level objective
+// from the span metrics in the odigos metrics store, and records them in its status.
+// Every objective is evaluated once per evaluation interval, but its status is only written
+// when a condition changes or the values are due for a refresh.
+type ServiceLevelObjectiveReconciler struct {
+	client.Client
+	// API queries the odigos metrics store.
+	API v1.API
+}
+
+func (r *ServiceLevelObjectiveReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	slo := &odigosv1.ServiceLevelObjective{}
+	if err := r.Get(ctx, req.NamespacedName, slo); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	odigosConfiguration, err := k8sutils.GetCurrentOdigosConfiguration(ctx, r.Client)
+	if err != nil {
+		return k8sutils.K8SNoEffectiveConfigErrorHandler(err)
+	}
+	api := r.API
+	if ownTelemetry := odigosConfiguration.OdigosOwnTelemetryStore; ownTelemetry != nil &&
+		ownTelemetry.MetricsStoreDisabled != nil && *ownTelemetry.MetricsStoreDisabled {
+		api = nil
+	}
+	sliWindow := sliWindowFor(common.MetricsStoreRetention(odigosConfiguration.OdigosOwnTelemetryStore))
+
+	now := time.Now()
+	status := evaluate(ctx, api, slo, sliWindow, now)
+	if needsStatusWrite(&slo.Status, &status, now) {
+		slo.Status = status
+		if err := r.Status().Update(ctx, slo); err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+	}
+	return ctrl.Result{RequeueAfter: evaluationInterval}, nil
+}
+
+func SetupWithManager(mgr ctrl.Manager) error {
+	api, err := metricsstore.NewAPI(env.GetCurrentNamespace())
+	if err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("servicelevelobjectives").
+		For(&odigosv1.ServiceLevelObjective{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(&ServiceLevelObjectiveReconciler{Client: mgr.GetClient(), API: api})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/odigos-io/odigos/api/k8sconsts"
 	"github.com/odigos-io/odigos/cli/cmd/resources"
@@ -16,6 +17,11 @@ import (
 var (
 	describeNamespaceFlag string
 	describeRemoteFlag    bool
+
+	serviceMapDiffBaselineFromFlag string
+	serviceMapDiffBaselineToFlag   string
+	serviceMapDiffFromFlag         string
+	serviceMapDiffToFlag           string
 )
 
 var describeCmd = &cobra.Command{
@@ -244,6 +250,65 @@ var describeSourceRolloutCmd = &cobra.Command{
 	},
 }
 
+var describeServiceMapDiffCmd = &cobra.Command{
+	Use:   "servicemap-diff",
+	Short: "Show service map edges which appeared, disappeared or changed between two time windows",
+	Long: `Compare the service map between a baseline and a current time window, for example before and after a deploy.
+Edges which appeared, disappeared, or changed significantly in request rate, error rate or p95 latency are listed.
+The service map is kept by the odigos ui service for the last hour, so this command always uses the ui service in the cluster.
+Times can be given as RFC3339 timestamps or as durations relative to now (e.g. "30m" for 30 minutes ago).`,
+	Example: `
+# Compare the last 10 minutes with the 10 minutes before them
+odigos describe servicemap-diff
+
+# Compare the last 5 minutes with a baseline window before a deploy that happened 20 minutes ago
+odigos describe servicemap-diff --baseline-from 35m --baseline-to 20m --from 5m
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		client := cmdcontext.KubeClientFromContextOrExit(ctx)
+
+		odigosNs, err := resources.GetOdigosNamespace(client, ctx)
+		if err != nil {
+			fmt.Println("\033[31mERROR\033[0m Odigos is NOT yet installed in the current cluster")
+			os.Exit(1)
+		}
+
+		uiSvcProxyEndpoint := fmt.Sprintf("/api/v1/namespaces/%s/services/%s:%d/proxy/api/describe/servicemap/diff", odigosNs, k8sconsts.OdigosUiServiceName, k8sconsts.OdigosUiServicePort)
+		request := client.Clientset.RESTClient().Get().AbsPath(uiSvcProxyEndpoint)
+
+		now := time.Now()
+		for param, flagValue := range map[string]string{
+			"baselineFrom": serviceMapDiffBaselineFromFlag,
+			"baselineTo":   serviceMapDiffBaselineToFlag,
+			"from":         serviceMapDiffFromFlag,
+			"to":           serviceMapDiffToFlag,
+		} {
+			t, err := parseServiceMapDiffTime(flagValue, now)
+			if err != nil {
+				fmt.Printf("\033[31mERROR\033[0m invalid %s time: %s\n", param, err)
+				os.Exit(1)
+			}
+			request = request.Param(param, t.Format(time.RFC3339))
+		}
+
+		response, err := request.Do(ctx).Raw()
+		if err != nil {
+			fmt.Println("Remote describe failed: " + err.Error())
+			return
+		}
+		fmt.Println(string(response))
+	},
+}
+
+// parseServiceMapDiffTime accepts either an RFC3339 timestamp, or a duration which is subtracted from now.
+func parseServiceMapDiffTime(value string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
 func executeRemoteOdigosDescribe(ctx context.Context, client *kube.Client, odigosNs string) string {
 	uiSvcProxyEndpoint := fmt.Sprintf("/api/v1/namespaces/%s/services/%s:%d/proxy/api/describe/odigos", odigosNs, k8sconsts.OdigosUiServiceName, k8sconsts.OdigosUiServicePort)
 	request := client.Clientset.RESTClient().Get().AbsPath(uiSvcProxyEndpoint).Do(ctx)
@@ -285,6 +350,13 @@ func init() {
 	rootCmd.AddCommand(describeCmd)
 	describeCmd.PersistentFlags().BoolVarP(&describeRemoteFlag, "remote", "r", false, "use odigos ui service in the cluster to describe the entity")
 
+	// service map diff
+	describeCmd.AddCommand(describeServiceMapDiffCmd)
+	describeServiceMapDiffCmd.Flags().StringVar(&serviceMapDiffBaselineFromFlag, "baseline-from", "20m", "start of the baseline window, RFC3339 or duration ago")
+	describeServiceMapDiffCmd.Flags().StringVar(&serviceMapDiffBaselineToFlag, "baseline-to", "10m", "end of the baseline window, RFC3339 or duration ago")
+	describeServiceMapDiffCmd.Flags().StringVar(&serviceMapDiffFromFlag, "from", "10m", "start of the current window, RFC3339 or duration ago")
+	describeServiceMapDiffCmd.Flags().StringVar(&serviceMapDiffToFlag, "to", "0s", "end of the current window, RFC3339 or duration ago")
+
 	// source
 	describeCmd.AddCommand(describeSourceCmd)
 	describeSourceCmd.PersistentFlags().StringVarP(&describeNamespaceFlag, "namespace", "n", "default", "namespace of the source being described")
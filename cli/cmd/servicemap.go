@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	"github.com/odigos-io/odigos/cli/cmd/resources"
+	cmdcontext "github.com/odigos-io/odigos/cli/pkg/cmd_context"
+	"github.com/spf13/cobra"
+)
+
+var (
+	serviceMapExportFormatFlag string
+	serviceMapExportOwnerFlag  string
+	serviceMapExportOutputFlag string
+)
+
+var serviceMapCmd = &cobra.Command{
+	Use:     "service-map",
+	Aliases: []string{"servicemap"},
+	Short:   "Work with the service dependency graph observed by odigos",
+}
+
+var serviceMapExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the service dependency graph as Graphviz, Mermaid or Backstage catalog entities",
+	Long: `Export the service dependency graph, as observed from the traffic of the instrumented services.
+Supported formats:
+  dot        Graphviz DOT
+  mermaid    Mermaid flowchart
+  backstage  Backstage catalog Component and API entities, with dependsOn relations`,
+	Example: `
+# Render the service map as an image with graphviz
+odigos service-map export --format dot | dot -Tpng -o service-map.png
+
+# Write backstage catalog entities owned by the platform team
+odigos service-map export --format backstage --owner platform-team -o catalog-info.yaml
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		client := cmdcontext.KubeClientFromContextOrExit(ctx)
+
+		odigosNs, err := resources.GetOdigosNamespace(client, ctx)
+		if err != nil {
+			fmt.Println("\033[31mERROR\033[0m Odigos is NOT yet installed in the current cluster")
+			os.Exit(1)
+		}
+
+		uiSvcProxyEndpoint := fmt.Sprintf("/api/v1/namespaces/%s/services/%s:%d/proxy/api/servicemap/export", odigosNs, k8sconsts.OdigosUiServiceName, k8sconsts.OdigosUiServicePort)
+		request := client.Clientset.RESTClient().Get().AbsPath(uiSvcProxyEndpoint).Param("format", serviceMapExportFormatFlag)
+		if serviceMapExportOwnerFlag != "" {
+			request = request.Param("owner", serviceMapExportOwnerFlag)
+		}
+		response, err := request.Do(ctx).Raw()
+		if err != nil {
+			fmt.Printf("\033[31mERROR\033[0m Failed to export the service map: %s\n", err)
+			os.Exit(1)
+		}
+
+		if serviceMapExportOutputFlag == "" {
+			fmt.Print(string(response))
+			return
+		}
+		if err := os.WriteFile(serviceMapExportOutputFlag, response, 0644); err != nil {
+			fmt.Printf("\033[31mERROR\033[0m Failed to write %s: %s\n", serviceMapExportOutputFlag, err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serviceMapCmd)
+	serviceMapCmd.AddCommand(serviceMapExportCmd)
+	serviceMapExportCmd.Flags().StringVarP(&serviceMapExportFormatFlag, "format", "f", "dot", "export format: dot, mermaid or backstage")
+	serviceMapExportCmd.Flags().StringVar(&serviceMapExportOwnerFlag, "owner", "", "owner of the generated backstage entities")
+	serviceMapExportCmd.Flags().StringVarP(&serviceMapExportOutputFlag, "output", "o", "", "file to write the export to, defaults to stdout")
+}
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/cli/cmd/resources"
+	cmdcontext "github.com/odigos-io/odigos/cli/pkg/cmd_context"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/spf13/cobra"
+)
+
+var sloCmd = &cobra.Command{
+	Use:     "slo",
+	Aliases: []string{"service-level-objectives"},
+	Short:   "Work with the service level objectives of odigos sources",
+}
+
+var sloListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the service level objectives with their SLI, remaining error budget and burn rates",
+	Long: `List the ServiceLevelObjective resources in the odigos namespace.
+The SLI and burn rates are computed by odigos from the span metrics in its own metrics store.`,
+	Example: `
+# List all the service level objectives
+odigos slo list
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		client := cmdcontext.KubeClientFromContextOrExit(ctx)
+
+		odigosNs, err := resources.GetOdigosNamespace(client, ctx)
+		if err != nil {
+			fmt.Println("\033[31mERROR\033[0m Odigos is NOT yet installed in the current cluster")
+			os.Exit(1)
+		}
+
+		slos, err := client.OdigosClient.ServiceLevelObjectives(odigosNs).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			fmt.Printf("\033[31mERROR\033[0m Failed to list service level objectives: %s\n", err)
+			os.Exit(1)
+		}
+		if len(slos.Items) == 0 {
+			fmt.Println("No service level objectives found")
+			return
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 20, 4, 2, ' ', tabwriter.TabIndent)
+		fmt.Fprintln(w, "NAME\tSOURCE\tTYPE\tTARGET\tSLI\tBUDGET LEFT\tBURN RATES\tSTATUS")
+		for _, slo := range slos.Items {
+			color := "\033[32m"
+			state := sloState(&slo)
+			if state != "OK" {
+				color = "\033[31m"
+			}
+			workload := slo.Spec.Workload
+			fmt.Fprintf(w, "%s%s\t%s/%s/%s\t%s\t%g%%\t%s\t%s\t%s\t%s\033[0m\n",
+				color, slo.Name, workload.Namespace, workload.Kind, workload.Name, slo.Spec.Type, slo.Spec.Target,
+				formatPercentage(slo.Status.SLI), formatPercentage(slo.Status.ErrorBudgetRemaining), formatBurnRates(slo.Status.BurnRates), state)
+		}
+		w.Flush()
+	},
+}
+
+// sloState summarizes the conditions of the objective: the burn alert which fires, or why it was not evaluated.
+func sloState(slo *v1alpha1.ServiceLevelObjective) string {
+	evaluated := meta.FindStatusCondition(slo.Status.Conditions, v1alpha1.ServiceLevelObjectiveEvaluatedConditionType)
+	if evaluated == nil {
+		return "Pending"
+	}
+	if evaluated.Status != metav1.ConditionTrue {
+		return evaluated.Reason
+	}
+	for _, conditionType := range []string{v1alpha1.ServiceLevelObjectiveFastBurnConditionType, v1alpha1.ServiceLevelObjectiveSlowBurnConditionType} {
+		if meta.IsStatusConditionTrue(slo.Status.Conditions, conditionType) {
+			return conditionType
+		}
+	}
+	return "OK"
+}
+
+func formatPercentage(value *float64) string {
+	if value == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.4g%%", *value)
+}
+
+func formatBurnRates(burnRates []v1alpha1.ServiceLevelObjectiveBurnRate) string {
+	if len(burnRates) == 0 {
+		return "-"
+	}
+	parts := make([]string, 0, len(burnRates))
+	for _, burnRate := range burnRates {
+		parts = append(parts, fmt.Sprintf("%s=%.3g", burnRate.Window, burnRate.BurnRate))
+	}
+	return strings.Join(parts, " ")
+}
+
+func init() {
+	rootCmd.AddCommand(sloCmd)
+	sloCmd.AddCommand(sloListCmd)
+}
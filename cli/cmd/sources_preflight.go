@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/odigos-io/odigos/cli/cmd/resources"
+	cmdcontext "github.com/odigos-io/odigos/cli/pkg/cmd_context"
+	"github.com/odigos-io/odigos/k8sutils/pkg/compatibility"
+
+	"github.com/spf13/cobra"
+)
+
+var sourcePreflightCmd = &cobra.Command{
+	Use:   "preflight [flags]",
+	Short: "Report the compatibility of the workloads in a namespace before instrumenting it",
+	Long: `This command reports, for every container of the deployments, daemonsets, statefulsets and cronjobs in a namespace,
+the detected programming language and runtime version, the distribution Odigos would use and whether an agent would be enabled.
+It also lists known blockers: a read-only root filesystem, other instrumentation agents, secure-execution mode,
+and limits which leave no headroom for the agent.
+
+Workloads which are not instrumented are inspected on demand by odiglet, so only workloads with running pods have runtime details.
+Nothing is instrumented by this command.`,
+	Example: `
+# Report the compatibility of the workloads in namespace "default"
+odigos sources preflight
+
+# Report the compatibility of the workloads in namespace "bar"
+odigos sources preflight -n bar
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		client := cmdcontext.KubeClientFromContextOrExit(ctx)
+
+		odigosNs, err := resources.GetOdigosNamespace(client, ctx)
+		if err != nil {
+			fmt.Printf("\033[31mERROR\033[0m Cannot get odigos namespace: %s\n", err)
+			os.Exit(1)
+		}
+
+		report, err := compatibility.Get(ctx, client, odigosNs, sourceNamespaceFlag)
+		if err != nil {
+			fmt.Printf("\033[31mERROR\033[0m Cannot get compatibility report: %s\n", err)
+			os.Exit(1)
+		}
+
+		if report.InspectionError != "" {
+			fmt.Printf("\033[33mWARNING\033[0m Runtime inspection failed on some nodes, their workloads are reported without runtime details: %s\n\n", report.InspectionError)
+		}
+		if len(report.Workloads) == 0 {
+			fmt.Printf("No workloads found in namespace %s\n", report.Namespace)
+			return
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 20, 4, 2, ' ', tabwriter.TabIndent)
+		fmt.Fprintln(w, "WORKLOAD\tCONTAINER\tLANGUAGE\tRUNTIME VERSION\tDISTRO\tSUPPORTED\tBLOCKERS\tMESSAGE")
+		for _, workloadReport := range report.Workloads {
+			for _, containerReport := range workloadReport.Containers {
+				color := "\033[32m"
+				if !containerReport.Supported {
+					color = "\033[31m"
+				} else if len(containerReport.Blockers) > 0 {
+					color = "\033[33m"
+				}
+				fmt.Fprintf(w, "%s%s/%s\t%s\t%s\t%s\t%s\t%t\t%d\t%s\033[0m\n",
+					color, workloadReport.Kind, workloadReport.Name, containerReport.ContainerName,
+					valueOrDash(string(containerReport.Language)), valueOrDash(containerReport.RuntimeVersion), valueOrDash(containerReport.OtelDistroName),
+					containerReport.Supported, len(containerReport.Blockers), containerReport.Message)
+			}
+		}
+		w.Flush()
+
+		printedHeader := false
+		for _, workloadReport := range report.Workloads {
+			for _, containerReport := range workloadReport.Containers {
+				for _, blocker := range containerReport.Blockers {
+					if !printedHeader {
+						fmt.Println("\nBlockers:")
+						printedHeader = true
+					}
+					fmt.Printf("  - %s/%s container %s: %s: %s\n", workloadReport.Kind, workloadReport.Name, containerReport.ContainerName, blocker.Type, blocker.Message)
+				}
+			}
+		}
+	},
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+func init() {
+	sourcesCmd.AddCommand(sourcePreflightCmd)
+	sourcePreflightCmd.Flags().StringVarP(&sourceNamespaceFlag, sourceNamespaceFlagName, "n", "default", "Kubernetes Namespace to report on")
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	"github.com/odigos-io/odigos/cli/cmd/resources"
+	cmdcontext "github.com/odigos-io/odigos/cli/pkg/cmd_context"
+	"github.com/odigos-io/odigos/k8sutils/pkg/injectionpreview"
+	"github.com/odigos-io/odigos/k8sutils/pkg/workload"
+
+	"github.com/spf13/cobra"
+)
+
+var sourcePreviewCmd = &cobra.Command{
+	Use:   "preview [workload type] [workload name] [flags]",
+	Short: "Preview the changes Odigos would make to the pods of a workload",
+	Long: `This command runs the Odigos pods webhook against the current pod template of the given workload, without creating a pod.
+It prints the JSON patch the webhook would apply to new pods (env vars, volumes, device resources and labels),
+or the reasons the pods would not be mutated.`,
+	Example: `
+# Preview the injection for deployment "foo" in namespace "default"
+odigos sources preview deployment foo
+
+# Preview the injection for statefulset "foo" in namespace "bar"
+odigos sources preview statefulset foo -n bar
+`,
+}
+
+func previewSourceCmd(workloadKind k8sconsts.WorkloadKind) *cobra.Command {
+	return &cobra.Command{
+		Use:     fmt.Sprintf("%s [name]", workload.WorkloadKindLowerCaseFromKind(workloadKind)),
+		Short:   fmt.Sprintf("Preview the changes Odigos would make to the pods of a %s", workloadKind),
+		Args:    cobra.ExactArgs(1),
+		Aliases: kindAliases[workloadKind],
+		Run: func(cmd *cobra.Command, args []string) {
+			previewSource(cmd, workloadKind, args[0])
+		},
+	}
+}
+
+func previewSource(cmd *cobra.Command, workloadKind k8sconsts.WorkloadKind, workloadName string) {
+	ctx := cmd.Context()
+	client := cmdcontext.KubeClientFromContextOrExit(ctx)
+
+	odigosNs, err := resources.GetOdigosNamespace(client, ctx)
+	if err != nil {
+		fmt.Printf("\033[31mERROR\033[0m Cannot get odigos namespace: %s\n", err)
+		os.Exit(1)
+	}
+
+	preview, err := injectionpreview.Get(ctx, client, odigosNs, k8sconsts.PodWorkload{
+		Namespace: sourceNamespaceFlag,
+		Kind:      workloadKind,
+		Name:      workloadName,
+	})
+	if err != nil {
+		fmt.Printf("\033[31mERROR\033[0m Cannot preview injection: %s\n", err)
+		os.Exit(1)
+	}
+
+	if preview.Injected {
+		fmt.Printf("\033[32mOdigos would inject to the pods of %s %s/%s\033[0m\n", workloadKind, sourceNamespaceFlag, workloadName)
+	} else {
+		fmt.Printf("\033[33mOdigos would not mutate the pods of %s %s/%s\033[0m\n", workloadKind, sourceNamespaceFlag, workloadName)
+	}
+
+	if len(preview.Reasons) > 0 {
+		fmt.Println("\nReasons:")
+		for _, reason := range preview.Reasons {
+			fmt.Printf("  - %s\n", reason)
+		}
+	}
+
+	if len(preview.Patch) > 0 {
+		patch, err := json.MarshalIndent(preview.Patch, "", "  ")
+		if err != nil {
+			fmt.Printf("\033[31mERROR\033[0m Cannot format patch: %s\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("\nPatch:\n%s\n", string(patch))
+	}
+}
+
+func init() {
+	sourcesCmd.AddCommand(sourcePreviewCmd)
+
+	for _, kind := range []k8sconsts.WorkloadKind{
+		k8sconsts.WorkloadKindDeployment,
+		k8sconsts.WorkloadKindDaemonSet,
+		k8sconsts.WorkloadKindStatefulSet,
+		k8sconsts.WorkloadKindCronJob,
+		k8sconsts.WorkloadKindDeploymentConfig,
+		k8sconsts.WorkloadKindArgoRollout,
+	} {
+		previewCmd := previewSourceCmd(kind)
+		previewCmd.Flags().StringVarP(&sourceNamespaceFlag, sourceNamespaceFlagName, "n", "default", "Kubernetes Namespace of the workload")
+		sourcePreviewCmd.AddCommand(previewCmd)
+	}
+}
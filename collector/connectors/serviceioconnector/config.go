@@ -10,6 +10,14 @@ import (
 
 const defaultMetricsFlushInterval = 60 * time.Second
 
+// defaultLatencyHistogramBuckets are the output call latency histogram bounds, matching the spanmetrics defaults.
+var defaultLatencyHistogramBuckets = []time.Duration{
+	2 * time.Millisecond, 4 * time.Millisecond, 6 * time.Millisecond, 8 * time.Millisecond,
+	10 * time.Millisecond, 50 * time.Millisecond, 100 * time.Millisecond, 200 * time.Millisecond,
+	400 * time.Millisecond, 800 * time.Millisecond, 1 * time.Second, 1400 * time.Millisecond,
+	2 * time.Second, 5 * time.Second, 10 * time.Second, 15 * time.Second,
+}
+
 // Config configures the serviceio connector.
 type Config struct {
 	// InputSpanAttributes lists OpenTelemetry span attribute names read from inbound
@@ -42,6 +50,10 @@ type Config struct {
 	// adjustment, not per-trace timing; leave at 0 unless you need that alignment.
 	MetricsTimestampOffset time.Duration `mapstructure:"metrics_timestamp_offset"`
 
+	// LatencyHistogramBuckets are the explicit bucket bounds of the output call latency histogram.
+	// Must be positive and strictly increasing. Defaults to the spanmetrics connector buckets (2ms..15s) if unset.
+	LatencyHistogramBuckets []time.Duration `mapstructure:"latency_histogram_buckets"`
+
 	// OdigosConfigExtension references the odigos_config_k8s extension (implements OdigosConfigExtension).
 	// When set, only service instances whose root span resource is an active Odigos source are counted.
 	OdigosConfigExtension *component.ID `mapstructure:"odigos_config_extension"`
@@ -54,6 +66,14 @@ func (c *Config) Validate() error {
 	if err := validateSpanAttributes("output_span_attributes", c.OutputSpanAttributes); err != nil {
 		return err
 	}
+	for i, bucket := range c.LatencyHistogramBuckets {
+		if bucket <= 0 {
+			return fmt.Errorf("latency_histogram_buckets[%d] must be positive", i)
+		}
+		if i > 0 && bucket <= c.LatencyHistogramBuckets[i-1] {
+			return fmt.Errorf("latency_histogram_buckets must be strictly increasing")
+		}
+	}
 	if c.OdigosConfigExtension != nil {
 		typeStr := c.OdigosConfigExtension.Type().String()
 		if _, err := component.NewType(typeStr); err != nil {
@@ -100,3 +120,16 @@ func (c *Config) resolvedMetricsFlushInterval() time.Duration {
 	}
 	return *c.MetricsFlushInterval
 }
+
+// resolvedLatencyHistogramBounds returns the latency histogram bounds in milliseconds.
+func (c *Config) resolvedLatencyHistogramBounds() []float64 {
+	buckets := c.LatencyHistogramBuckets
+	if len(buckets) == 0 {
+		buckets = defaultLatencyHistogramBuckets
+	}
+	bounds := make([]float64, len(buckets))
+	for i, bucket := range buckets {
+		bounds[i] = float64(bucket) / float64(time.Millisecond)
+	}
+	return bounds
+}
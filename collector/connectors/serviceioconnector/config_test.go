@@ -42,6 +42,13 @@ func TestConfigValidate(t *testing.T) {
 			},
 			wantErr: "output_span_attributes contains duplicate key",
 		},
+		{
+			name: "unsorted latency buckets",
+			config: Config{
+				LatencyHistogramBuckets: []time.Duration{10 * time.Millisecond, 5 * time.Millisecond},
+			},
+			wantErr: "latency_histogram_buckets must be strictly increasing",
+		},
 	}
 
 	for _, tt := range tests {
@@ -68,6 +75,7 @@ func TestLoadConfig(t *testing.T) {
 	require.Equal(t, []string{"http.route", "rpc.method"}, connectorCfg.InputSpanAttributes)
 	require.Equal(t, []string{"http.route", "rpc.service", "db.system"}, connectorCfg.OutputSpanAttributes)
 	require.Equal(t, 30*time.Second, *connectorCfg.MetricsFlushInterval)
+	require.Equal(t, []time.Duration{10 * time.Millisecond, 100 * time.Millisecond, time.Second}, connectorCfg.LatencyHistogramBuckets)
 }
 
 func TestMetadataConfigUnmarshal(t *testing.T) {
@@ -1,7 +1,10 @@
 package serviceioconnector
 
 import (
+	"sort"
+
 	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
 
 	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
 
@@ -10,10 +13,12 @@ import (
 )
 
 const (
-	metricNameConnectionTotal = "traces_service_io_connection_total"
-	serviceNameAttribute      = string(semconv.ServiceNameKey)
-	inputAttributePrefix      = "input."
-	outputAttributePrefix     = "output."
+	metricNameConnectionTotal   = "traces_service_io_connection_total"
+	metricNameConnectionErrors  = "traces_service_io_connection_errors_total"
+	metricNameConnectionLatency = "traces_service_io_connection_duration"
+	serviceNameAttribute        = string(semconv.ServiceNameKey)
+	inputAttributePrefix        = "input."
+	outputAttributePrefix       = "output."
 	// Identifies the collector pod/process that produced the metric (distinct from workload k8s.pod.name on spans).
 	collectorInstanceAttributeId = "odigos.collector.instance.id"
 )
@@ -83,8 +88,15 @@ func (c *serviceioConnector) aggregateConnectionsFromTree(tree *TraceTree, odigo
 			if series.count == 0 {
 				series.dimensions = attributes
 				series.resource = buildMetricResourceAttributes(instance)
+				series.latencyBucketCounts = make([]uint64, len(c.latencyBounds)+1)
 			}
 			series.count++
+			if outputLeaf.Span.Status().Code() == ptrace.StatusCodeError {
+				series.errorCount++
+			}
+			latencyMs := spanDurationMs(outputLeaf.Span)
+			series.latencySumMs += latencyMs
+			series.latencyBucketCounts[sort.SearchFloat64s(c.latencyBounds, latencyMs)]++
 			c.keyToMetric[key] = series
 			added = true
 		}
@@ -93,6 +105,15 @@ func (c *serviceioConnector) aggregateConnectionsFromTree(tree *TraceTree, odigo
 	return added
 }
 
+// spanDurationMs returns the span duration in milliseconds, or 0 for spans ending before they start.
+func spanDurationMs(span ptrace.Span) float64 {
+	start, end := span.StartTimestamp(), span.EndTimestamp()
+	if end <= start {
+		return 0
+	}
+	return float64(end-start) / 1e6
+}
+
 func metricScopeName() string {
 	return metadata.ScopeName
 }
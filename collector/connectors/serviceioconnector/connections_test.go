@@ -49,6 +49,52 @@ func TestAggregateConnectionsFromTree(t *testing.T) {
 	require.ElementsMatch(t, []string{"Users", "Orders"}, outputServices)
 }
 
+func TestAggregateConnectionsFromTree_LatencyAndErrors(t *testing.T) {
+	td := buildServiceIOTestTrace(t)
+	start := pcommon.NewTimestampFromTime(time.Unix(1700000000, 0))
+	spans := td.ResourceSpans()
+	for i := 0; i < spans.Len(); i++ {
+		span := spans.At(i).ScopeSpans().At(0).Spans().At(0)
+		span.SetStartTimestamp(start)
+		switch span.Name() {
+		case "client-1":
+			span.SetEndTimestamp(start + pcommon.Timestamp(30*time.Millisecond))
+			span.Status().SetCode(ptrace.StatusCodeError)
+		case "client-2":
+			span.SetEndTimestamp(start + pcommon.Timestamp(2*time.Second))
+		}
+	}
+
+	tree, err := BuildTraceTree(td, nil)
+	require.NoError(t, err)
+
+	connector := &serviceioConnector{
+		keyToMetric:          make(map[uint64]metricSeries),
+		outputSpanAttributes: []string{"rpc.service"},
+		latencyBounds:        []float64{10, 100, 1000},
+	}
+	odigosConfig := &mockOdigosConfigExtension{
+		activeSources: map[string]struct{}{"svc-1": {}},
+	}
+	require.True(t, connector.aggregateConnectionsFromTree(tree, odigosConfig))
+	require.Len(t, connector.keyToMetric, 2)
+
+	for _, series := range connector.keyToMetric {
+		outputService, ok := series.dimensions.Get(outputAttributePrefix + "rpc.service")
+		require.True(t, ok)
+		switch outputService.Str() {
+		case "Users":
+			require.EqualValues(t, 1, series.errorCount)
+			require.InDelta(t, 30, series.latencySumMs, 0.001)
+			require.Equal(t, []uint64{0, 1, 0, 0}, series.latencyBucketCounts)
+		case "Orders":
+			require.EqualValues(t, 0, series.errorCount)
+			require.InDelta(t, 2000, series.latencySumMs, 0.001)
+			require.Equal(t, []uint64{0, 0, 0, 1}, series.latencyBucketCounts)
+		}
+	}
+}
+
 func TestConnectorConsumeTraces_EmitsConnectionMetrics(t *testing.T) {
 	sink := &consumertest.MetricsSink{}
 	flushImmediately := time.Duration(0)
@@ -20,6 +20,7 @@ type serviceioConnector struct {
 	inputSpanAttributes  []string
 	outputSpanAttributes []string
 	metricsFlushInterval time.Duration
+	latencyBounds        []float64
 	logger               *zap.Logger
 	metricsConsumer      consumer.Metrics
 	collectorInstanceID  string
@@ -56,6 +57,7 @@ func newConnector(set component.TelemetrySettings, cfg component.Config, next co
 		inputSpanAttributes:  normalizeSpanAttributes(typedCfg.InputSpanAttributes),
 		outputSpanAttributes: normalizeSpanAttributes(typedCfg.OutputSpanAttributes),
 		metricsFlushInterval: typedCfg.resolvedMetricsFlushInterval(),
+		latencyBounds:        typedCfg.resolvedLatencyHistogramBounds(),
 		logger:               set.Logger,
 		metricsConsumer:      next,
 		collectorInstanceID:  collectorInstanceID,
@@ -17,6 +17,12 @@ type metricSeries struct {
 	dimensions pcommon.Map
 	resource   pcommon.Map
 	count      int64
+	// errorCount counts the connections whose output span has an error status.
+	errorCount int64
+	// latencyBucketCounts and latencySumMs aggregate the output span durations,
+	// with one bucket per latency bound plus the overflow bucket.
+	latencyBucketCounts []uint64
+	latencySumMs        float64
 }
 
 // hashAttributes returns a deterministic hash of sorted metric attribute names and values.
@@ -79,24 +85,56 @@ func (c *serviceioConnector) buildMetrics() (pmetric.Metrics, error) {
 		sm := rm.ScopeMetrics().AppendEmpty()
 		sm.Scope().SetName(metricScopeName())
 
+		startTimestamp := pcommon.NewTimestampFromTime(c.startTime)
+		timestamp := pcommon.NewTimestampFromTime(c.nowWithOffset())
+
 		mCount := sm.Metrics().AppendEmpty()
 		mCount.SetName(metricNameConnectionTotal)
 		mCount.SetEmptySum().SetIsMonotonic(true)
 		mCount.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
 
+		mErrors := sm.Metrics().AppendEmpty()
+		mErrors.SetName(metricNameConnectionErrors)
+		mErrors.SetEmptySum().SetIsMonotonic(true)
+		mErrors.Sum().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+
+		mLatency := sm.Metrics().AppendEmpty()
+		mLatency.SetName(metricNameConnectionLatency)
+		mLatency.SetUnit("ms")
+		mLatency.SetEmptyHistogram().SetAggregationTemporality(pmetric.AggregationTemporalityCumulative)
+
 		for _, series := range group.seriesList {
 			dp := mCount.Sum().DataPoints().AppendEmpty()
-			dp.SetStartTimestamp(pcommon.NewTimestampFromTime(c.startTime))
-			dp.SetTimestamp(pcommon.NewTimestampFromTime(c.nowWithOffset()))
+			dp.SetStartTimestamp(startTimestamp)
+			dp.SetTimestamp(timestamp)
 			dp.SetIntValue(series.count)
-			series.dimensions.CopyTo(dp.Attributes())
-			dp.Attributes().PutStr(collectorInstanceAttributeId, c.collectorInstanceID)
+			c.setSeriesAttributes(series, dp.Attributes())
+
+			errDp := mErrors.Sum().DataPoints().AppendEmpty()
+			errDp.SetStartTimestamp(startTimestamp)
+			errDp.SetTimestamp(timestamp)
+			errDp.SetIntValue(series.errorCount)
+			c.setSeriesAttributes(series, errDp.Attributes())
+
+			latencyDp := mLatency.Histogram().DataPoints().AppendEmpty()
+			latencyDp.SetStartTimestamp(startTimestamp)
+			latencyDp.SetTimestamp(timestamp)
+			latencyDp.SetCount(uint64(series.count))
+			latencyDp.SetSum(series.latencySumMs)
+			latencyDp.ExplicitBounds().FromRaw(c.latencyBounds)
+			latencyDp.BucketCounts().FromRaw(series.latencyBucketCounts)
+			c.setSeriesAttributes(series, latencyDp.Attributes())
 		}
 	}
 
 	return m, nil
 }
 
+func (c *serviceioConnector) setSeriesAttributes(series metricSeries, attrs pcommon.Map) {
+	series.dimensions.CopyTo(attrs)
+	attrs.PutStr(collectorInstanceAttributeId, c.collectorInstanceID)
+}
+
 func (c *serviceioConnector) flushMetrics(ctx context.Context) error {
 	md, err := c.buildMetrics()
 	if err != nil {
@@ -16,6 +16,7 @@ func TestBuildMetrics(t *testing.T) {
 		startTime:           time.Unix(1700000000, 0),
 		keyToMetric:         make(map[uint64]metricSeries),
 		collectorInstanceID: "odigos-gateway-test-pod",
+		latencyBounds:       []float64{10, 100},
 	}
 
 	inputAttrs := pcommon.NewMap()
@@ -29,16 +30,20 @@ func TestBuildMetrics(t *testing.T) {
 	inputAttributes := buildServiceInstanceBaseAttributes(instance, inputAttrs)
 	key, attributes := buildConnectionAttributes(inputAttributes, outputAttrs)
 	connector.keyToMetric[key] = metricSeries{
-		dimensions: attributes,
-		resource:   buildMetricResourceAttributes(instance),
-		count:      3,
+		dimensions:          attributes,
+		resource:            buildMetricResourceAttributes(instance),
+		count:               3,
+		errorCount:          1,
+		latencyBucketCounts: []uint64{1, 2, 0},
+		latencySumMs:        125,
 	}
 
 	md, err := connector.buildMetrics()
 	require.NoError(t, err)
-	require.Equal(t, 1, md.MetricCount())
+	require.Equal(t, 3, md.MetricCount())
 
-	metric := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics().At(0)
+	metrics := md.ResourceMetrics().At(0).ScopeMetrics().At(0).Metrics()
+	metric := metrics.At(0)
 	require.Equal(t, metricNameConnectionTotal, metric.Name())
 	require.Equal(t, pmetric.AggregationTemporalityCumulative, metric.Sum().AggregationTemporality())
 
@@ -54,6 +59,22 @@ func TestBuildMetrics(t *testing.T) {
 	instanceID, ok := dp.Attributes().Get(collectorInstanceAttributeId)
 	require.True(t, ok)
 	require.Equal(t, "odigos-gateway-test-pod", instanceID.Str())
+
+	errors := metrics.At(1)
+	require.Equal(t, metricNameConnectionErrors, errors.Name())
+	require.EqualValues(t, 1, errors.Sum().DataPoints().At(0).IntValue())
+
+	latency := metrics.At(2)
+	require.Equal(t, metricNameConnectionLatency, latency.Name())
+	require.Equal(t, "ms", latency.Unit())
+	latencyDp := latency.Histogram().DataPoints().At(0)
+	require.EqualValues(t, 3, latencyDp.Count())
+	require.InDelta(t, 125, latencyDp.Sum(), 0.001)
+	require.Equal(t, []float64{10, 100}, latencyDp.ExplicitBounds().AsRaw())
+	require.Equal(t, []uint64{1, 2, 0}, latencyDp.BucketCounts().AsRaw())
+	route, ok := latencyDp.Attributes().Get(inputAttributePrefix + "http.route")
+	require.True(t, ok)
+	require.Equal(t, "/users", route.Str())
 }
 
 func TestConnectionAttributes_IsDeterministic(t *testing.T) {
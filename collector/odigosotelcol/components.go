@@ -104,6 +104,7 @@ import (
 	serviceioconnector "github.com/odigos-io/odigos/collector/connectors/serviceioconnector"
 	odigoscapabilitiesextension "github.com/odigos-io/odigos/collector/extension/odigoscapabilitiesextension"
 	odigosconfigk8sextension "github.com/odigos-io/odigos/collector/extension/odigosconfigk8sextension"
+	odigosbaggageattributesprocessor "github.com/odigos-io/odigos/collector/processor/odigosbaggageattributesprocessor"
 	odigosextractattributeprocessor "github.com/odigos-io/odigos/collector/processor/odigosextractattributeprocessor"
 	odigoslogsresourceattrsprocessor "github.com/odigos-io/odigos/collector/processor/odigoslogsresourceattrsprocessor"
 	odigospiimaskingprocessor "github.com/odigos-io/odigos/collector/processor/odigospiimaskingprocessor"
@@ -285,6 +286,7 @@ func components() (otelcol.Factories, error) {
 		odigossqldboperationprocessor.NewFactory(),
 		odigossqlqueryprocessor.NewFactory(),
 		odigospiimaskingprocessor.NewFactory(),
+		odigosbaggageattributesprocessor.NewFactory(),
 		batchprocessor.NewFactory(),
 		memorylimiterprocessor.NewFactory(),
 		attributesprocessor.NewFactory(),
@@ -321,6 +323,7 @@ func components() (otelcol.Factories, error) {
 		odigossqldboperationprocessor.NewFactory().Type():    "github.com/open-telemetry/opentelemetry-collector-contrib/odigos/processor/odigossqldboperationprocessor v0.151.0",
 		odigossqlqueryprocessor.NewFactory().Type():          "github.com/odigos-io/odigos/collector/processor/odigossqlqueryprocessor v0.151.0",
 		odigospiimaskingprocessor.NewFactory().Type():        "github.com/odigos-io/odigos/collector/processor/odigospiimaskingprocessor v0.151.0",
+		odigosbaggageattributesprocessor.NewFactory().Type(): "github.com/odigos-io/odigos/collector/processor/odigosbaggageattributesprocessor v0.151.0",
 		batchprocessor.NewFactory().Type():                   "go.opentelemetry.io/collector/processor/batchprocessor v0.151.0",
 		memorylimiterprocessor.NewFactory().Type():           "go.opentelemetry.io/collector/processor/memorylimiterprocessor v0.151.0",
 		attributesprocessor.NewFactory().Type():              "github.com/open-telemetry/opentelemetry-collector-contrib/processor/attributesprocessor v0.151.0",
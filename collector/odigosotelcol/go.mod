@@ -9,6 +9,7 @@ require (
 	github.com/odigos-io/odigos/collector/connectors/serviceioconnector v0.151.0
 	github.com/odigos-io/odigos/collector/extension/odigoscapabilitiesextension v0.151.0
 	github.com/odigos-io/odigos/collector/extension/odigosconfigk8sextension v0.151.0
+	github.com/odigos-io/odigos/collector/processor/odigosbaggageattributesprocessor v0.151.0
 	github.com/odigos-io/odigos/collector/processor/odigosextractattributeprocessor v0.151.0
 	github.com/odigos-io/odigos/collector/processor/odigoslogsresourceattrsprocessor v0.151.0
 	github.com/odigos-io/odigos/collector/processor/odigospiimaskingprocessor v0.151.0
@@ -764,6 +765,8 @@ replace github.com/odigos-io/odigos/collector/processor/odigossqlqueryprocessor
 
 replace github.com/odigos-io/odigos/collector/processor/odigospiimaskingprocessor => ../processors/odigospiimaskingprocessor
 
+replace github.com/odigos-io/odigos/collector/processor/odigosbaggageattributesprocessor => ../processors/odigosbaggageattributesprocessor
+
 replace github.com/odigos-io/odigos/collector/processors/odigostracestateprocessor => ../processors/odigostracestateprocessor
 
 replace github.com/open-telemetry/opentelemetry-collector-contrib/odigos/exporter/azureblobstorageexporter => ../exporters/azureblobstorageexporter
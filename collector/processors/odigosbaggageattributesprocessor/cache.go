@@ -0,0 +1,43 @@
+package odigosbaggageattributesprocessor
+
+import (
+	"sync"
+
+	"github.com/odigos-io/odigos/common/api/actions"
+)
+
+// processorBaggageConfigCache caches the baggage attributes config per workload key.
+// Updated via extension callback on cache add/update/delete; hot path only does a read.
+type processorBaggageConfigCache struct {
+	mu   sync.RWMutex
+	data map[string]*actions.BaggageAttributesCollectorConfig
+}
+
+func newProcessorBaggageConfigCache() *processorBaggageConfigCache {
+	return &processorBaggageConfigCache{data: make(map[string]*actions.BaggageAttributesCollectorConfig)}
+}
+
+func (c *processorBaggageConfigCache) get(key string) (*actions.BaggageAttributesCollectorConfig, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	e, ok := c.data[key]
+	return e, ok
+}
+
+func (c *processorBaggageConfigCache) set(key string, e *actions.BaggageAttributesCollectorConfig) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.data[key] = e
+}
+
+func (c *processorBaggageConfigCache) delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.data, key)
+}
+
+func (c *processorBaggageConfigCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.data = make(map[string]*actions.BaggageAttributesCollectorConfig)
+}
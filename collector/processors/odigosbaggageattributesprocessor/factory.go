@@ -0,0 +1,50 @@
+package odigosbaggageattributesprocessor
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/consumer"
+	"go.opentelemetry.io/collector/processor"
+	"go.opentelemetry.io/collector/processor/processorhelper"
+
+	"github.com/odigos-io/odigos/collector/processor/odigosbaggageattributesprocessor/internal/metadata"
+)
+
+//go:generate mdatagen metadata.yaml
+
+var consumerCapabilities = consumer.Capabilities{MutatesData: true}
+
+// NewFactory creates a new ProcessorFactory with default configuration.
+func NewFactory() processor.Factory {
+	return processor.NewFactory(
+		metadata.Type,
+		createDefaultConfig,
+		processor.WithTraces(createTracesProcessor, metadata.TracesStability),
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{}
+}
+
+func createTracesProcessor(
+	ctx context.Context,
+	set processor.Settings,
+	cfg component.Config,
+	nextConsumer consumer.Traces,
+) (processor.Traces, error) {
+	oCfg := cfg.(*Config)
+	proc := newBaggageAttributesProcessor(set, oCfg)
+
+	return processorhelper.NewTraces(
+		ctx,
+		set,
+		cfg,
+		nextConsumer,
+		proc.processTraces,
+		processorhelper.WithCapabilities(consumerCapabilities),
+		processorhelper.WithStart(proc.Start),
+		processorhelper.WithShutdown(proc.Shutdown),
+	)
+}
@@ -0,0 +1,186 @@
+package odigosbaggageattributesprocessor
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/processor"
+	"go.uber.org/zap"
+
+	commonapi "github.com/odigos-io/odigos/common/api"
+	"github.com/odigos-io/odigos/common/api/actions"
+	"github.com/odigos-io/odigos/common/collector"
+)
+
+// baggageHeaderAttribute is the span attribute in which agents record the baggage request header.
+// The processor is the fallback for agents which can not record baggage entries as attributes themselves.
+// Baggage itself is not exported with spans, so the header is the only place the gateway can read it from,
+// and only spans which recorded the header (usually server spans) can be enriched.
+const baggageHeaderAttribute = "http.request.header.baggage"
+
+// Ensure baggageAttributesProcessor implements the callback interface used by the extension.
+var _ collector.WorkloadConfigCacheCallback = (*baggageAttributesProcessor)(nil)
+
+type baggageAttributesProcessor struct {
+	logger *zap.Logger
+	cfg    *Config
+
+	// provider is set in Start() from odigos_config_extension.
+	provider collector.OdigosConfigExtension
+
+	// configCache caches the baggage attributes config per workload key; updated via extension callback.
+	configCache *processorBaggageConfigCache
+}
+
+func newBaggageAttributesProcessor(set processor.Settings, cfg *Config) *baggageAttributesProcessor {
+	return &baggageAttributesProcessor{
+		logger:      set.Logger,
+		cfg:         cfg,
+		configCache: newProcessorBaggageConfigCache(),
+	}
+}
+
+// Start resolves odigos_config_extension for per-source config lookups.
+func (p *baggageAttributesProcessor) Start(ctx context.Context, host component.Host) error {
+	if p.cfg.OdigosConfigExtension == nil {
+		return fmt.Errorf("odigos_config_extension is required")
+	}
+	extID := p.cfg.OdigosConfigExtension
+	ext, ok := host.GetExtensions()[*extID]
+	if !ok {
+		return fmt.Errorf("odigos config extension %q not found", extID.String())
+	}
+	odigosExt, ok := ext.(collector.OdigosConfigExtension)
+	if !ok {
+		return fmt.Errorf("extension %q is not an OdigosConfigExtension (got %T)", extID.String(), ext)
+	}
+	p.provider = odigosExt
+	odigosExt.RegisterWorkloadConfigCacheCallback(p)
+	if !p.provider.WaitForCacheSync(ctx) {
+		p.logger.Warn("odigos config extension cache sync did not complete; some spans may be missed on startup")
+	}
+	return nil
+}
+
+// Shutdown unregisters from the extension and clears local caches.
+func (p *baggageAttributesProcessor) Shutdown(context.Context) error {
+	if p.provider != nil {
+		p.provider.UnregisterWorkloadConfigCacheCallback(p)
+		p.provider = nil
+	}
+	p.configCache.clear()
+	return nil
+}
+
+// OnSet implements collector.WorkloadConfigCacheCallback.
+func (p *baggageAttributesProcessor) OnSet(key string, cfg *commonapi.ContainerCollectorConfig) {
+	if cfg.BaggageAttributes == nil || len(cfg.BaggageAttributes.BaggageKeys) == 0 {
+		p.configCache.delete(key)
+		return
+	}
+	p.configCache.set(key, cfg.BaggageAttributes.DeepCopy())
+	p.logger.Debug("workload config cache OnSet", zap.String("key", key))
+}
+
+// OnDeleteKey implements collector.WorkloadConfigCacheCallback.
+func (p *baggageAttributesProcessor) OnDeleteKey(key string) {
+	p.configCache.delete(key)
+	p.logger.Debug("workload config cache OnDeleteKey", zap.String("key", key))
+}
+
+func (p *baggageAttributesProcessor) processTraces(_ context.Context, traces ptrace.Traces) (ptrace.Traces, error) {
+	if p.provider == nil {
+		return traces, nil
+	}
+
+	resourceSpans := traces.ResourceSpans()
+	for i := 0; i < resourceSpans.Len(); i++ {
+		rs := resourceSpans.At(i)
+
+		key, err := p.provider.GetWorkloadCacheKey(rs.Resource())
+		if err != nil {
+			continue
+		}
+		baggageConfig, ok := p.configCache.get(key)
+		if !ok {
+			continue
+		}
+
+		scopeSpans := rs.ScopeSpans()
+		for j := 0; j < scopeSpans.Len(); j++ {
+			spans := scopeSpans.At(j).Spans()
+			for k := 0; k < spans.Len(); k++ {
+				promoteBaggage(spans.At(k).Attributes(), baggageConfig)
+			}
+		}
+	}
+	return traces, nil
+}
+
+// promoteBaggage copies the selected baggage entries from the recorded baggage header to attributes.
+// Attributes already set on the span are not overwritten.
+// The header attribute is removed afterwards when it was collected only for this processor.
+func promoteBaggage(attrs pcommon.Map, baggageConfig *actions.BaggageAttributesCollectorConfig) {
+	header, found := attrs.Get(baggageHeaderAttribute)
+	if !found {
+		return
+	}
+	if baggageConfig.RemoveBaggageHeader {
+		defer attrs.Remove(baggageHeaderAttribute)
+	}
+
+	var entries map[string]string
+	switch header.Type() {
+	case pcommon.ValueTypeStr:
+		entries = parseBaggage(header.Str())
+	case pcommon.ValueTypeSlice:
+		// a request can carry more than one baggage header, all of which are combined
+		values := header.Slice()
+		parts := make([]string, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			parts = append(parts, values.At(i).AsString())
+		}
+		entries = parseBaggage(strings.Join(parts, ","))
+	default:
+		return
+	}
+
+	for _, key := range baggageConfig.BaggageKeys {
+		value, ok := entries[key]
+		if !ok {
+			continue
+		}
+		if _, exists := attrs.Get(key); exists {
+			continue
+		}
+		attrs.PutStr(key, value)
+	}
+}
+
+// parseBaggage parses a W3C baggage header value into its entries.
+// Entry properties are dropped, and malformed entries are skipped.
+func parseBaggage(header string) map[string]string {
+	entries := make(map[string]string)
+	for _, member := range strings.Split(header, ",") {
+		member, _, _ = strings.Cut(member, ";")
+		key, value, ok := strings.Cut(member, "=")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		if key == "" {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if decoded, err := url.PathUnescape(value); err == nil {
+			value = decoded
+		}
+		entries[key] = value
+	}
+	return entries
+}
@@ -0,0 +1,100 @@
+package odigosbaggageattributesprocessor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+
+	"github.com/odigos-io/odigos/common/api/actions"
+)
+
+func baggageConfig(removeBaggageHeader bool, baggageKeys ...string) *actions.BaggageAttributesCollectorConfig {
+	return &actions.BaggageAttributesCollectorConfig{
+		BaggageAttributesConfig: actions.BaggageAttributesConfig{BaggageKeys: baggageKeys},
+		RemoveBaggageHeader:     removeBaggageHeader,
+	}
+}
+
+func TestParseBaggage(t *testing.T) {
+	entries := parseBaggage(" tenant_id = acme ;ttl=60, experiment=new%20checkout,malformed, =empty")
+	assert.Equal(t, map[string]string{
+		"tenant_id":  "acme",
+		"experiment": "new checkout",
+	}, entries)
+}
+
+func TestPromoteBaggage(t *testing.T) {
+	baggageKeys := []string{"tenant_id", "experiment", "region"}
+
+	tests := []struct {
+		name   string
+		header func(attrs pcommon.Map)
+		want   map[string]string
+	}{
+		{
+			name: "string header",
+			header: func(attrs pcommon.Map) {
+				attrs.PutStr(baggageHeaderAttribute, "tenant_id=acme,user=alice")
+			},
+			want: map[string]string{"tenant_id": "acme"},
+		},
+		{
+			name: "multiple header values",
+			header: func(attrs pcommon.Map) {
+				values := attrs.PutEmptySlice(baggageHeaderAttribute)
+				values.AppendEmpty().SetStr("tenant_id=acme")
+				values.AppendEmpty().SetStr("experiment=b")
+			},
+			want: map[string]string{"tenant_id": "acme", "experiment": "b"},
+		},
+		{
+			name:   "no header",
+			header: func(attrs pcommon.Map) {},
+			want:   map[string]string{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			attrs := pcommon.NewMap()
+			tc.header(attrs)
+			promoteBaggage(attrs, baggageConfig(false, baggageKeys...))
+			for _, key := range baggageKeys {
+				value, found := attrs.Get(key)
+				want, wantFound := tc.want[key]
+				assert.Equal(t, wantFound, found, key)
+				if wantFound {
+					assert.Equal(t, want, value.Str(), key)
+				}
+			}
+		})
+	}
+}
+
+func TestPromoteBaggageKeepsExistingAttribute(t *testing.T) {
+	attrs := pcommon.NewMap()
+	attrs.PutStr(baggageHeaderAttribute, "tenant_id=acme")
+	attrs.PutStr("tenant_id", "from-span")
+
+	promoteBaggage(attrs, baggageConfig(false, "tenant_id"))
+
+	value, _ := attrs.Get("tenant_id")
+	assert.Equal(t, "from-span", value.Str())
+}
+
+func TestPromoteBaggageRemovesHeader(t *testing.T) {
+	attrs := pcommon.NewMap()
+	attrs.PutStr(baggageHeaderAttribute, "tenant_id=acme")
+	promoteBaggage(attrs, baggageConfig(false, "tenant_id"))
+	_, found := attrs.Get(baggageHeaderAttribute)
+	assert.True(t, found, "the header is kept when collected by a headers collection rule")
+
+	attrs = pcommon.NewMap()
+	attrs.PutStr(baggageHeaderAttribute, "tenant_id=acme")
+	promoteBaggage(attrs, baggageConfig(true, "tenant_id"))
+	_, found = attrs.Get(baggageHeaderAttribute)
+	assert.False(t, found, "the header is removed when collected only for this processor")
+	value, _ := attrs.Get("tenant_id")
+	assert.Equal(t, "acme", value.Str())
+}
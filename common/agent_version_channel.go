@@ -0,0 +1,27 @@
+package common
+
+// AgentVersionChannel selects which version of the odigos agents is injected into a workload,
+// so critical workloads can be kept on a validated agents version while the rest of the cluster is upgraded.
+// +kubebuilder:validation:Enum=stable;previous;canary
+type AgentVersionChannel string
+
+const (
+	// the agents version which matches the installed odigos version.
+	StableAgentVersionChannel AgentVersionChannel = "stable"
+	// the agents version of the odigos version installed before the current one.
+	PreviousAgentVersionChannel AgentVersionChannel = "previous"
+	// a newer agents version, to be validated on a subset of the workloads before upgrading odigos.
+	CanaryAgentVersionChannel AgentVersionChannel = "canary"
+)
+
+// AgentVersionChannelConfiguration describes where the agents of a non-stable channel come from.
+type AgentVersionChannelConfiguration struct {
+	// the odigos version of the agents served by this channel, reported in the instrumentation config status.
+	// can be empty when the channel is configured with an explicit image.
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+
+	// the odigos agents image of this version.
+	// odiglet copies its agents to the node for the host based mount methods,
+	// and the pods webhook uses it directly for the k8s-init-container and k8s-image-volume mount methods.
+	Image string `json:"image" yaml:"image"`
+}
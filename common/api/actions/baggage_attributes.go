@@ -0,0 +1,27 @@
+package actions
+
+// BaggageAttributesConfig copies entries of the W3C baggage onto the telemetry of a source,
+// so that sampling rules, span metrics dimensions and other actions can use them as attributes.
+// Each selected entry is recorded as an attribute named as its baggage key (e.g. "tenant_id").
+//
+// +kubebuilder:object:generate=true
+// +kubebuilder:deepcopy-gen=true
+type BaggageAttributesConfig struct {
+	// BaggageKeys are the keys of the baggage entries to record as attributes.
+	// Entries which are not present in the baggage of a request are skipped.
+	// +kubebuilder:validation:MinItems=1
+	BaggageKeys []string `json:"baggageKeys" mapstructure:"baggage_keys"`
+}
+
+// BaggageAttributesCollectorConfig is the configuration for parsing the selected baggage entries
+// in the collector, from the baggage request header recorded on spans.
+//
+// +kubebuilder:object:generate=true
+// +kubebuilder:deepcopy-gen=true
+type BaggageAttributesCollectorConfig struct {
+	BaggageAttributesConfig `json:",inline"`
+
+	// RemoveBaggageHeader is set when the baggage request header is collected only to parse the entries from it,
+	// and not by a headers collection rule. The header attribute is then removed from spans after parsing.
+	RemoveBaggageHeader bool `json:"removeBaggageHeader,omitempty"`
+}
@@ -22,6 +22,42 @@ package actions
 
 import ()
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BaggageAttributesCollectorConfig) DeepCopyInto(out *BaggageAttributesCollectorConfig) {
+	*out = *in
+	in.BaggageAttributesConfig.DeepCopyInto(&out.BaggageAttributesConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BaggageAttributesCollectorConfig.
+func (in *BaggageAttributesCollectorConfig) DeepCopy() *BaggageAttributesCollectorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BaggageAttributesCollectorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BaggageAttributesConfig) DeepCopyInto(out *BaggageAttributesConfig) {
+	*out = *in
+	if in.BaggageKeys != nil {
+		in, out := &in.BaggageKeys, &out.BaggageKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BaggageAttributesConfig.
+func (in *BaggageAttributesConfig) DeepCopy() *BaggageAttributesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BaggageAttributesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomFormatMasking) DeepCopyInto(out *CustomFormatMasking) {
 	*out = *in
@@ -76,6 +76,34 @@ type AgentTracesConfig struct {
 
 	// custom instrumentation probes for this container.
 	CustomInstrumentations *instrumentationrules.CustomInstrumentations `json:"customInstrumentations,omitempty"`
+
+	// baggage entries to record as attributes on every span of this container.
+	BaggageAttributes *actions.BaggageAttributesConfig `json:"baggageAttributes,omitempty"`
+}
+
+// general OpenTelemetry SDK settings for an agent running in a specific container,
+// which native SDKs usually read from OTEL_* environment variables.
+// Unset fields keep the defaults of the agent.
+// +kubebuilder:object:generate=true
+type AgentSdkConfig struct {
+	// names of instrumentation libraries which should not produce telemetry in the container,
+	// as named by the agent (e.g. "redis" or "jdbc", or the instrumented package for the go eBPF agent, e.g. "net/http").
+	// Not supported by the OBI agent, which attaches the same probes to all the processes it instruments.
+	DisabledInstrumentationLibraries []string `json:"disabledInstrumentationLibraries,omitempty"`
+
+	// resource attributes to add to the telemetry of the container,
+	// in addition to the ones odigos sets, which take precedence.
+	ResourceAttributes map[string]string `json:"resourceAttributes,omitempty"`
+
+	// context propagators used to extract and inject trace context.
+	// empty means the default of the agent, which is tracecontext and baggage for most agents.
+	// Agents which only support some of them ignore the others, and report it in the ContextPropagation condition.
+	Propagators []common.Propagator `json:"propagators,omitempty"`
+
+	// protocol used to export telemetry to the node collector.
+	// Only applies to native agents which export to the node collector endpoint odigos sets in their environment.
+	// eBPF agents export through odiglet, and ignore it.
+	ExporterProtocol common.OtlpExporterProtocol `json:"exporterProtocol,omitempty"`
 }
 
 // all "metrics" related configuration for an agent running on any process in a specific container.
@@ -95,6 +123,9 @@ type AgentMetricsConfig struct {
 
 	// NetworkMetrics enables network flow and TCP stats metrics for this container.
 	NetworkMetrics *instrumentationrules.NetworkMetricsConfig `json:"networkMetrics,omitempty"`
+
+	// baggage entries to record as attributes on the metric measurements recorded in the context of a request.
+	BaggageAttributes *actions.BaggageAttributesConfig `json:"baggageAttributes,omitempty"`
 }
 
 // all "logs" related configuration for an agent running on any process in a specific container.
@@ -103,4 +134,7 @@ type AgentMetricsConfig struct {
 type AgentLogsConfig struct {
 	// if set, switches the logs pipeline to use the eBPF receiver instead of filelog.
 	EbpfLogCapture *instrumentationrules.EbpfLogCapture `json:"ebpfLogCapture,omitempty"`
+
+	// baggage entries to record as attributes on the log records emitted in the context of a request.
+	BaggageAttributes *actions.BaggageAttributesConfig `json:"baggageAttributes,omitempty"`
 }
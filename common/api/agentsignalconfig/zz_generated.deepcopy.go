@@ -35,6 +35,11 @@ func (in *AgentLogsConfig) DeepCopyInto(out *AgentLogsConfig) {
 		*out = new(instrumentationrules.EbpfLogCapture)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BaggageAttributes != nil {
+		in, out := &in.BaggageAttributes, &out.BaggageAttributes
+		*out = new(actions.BaggageAttributesConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentLogsConfig.
@@ -65,6 +70,11 @@ func (in *AgentMetricsConfig) DeepCopyInto(out *AgentMetricsConfig) {
 		*out = new(instrumentationrules.NetworkMetricsConfig)
 		**out = **in
 	}
+	if in.BaggageAttributes != nil {
+		in, out := &in.BaggageAttributes, &out.BaggageAttributes
+		*out = new(actions.BaggageAttributesConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentMetricsConfig.
@@ -77,6 +87,38 @@ func (in *AgentMetricsConfig) DeepCopy() *AgentMetricsConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentSdkConfig) DeepCopyInto(out *AgentSdkConfig) {
+	*out = *in
+	if in.DisabledInstrumentationLibraries != nil {
+		in, out := &in.DisabledInstrumentationLibraries, &out.DisabledInstrumentationLibraries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResourceAttributes != nil {
+		in, out := &in.ResourceAttributes, &out.ResourceAttributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Propagators != nil {
+		in, out := &in.Propagators, &out.Propagators
+		*out = make([]common.Propagator, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentSdkConfig.
+func (in *AgentSdkConfig) DeepCopy() *AgentSdkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentSdkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AgentSpanMetricsConfig) DeepCopyInto(out *AgentSpanMetricsConfig) {
 	*out = *in
@@ -150,6 +192,11 @@ func (in *AgentTracesConfig) DeepCopyInto(out *AgentTracesConfig) {
 		*out = new(instrumentationrules.CustomInstrumentations)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BaggageAttributes != nil {
+		in, out := &in.BaggageAttributes, &out.BaggageAttributes
+		*out = new(actions.BaggageAttributesConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentTracesConfig.
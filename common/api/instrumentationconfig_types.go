@@ -2,6 +2,7 @@ package api
 
 import (
 	"github.com/odigos-io/odigos/common/api/actions"
+	"github.com/odigos-io/odigos/common/api/instrumentationrules"
 	"github.com/odigos-io/odigos/common/api/sampling"
 )
 
@@ -21,4 +22,12 @@ type ContainerCollectorConfig struct {
 	InferDbAttributes *actions.InferDbAttributesConfig `json:"inferDbAttributes,omitempty"`
 
 	PiiMasking *actions.PiiMaskingConfig `json:"piiMasking,omitempty"`
+
+	// Baggage entries to parse from the recorded baggage request header of spans,
+	// for agents which can not record them as attributes themselves.
+	BaggageAttributes *actions.BaggageAttributesCollectorConfig `json:"baggageAttributes,omitempty"`
+
+	// Continuous profiling for this container, merged from the profiling instrumentation rules in scope.
+	// Nil when no profiling rule applies to the container.
+	Profiling *instrumentationrules.Profiling `json:"profiling,omitempty"`
 }
@@ -0,0 +1,17 @@
+package instrumentationrules
+
+import (
+	"github.com/odigos-io/odigos/common"
+)
+
+// +kubebuilder:object:generate=true
+// +kubebuilder:deepcopy-gen=true
+type AgentVersion struct {
+
+	// The agent version channel to inject into the scoped workloads.
+	// "stable" is the agents version of the installed odigos, while "previous" and "canary"
+	// must be configured in the odigos configuration to be used.
+	// Workloads selecting a channel which is not configured get the stable agents.
+	// if multiple rules select different channels for the same container, the first rule by namespace and name is used.
+	Channel common.AgentVersionChannel `json:"channel"`
+}
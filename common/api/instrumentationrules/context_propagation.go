@@ -0,0 +1,17 @@
+package instrumentationrules
+
+import (
+	"github.com/odigos-io/odigos/common"
+)
+
+// +kubebuilder:object:generate=true
+// +kubebuilder:deepcopy-gen=true
+type ContextPropagation struct {
+
+	// The propagators the agents of the scoped workloads use to extract and inject the trace context, in order.
+	// e.g. ["tracecontext", "baggage", "b3"] to keep traces connected with services which only emit B3 headers.
+	// if multiple rules set propagators for the same container, the first rule by namespace and name is used.
+	// Propagators set in the container overrides of a source take precedence over rules.
+	// +kubebuilder:validation:MinItems=1
+	Propagators []common.Propagator `json:"propagators"`
+}
@@ -0,0 +1,52 @@
+package instrumentationrules
+
+// ProfileType is a kind of continuous profile that can be collected for a workload.
+// Only the types the node eBPF profiler collects are accepted. Allocation and lock profiles are out of scope:
+// the eBPF profiler does not collect them, and they require in-process language profilers, which odigos does not deploy.
+// +kubebuilder:validation:Enum=cpu;offCpu
+type ProfileType string
+
+const (
+	// ProfileTypeCpu samples on-CPU stacks. Collected by the eBPF profiler for any language.
+	ProfileTypeCpu ProfileType = "cpu"
+	// ProfileTypeOffCpu samples stacks of threads blocked off-CPU (I/O, sleeps, scheduling).
+	ProfileTypeOffCpu ProfileType = "offCpu"
+)
+
+// Profiling tunes continuous profiling for the workloads in the rule scope.
+// Enablement is presence-based: a non-nil value means the rule applies to the scoped workloads.
+//
+// The eBPF profiler runs once per node and samples every process on it, and all the node collectors
+// share one configuration, so the sampling frequency is cluster-wide: every source is sampled at the
+// highest frequency requested for any source. The profile types are scoped: the node collectors drop the
+// off-cpu profiles of the containers that do not request them, and the cpu profiles of the containers
+// that only request off-cpu profiles.
+//
+// +kubebuilder:object:generate=true
+// +kubebuilder:deepcopy-gen=true
+type Profiling struct {
+	// The profile types to collect. When empty, only cpu profiles are collected.
+	ProfileTypes []ProfileType `json:"profileTypes,omitempty"`
+
+	// The cpu sampling frequency in samples per second.
+	// When unset, the profiler default is used (20Hz).
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	SamplingFrequencyHz *int `json:"samplingFrequencyHz,omitempty"`
+}
+
+// HasProfileType reports whether the profile type is collected by this config.
+func (p *Profiling) HasProfileType(t ProfileType) bool {
+	if p == nil {
+		return false
+	}
+	if len(p.ProfileTypes) == 0 {
+		return t == ProfileTypeCpu
+	}
+	for _, pt := range p.ProfileTypes {
+		if pt == t {
+			return true
+		}
+	}
+	return false
+}
@@ -50,6 +50,21 @@ func (in *AgentDiagnostics) DeepCopy() *AgentDiagnostics {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentVersion) DeepCopyInto(out *AgentVersion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentVersion.
+func (in *AgentVersion) DeepCopy() *AgentVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CodeAttributes) DeepCopyInto(out *CodeAttributes) {
 	*out = *in
@@ -95,6 +110,26 @@ func (in *CodeAttributes) DeepCopy() *CodeAttributes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContextPropagation) DeepCopyInto(out *ContextPropagation) {
+	*out = *in
+	if in.Propagators != nil {
+		in, out := &in.Propagators, &out.Propagators
+		*out = make([]common.Propagator, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContextPropagation.
+func (in *ContextPropagation) DeepCopy() *ContextPropagation {
+	if in == nil {
+		return nil
+	}
+	out := new(ContextPropagation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomInstrumentations) DeepCopyInto(out *CustomInstrumentations) {
 	*out = *in
@@ -389,6 +424,31 @@ func (in *PhpCustomProbe) DeepCopy() *PhpCustomProbe {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Profiling) DeepCopyInto(out *Profiling) {
+	*out = *in
+	if in.ProfileTypes != nil {
+		in, out := &in.ProfileTypes, &out.ProfileTypes
+		*out = make([]ProfileType, len(*in))
+		copy(*out, *in)
+	}
+	if in.SamplingFrequencyHz != nil {
+		in, out := &in.SamplingFrequencyHz, &out.SamplingFrequencyHz
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Profiling.
+func (in *Profiling) DeepCopy() *Profiling {
+	if in == nil {
+		return nil
+	}
+	out := new(Profiling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TraceConfig) DeepCopyInto(out *TraceConfig) {
 	*out = *in
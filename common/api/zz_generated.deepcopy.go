@@ -22,6 +22,7 @@ package api
 
 import (
 	"github.com/odigos-io/odigos/common/api/actions"
+	"github.com/odigos-io/odigos/common/api/instrumentationrules"
 	"github.com/odigos-io/odigos/common/api/sampling"
 )
 
@@ -53,6 +54,16 @@ func (in *ContainerCollectorConfig) DeepCopyInto(out *ContainerCollectorConfig)
 		*out = new(actions.PiiMaskingConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BaggageAttributes != nil {
+		in, out := &in.BaggageAttributes, &out.BaggageAttributes
+		*out = new(actions.BaggageAttributesCollectorConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Profiling != nil {
+		in, out := &in.Profiling, &out.Profiling
+		*out = new(instrumentationrules.Profiling)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerCollectorConfig.
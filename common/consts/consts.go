@@ -54,6 +54,12 @@ const (
 	OdigosLoaderDirName = "loader"
 	OdigosLoaderName    = "loader.so"
 
+	// directory under the agents directory which holds the agents of non-stable agent version channels,
+	// each channel in a sub directory named after it.
+	AgentVersionChannelsDirName = "channels"
+	// directory under the agents directory which holds the opamp unix socket served by odiglet.
+	OpampExchangeDirName = "exchange"
+
 	// name of the secret that contains the oidc client secret
 	OidcSecretName = "odigos-oidc"
 
@@ -125,6 +131,8 @@ var (
 	OtelMetricsExporter         = "OTEL_METRICS_EXPORTER"
 	OtelTracesExporter          = "OTEL_TRACES_EXPORTER"
 	OtelExporterEndpointEnvName = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	OtelExporterProtocolEnvName = "OTEL_EXPORTER_OTLP_PROTOCOL"
+	OtelPropagatorsEnvName      = "OTEL_PROPAGATORS"
 	// Python related ones
 	OpampServerHostEnvName = "ODIGOS_OPAMP_SERVER_HOST"
 	OpAMPPort              = 4320
@@ -156,6 +164,7 @@ const (
 	SQLQueryProcessorName                 = "odigos-sql-query"
 	OdigosSQLQueryProcessorType           = "odigossqlquery"
 	OdigosExtractAttributeProcessorType   = "odigosextractattribute"
+	OdigosBaggageAttributesProcessorType  = "odigosbaggageattributes"
 )
 
 // Extension related consts
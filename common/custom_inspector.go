@@ -0,0 +1,58 @@
+package common
+
+// +kubebuilder:validation:Enum=exePath;cmdLine;env;maps
+type CustomInspectorVersionSource string
+
+const (
+	CustomInspectorVersionSourceExePath CustomInspectorVersionSource = "exePath"
+	CustomInspectorVersionSourceCmdLine CustomInspectorVersionSource = "cmdLine"
+	CustomInspectorVersionSourceEnv     CustomInspectorVersionSource = "env"
+	CustomInspectorVersionSourceMaps    CustomInspectorVersionSource = "maps"
+)
+
+// +kubebuilder:object:generate=true
+// CustomInspector is a declarative process discovery inspector.
+// It allows detecting in-house runtimes (custom launchers, wrapper binaries, etc.) which the
+// built-in inspectors miss or misdetect, without adding code to odigos.
+// Custom inspectors are evaluated in order, before the built-in inspectors, and the first match wins.
+//
+// Each of the criteria lists below is optional. A process matches the inspector if, for each non-empty
+// list, at least one of its entries matches the process. At least one list must be set.
+type CustomInspector struct {
+	// Name identifies the inspector in logs.
+	Name string `json:"name" yaml:"name"`
+
+	// Language reported for processes matching this inspector.
+	Language ProgrammingLanguage `json:"language" yaml:"language"`
+
+	// ExePathGlobs are glob patterns (as in path.Match) matched against the full executable path
+	// and against its base name. e.g. "/opt/launcher/bin/*" or "myjvm*".
+	ExePathGlobs []string `json:"exePathGlobs,omitempty" yaml:"exePathGlobs,omitempty"`
+
+	// CmdLineRegexes are regular expressions matched against the process command line,
+	// where arguments are separated by spaces.
+	CmdLineRegexes []string `json:"cmdLineRegexes,omitempty" yaml:"cmdLineRegexes,omitempty"`
+
+	// EnvVars are names of environment variables that should be set for the process.
+	EnvVars []string `json:"envVars,omitempty" yaml:"envVars,omitempty"`
+
+	// MappedLibraries are sub-strings looked up in the process memory maps, e.g. "libjvm.so".
+	// Scanning the maps is more expensive than the other criteria, so prefer those when possible.
+	MappedLibraries []string `json:"mappedLibraries,omitempty" yaml:"mappedLibraries,omitempty"`
+
+	// RuntimeVersion optionally describes how to extract the runtime version of a matching process.
+	RuntimeVersion *CustomInspectorVersionRule `json:"runtimeVersion,omitempty" yaml:"runtimeVersion,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+type CustomInspectorVersionRule struct {
+	// Source of the text the version is extracted from.
+	Source CustomInspectorVersionSource `json:"source" yaml:"source"`
+
+	// EnvVar is the name of the environment variable to read when Source is "env".
+	EnvVar string `json:"envVar,omitempty" yaml:"envVar,omitempty"`
+
+	// Regex is applied to the source text, and its first capture group is used as the version.
+	// Can be omitted when Source is "env", in which case the env var value is used as is.
+	Regex string `json:"regex,omitempty" yaml:"regex,omitempty"`
+}
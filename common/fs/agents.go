@@ -14,6 +14,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/odigos-io/odigos/common/consts"
 	commonlogger "github.com/odigos-io/odigos/common/logger"
 )
 
@@ -66,6 +67,34 @@ func CopyAgentsDirectoryToHost(srcDir, dstDir string, optionalRsyncPath *string)
 	return nil
 }
 
+// CopyAgentChannelsToHost syncs the agents of each agent version channel staged under srcDir
+// to the channels directory of dstDir. Channels are synced like the stable agents, so files
+// which may be memory-mapped by running processes pinned to a channel are preserved.
+func CopyAgentChannelsToHost(srcDir, dstDir string, optionalRsyncPath *string) error {
+	entries, err := os.ReadDir(srcDir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read agent channels directory: %w", err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		channelDstDir := filepath.Join(dstDir, consts.AgentVersionChannelsDirName, entry.Name())
+		if err := CopyAgentsDirectoryToHost(filepath.Join(srcDir, entry.Name()), channelDstDir, optionalRsyncPath); err != nil {
+			return fmt.Errorf("failed to copy agents of channel %s: %w", entry.Name(), err)
+		}
+		// the channel directory is mounted read-only in place of the agents directory,
+		// so it needs the mount point for the opamp exchange directory, which is mounted over it.
+		if err := os.MkdirAll(filepath.Join(channelDstDir, consts.OpampExchangeDirName), 0o755); err != nil {
+			return fmt.Errorf("failed to create exchange directory of channel %s: %w", entry.Name(), err)
+		}
+	}
+	return nil
+}
+
 // ApplyOpenShiftSELinuxSettings makes auto-instrumentation agents readable by containers on RHEL hosts.
 // Note: This function calls chroot to use the host's PATH to execute selinux commands. Calling it will
 // affect the odiglet running process's apparent filesystem.
@@ -247,6 +276,11 @@ func writeKeeplist(dstDir, file string, keeps map[string]struct{}) error {
 		relativePath := strings.TrimPrefix(hostPath, dstDir+"/")
 		_, _ = fmt.Fprintln(w, relativePath) // ignore error
 	}
+	// the agents of non-stable agent version channels are copied to the host separately,
+	// anchored to the root so only the channels directory itself is excluded from the sync.
+	_, _ = fmt.Fprintf(w, "/%s/\n", consts.AgentVersionChannelsDirName) // ignore error
+	// the opamp exchange directory is not part of the agents, and is mounted into containers while they run.
+	_, _ = fmt.Fprintf(w, "/%s/\n", consts.OpampExchangeDirName) // ignore error
 	return w.Flush()
 }
 
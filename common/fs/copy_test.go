@@ -278,3 +278,37 @@ func BenchmarkCopyDirectories(b *testing.B) {
 		}
 	}
 }
+
+func TestCopyAgentChannelsToHost(t *testing.T) {
+	src := t.TempDir()
+	dest := t.TempDir()
+	for _, channel := range []string{"canary", "previous"} {
+		if err := os.MkdirAll(filepath.Join(src, channel, "java"), 0755); err != nil {
+			t.Fatalf("failed to create channel directory: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(src, channel, "java", "javaagent.jar"), []byte(channel), 0644); err != nil {
+			t.Fatalf("failed to create channel file: %v", err)
+		}
+	}
+
+	if err := CopyAgentChannelsToHost(src, dest, nil); err != nil {
+		t.Fatalf("CopyAgentChannelsToHost failed: %v", err)
+	}
+	for _, channel := range []string{"canary", "previous"} {
+		content, err := os.ReadFile(filepath.Join(dest, "channels", channel, "java", "javaagent.jar"))
+		if err != nil {
+			t.Fatalf("failed to read copied channel file: %v", err)
+		}
+		if string(content) != channel {
+			t.Fatalf("unexpected content for channel %s: %s", channel, content)
+		}
+		if _, err := os.Stat(filepath.Join(dest, "channels", channel, "exchange")); err != nil {
+			t.Fatalf("missing exchange mount point for channel %s: %v", channel, err)
+		}
+	}
+
+	// no staged channels is not an error
+	if err := CopyAgentChannelsToHost(filepath.Join(src, "missing"), dest, nil); err != nil {
+		t.Fatalf("CopyAgentChannelsToHost with missing source failed: %v", err)
+	}
+}
@@ -17,7 +17,7 @@ type ProgramLanguageDetails struct {
 	RuntimeVersion string
 }
 
-// +kubebuilder:validation:Enum=java;python;go;dotnet;javascript;php;ruby;rust;cplusplus;mysql;nginx;redis;postgres;unknown;ignored;*
+// +kubebuilder:validation:Enum=java;python;go;dotnet;javascript;php;ruby;rust;cplusplus;swift;elixir;erlang;perl;lua;mysql;nginx;redis;postgres;mongodb;kafka;rabbitmq;elasticsearch;memcached;unknown;ignored;*
 type ProgrammingLanguage string
 
 const (
@@ -35,12 +35,20 @@ const (
 	CSharpProgrammingLanguage     ProgrammingLanguage = "csharp"
 	SwiftProgrammingLanguage      ProgrammingLanguage = "swift"
 	ElixirProgrammingLanguage     ProgrammingLanguage = "elixir"
+	ErlangProgrammingLanguage     ProgrammingLanguage = "erlang"
+	PerlProgrammingLanguage       ProgrammingLanguage = "perl"
+	LuaProgrammingLanguage        ProgrammingLanguage = "lua"
 	// This is an experimental feature, It is not a language
 	// but in order to avoid huge refactoring we are adding it here for now
-	MySQLProgrammingLanguage    ProgrammingLanguage = "mysql"
-	NginxProgrammingLanguage    ProgrammingLanguage = "nginx"
-	RedisProgrammingLanguage    ProgrammingLanguage = "redis"
-	PostgresProgrammingLanguage ProgrammingLanguage = "postgres"
+	MySQLProgrammingLanguage         ProgrammingLanguage = "mysql"
+	NginxProgrammingLanguage         ProgrammingLanguage = "nginx"
+	RedisProgrammingLanguage         ProgrammingLanguage = "redis"
+	PostgresProgrammingLanguage      ProgrammingLanguage = "postgres"
+	MongoDBProgrammingLanguage       ProgrammingLanguage = "mongodb"
+	KafkaProgrammingLanguage         ProgrammingLanguage = "kafka"
+	RabbitMQProgrammingLanguage      ProgrammingLanguage = "rabbitmq"
+	ElasticsearchProgrammingLanguage ProgrammingLanguage = "elasticsearch"
+	MemcachedProgrammingLanguage     ProgrammingLanguage = "memcached"
 	// Used when the language detection is not successful for all the available inspectors
 	UnknownProgrammingLanguage ProgrammingLanguage = "unknown"
 )
@@ -50,6 +58,34 @@ func IsProgrammingLanguageWildcard(lang ProgrammingLanguage) bool {
 	return strings.TrimSpace(string(lang)) == string(ProgrammingLanguageWildcard)
 }
 
+// IsDetectableProgrammingLanguage reports whether lang is a language which process discovery can report for a process.
+// The wildcard, unknown and ignored values are not detectable languages.
+func IsDetectableProgrammingLanguage(lang ProgrammingLanguage) bool {
+	switch lang {
+	case JavaProgrammingLanguage, PythonProgrammingLanguage, GoProgrammingLanguage, DotNetProgrammingLanguage,
+		JavascriptProgrammingLanguage, PhpProgrammingLanguage, RubyProgrammingLanguage, RustProgrammingLanguage,
+		CPlusPlusProgrammingLanguage, SwiftProgrammingLanguage, ElixirProgrammingLanguage, ErlangProgrammingLanguage,
+		PerlProgrammingLanguage, LuaProgrammingLanguage:
+		return true
+	default:
+		return IsInfrastructureProgrammingLanguage(lang)
+	}
+}
+
+// IsInfrastructureProgrammingLanguage reports whether lang is one of the pseudo-languages detected for
+// infrastructure processes (databases, message brokers, caches and proxies).
+// These are not applications, and are not instrumented with an in-process agent.
+func IsInfrastructureProgrammingLanguage(lang ProgrammingLanguage) bool {
+	switch lang {
+	case MySQLProgrammingLanguage, NginxProgrammingLanguage, RedisProgrammingLanguage, PostgresProgrammingLanguage,
+		MongoDBProgrammingLanguage, KafkaProgrammingLanguage, RabbitMQProgrammingLanguage,
+		ElasticsearchProgrammingLanguage, MemcachedProgrammingLanguage:
+		return true
+	default:
+		return false
+	}
+}
+
 // MapOdigosToSemConv maps odigos programming language to OpenTelemetry semantic conventions
 // It is supported only for the languages that are supported by OpenTelemetry [not for mysql, nginx, etc.]
 func MapOdigosToSemConv(odigosPrograminglang ProgrammingLanguage) string {
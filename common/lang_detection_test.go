@@ -47,3 +47,14 @@ func TestParseRuntimeVersion_satisfiesConstraintWithPrereleaseSuffix(t *testing.
 	require.NoError(t, err)
 	require.True(t, constraint.Check(v))
 }
+
+func TestIsDetectableProgrammingLanguage(t *testing.T) {
+	require.True(t, IsDetectableProgrammingLanguage(JavaProgrammingLanguage))
+	require.True(t, IsDetectableProgrammingLanguage(LuaProgrammingLanguage))
+	require.True(t, IsDetectableProgrammingLanguage(KafkaProgrammingLanguage))
+
+	require.False(t, IsDetectableProgrammingLanguage(UnknownProgrammingLanguage))
+	require.False(t, IsDetectableProgrammingLanguage(ProgrammingLanguageWildcard))
+	require.False(t, IsDetectableProgrammingLanguage("Java"))
+	require.False(t, IsDetectableProgrammingLanguage("cobol"))
+}
@@ -5,7 +5,7 @@ package common
 // We should revisit this decision later on and consider if the config should be k8s specific,
 // then move it to the api module.
 
-// +kubebuilder:validation:Enum=k8s-virtual-device;k8s-host-path;k8s-init-container;k8s-csi-driver
+// +kubebuilder:validation:Enum=k8s-virtual-device;k8s-host-path;k8s-init-container;k8s-csi-driver;k8s-image-volume
 type MountMethod string
 
 const (
@@ -13,4 +13,7 @@ const (
 	K8sHostPathMountMethod      MountMethod = "k8s-host-path"
 	K8sInitContainerMountMethod MountMethod = "k8s-init-container"
 	K8sCsiDriverMountMethod     MountMethod = "k8s-csi-driver"
+	// mounts the odigos agents image as a read-only volume, on clusters with the kubernetes ImageVolume feature.
+	// the pods webhook falls back to k8s-init-container when the feature is not available.
+	K8sImageVolumeMountMethod MountMethod = "k8s-image-volume"
 )
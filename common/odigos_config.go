@@ -1,6 +1,8 @@
 package common
 
 import (
+	"time"
+
 	"github.com/odigos-io/odigos/common/api/sampling"
 )
 
@@ -477,6 +479,37 @@ type AgentsInitContainerResources struct {
 	LimitMemoryMiB int `json:"limitMemoryMiB,omitempty" yaml:"limitMemoryMiB,omitempty"`
 }
 
+// AgentResourcesDelta is the amount of resources added to the requests and limits of a container
+// to account for the agent running inside it.
+type AgentResourcesDelta struct {
+	// CPUm is the cpu added to the container, in millicores.
+	CPUm int `json:"cpuMillicores,omitempty" yaml:"cpuMillicores,omitempty"`
+
+	// MemoryMiB is the memory added to the container, in MiB.
+	MemoryMiB int `json:"memoryMiB,omitempty" yaml:"memoryMiB,omitempty"`
+}
+
+// AgentResourcesAdjustmentConfiguration controls whether the pods webhook raises the resources of
+// instrumented containers, so agents do not push them over their limits (e.g. OOMKilled after injecting the java agent).
+// Only requests and limits already set on the container are raised, so the pod QoS class is kept.
+type AgentResourcesAdjustmentConfiguration struct {
+	// enable/disable adjusting the resources of instrumented containers.
+	// disabled by default.
+	Enabled *bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+
+	// per language delta, which replaces the resource overhead estimate of the distribution.
+	Languages map[ProgrammingLanguage]AgentResourcesDelta `json:"languages,omitempty" yaml:"languages,omitempty"`
+
+	// when true, the overhead measured for a container (see ownTelemetry.agentOverhead) is used
+	// instead of the configured or estimated delta, once such a measurement exists.
+	UseMeasuredOverhead *bool `json:"useMeasuredOverhead,omitempty" yaml:"useMeasuredOverhead,omitempty"`
+}
+
+// AgentResourcesAdjustmentActive reports whether the pods webhook should add the agent overhead to the instrumented containers resources.
+func AgentResourcesAdjustmentActive(a *AgentResourcesAdjustmentConfiguration) bool {
+	return a != nil && a.Enabled != nil && *a.Enabled
+}
+
 // +kubebuilder:object:generate=true
 type TraceCorrelationsServiceIOConfiguration struct {
 	// enable/disable for service I/O correlation metrics (serviceio connector).
@@ -531,6 +564,88 @@ type MetricsSourceConfiguration struct {
 type OdigosOwnTelemetryConfiguration struct {
 	// if set to true, odigos will not deploy victoriametrics as own metrics store and will not send own metrics to it.
 	MetricsStoreDisabled *bool `json:"metricsStoreDisabled,omitempty"`
+
+	// how long the own metrics store keeps the metrics (format: 24h, 168h, etc). default is 24h.
+	// features which look back at the metrics, like the service level objectives, are limited to it.
+	MetricsStoreRetention string `json:"metricsStoreRetention,omitempty" yaml:"metricsStoreRetention,omitempty"`
+
+	// configuration for the built-in anomaly detection on span metrics.
+	// requires the own metrics store, which the span metrics are copied into.
+	AnomalyDetection *AnomalyDetectionConfiguration `json:"anomalyDetection,omitempty" yaml:"anomalyDetection,omitempty"`
+
+	// configuration for measuring the cpu and memory overhead the agents add to the instrumented workloads.
+	// requires the own metrics store, and the kubelet stats metrics source to be collected for a metrics destination.
+	AgentOverhead *AgentOverheadConfiguration `json:"agentOverhead,omitempty" yaml:"agentOverhead,omitempty"`
+}
+
+// DefaultMetricsStoreRetention is the retention of the own metrics store when none is configured.
+const DefaultMetricsStoreRetention = 24 * time.Hour
+
+// MetricsStoreRetention returns how long the own metrics store keeps the metrics.
+func MetricsStoreRetention(o *OdigosOwnTelemetryConfiguration) time.Duration {
+	if o == nil || o.MetricsStoreRetention == "" {
+		return DefaultMetricsStoreRetention
+	}
+	d, err := time.ParseDuration(o.MetricsStoreRetention)
+	if err != nil || d <= 0 {
+		return DefaultMetricsStoreRetention
+	}
+	return d
+}
+
+// Agent overhead accounting compares the cpu and memory usage of the containers of each instrumented workload,
+// as reported by the kubelet, in a window before the agent was enabled against the same window after it.
+// Agents that report runtime metrics also have their own usage in the window after it recorded.
+// The autoscaler records the results in the instrumentation config status of the workload.
+type AgentOverheadConfiguration struct {
+	// enable/disable the agent overhead accounting. disabled by default.
+	Enabled *bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+
+	// time range the usage is averaged over, before and after the agent is enabled (format: 30m, 1h, etc). default is 1h.
+	// the window before the agent is enabled must fit in the retention of the own metrics store.
+	MeasurementWindow string `json:"measurementWindow,omitempty" yaml:"measurementWindow,omitempty"`
+}
+
+// AgentOverheadAccountingActive reports whether the kubelet stats of the workloads should be copied to the own metrics store
+// and compared around the agent enablement. Agent overhead accounting is opt-in, and depends on the own metrics store being deployed.
+func AgentOverheadAccountingActive(o *OdigosOwnTelemetryConfiguration) bool {
+	if o == nil || o.AgentOverhead == nil || o.AgentOverhead.Enabled == nil || !*o.AgentOverhead.Enabled {
+		return false
+	}
+	return o.MetricsStoreDisabled == nil || !*o.MetricsStoreDisabled
+}
+
+// Anomaly detection compares the latency, error rate and throughput of each source endpoint,
+// computed from span metrics, against a baseline of the same signal over a longer window.
+// Deviations are recorded as anomalies, which are viewable in the UI.
+type AnomalyDetectionConfiguration struct {
+	// enable/disable the anomaly detection. disabled by default.
+	Enabled *bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+
+	// time range the baseline of each signal is computed over (format: 30m, 1h, etc). default is 1h.
+	BaselineWindow string `json:"baselineWindow,omitempty" yaml:"baselineWindow,omitempty"`
+
+	// recent time range that is compared against the baseline (format: 1m, 5m, etc). default is 5m.
+	EvaluationWindow string `json:"evaluationWindow,omitempty" yaml:"evaluationWindow,omitempty"`
+
+	// how often signals are evaluated (format: 30s, 1m, etc). default is 1m.
+	EvaluationInterval string `json:"evaluationInterval,omitempty" yaml:"evaluationInterval,omitempty"`
+
+	// number of standard deviations from the baseline mean a signal has to cross to be recorded as an anomaly.
+	// default is 3.
+	DeviationThreshold int `json:"deviationThreshold,omitempty" yaml:"deviationThreshold,omitempty"`
+
+	// if set to true, anomalies are also sent as log records to the logs destinations of the affected source.
+	ForwardToDestinations *bool `json:"forwardToDestinations,omitempty" yaml:"forwardToDestinations,omitempty"`
+}
+
+// AnomalyDetectionActive reports whether span metrics should be copied to the own metrics store and evaluated for anomalies.
+// Anomaly detection is opt-in, and depends on the own metrics store being deployed.
+func AnomalyDetectionActive(o *OdigosOwnTelemetryConfiguration) bool {
+	if o == nil || o.AnomalyDetection == nil || o.AnomalyDetection.Enabled == nil || !*o.AnomalyDetection.Enabled {
+		return false
+	}
+	return o.MetricsStoreDisabled == nil || !*o.MetricsStoreDisabled
 }
 
 // Configuration for Odigos auto-kubelet-probes detection and sampling.
@@ -580,6 +695,25 @@ type ProfilingUiConfiguration struct {
 	SlotTTLSeconds int `json:"slotTTLSeconds,omitempty" yaml:"slotTTLSeconds,omitempty"`
 	MaxSlots       int `json:"maxSlots,omitempty" yaml:"maxSlots,omitempty"`
 	SlotMaxBytes   int `json:"slotMaxBytes,omitempty" yaml:"slotMaxBytes,omitempty"`
+	// Persistence keeps aggregated profiles of all sources on the UI pod's disk, so profiles
+	// from before a source was opened in the UI (e.g. yesterday's CPU profile) can be viewed.
+	Persistence *ProfilingPersistenceConfiguration `json:"persistence,omitempty" yaml:"persistence,omitempty"`
+}
+
+// +kubebuilder:object:generate=true
+// ProfilingPersistenceConfiguration controls the on-disk profile storage of the UI backend.
+// Profiles are aggregated per source into one minute buckets, which are merged into coarser
+// buckets once they are older than DownsampleAfterMinutes, and deleted after RetentionHours.
+type ProfilingPersistenceConfiguration struct {
+	Enabled *bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	// RetentionHours is how long profiles are kept. Defaults to 24.
+	RetentionHours int `json:"retentionHours,omitempty" yaml:"retentionHours,omitempty"`
+	// DownsampleAfterMinutes is the age after which one minute buckets are merged. Defaults to 60.
+	DownsampleAfterMinutes int `json:"downsampleAfterMinutes,omitempty" yaml:"downsampleAfterMinutes,omitempty"`
+	// DownsampleIntervalMinutes is the bucket size of downsampled profiles. Defaults to 10.
+	DownsampleIntervalMinutes int `json:"downsampleIntervalMinutes,omitempty" yaml:"downsampleIntervalMinutes,omitempty"`
+	// MaxBytes caps the stored profile data; the oldest buckets are deleted first. Defaults to 256MiB.
+	MaxBytes int64 `json:"maxBytes,omitempty" yaml:"maxBytes,omitempty"`
 }
 
 // +kubebuilder:object:generate=true
@@ -641,6 +775,9 @@ type OdigosConfiguration struct {
 	CentralBackendURL         string                         `json:"centralBackendURL,omitempty" yaml:"centralBackendURL"`
 	ClusterName               string                         `json:"clusterName,omitempty" yaml:"clusterName"`
 	MountMethod               *MountMethod                   `json:"mountMethod,omitempty" yaml:"mountMethod"`
+	// ImageVolumeEnabled overrides the kubernetes version check for the k8s-image-volume mount method.
+	// Set it on clusters older than 1.35 where the ImageVolume feature gate is enabled (1.33 and 1.34).
+	ImageVolumeEnabled *bool `json:"imageVolumeEnabled,omitempty" yaml:"imageVolumeEnabled"`
 	//nolint:lll // CustomContainerRuntimeSocketPath line is long due to struct tag requirements
 	CustomContainerRuntimeSocketPath  string                          `json:"customContainerRuntimeSocketPath,omitempty" yaml:"customContainerRuntimeSocketPath"`
 	AgentEnvVarsInjectionMethod       *EnvInjectionMethod             `json:"agentEnvVarsInjectionMethod,omitempty" yaml:"agentEnvVarsInjectionMethod"`
@@ -651,6 +788,7 @@ type OdigosConfiguration struct {
 	RollbackDisabled                  *bool                           `json:"rollbackDisabled,omitempty" yaml:"rollbackDisabled"`
 	RollbackGraceTime                 string                          `json:"rollbackGraceTime,omitempty" yaml:"rollbackGraceTime"`
 	RollbackStabilityWindow           string                          `json:"rollbackStabilityWindow,omitempty" yaml:"rollbackStabilityWindow"`
+	RollbackEbpfFallback              *bool                           `json:"rollbackEbpfFallback,omitempty" yaml:"rollbackEbpfFallback"`
 	Oidc                              *OidcConfiguration              `json:"oidc,omitempty" yaml:"oidc"`
 	OdigletHealthProbeBindPort        int                             `json:"odigletHealthProbeBindPort,omitempty" yaml:"odigletHealthProbeBindPort"`
 	GoAutoOffsetsCron                 string                          `json:"goAutoOffsetsCron,omitempty" yaml:"goAutoOffsetsCron"`
@@ -665,6 +803,15 @@ type OdigosConfiguration struct {
 	//nolint:lll // AgentsInitContainerResources line is long due to struct tag requirements
 	AgentsInitContainerResources *AgentsInitContainerResources `json:"agentsInitContainerResources,omitempty" yaml:"agentsInitContainerResources"`
 
+	// adds the expected agent overhead to the resources of instrumented containers.
+	//nolint:lll // AgentResourcesAdjustment line is long due to struct tag requirements
+	AgentResourcesAdjustment *AgentResourcesAdjustmentConfiguration `json:"agentResourcesAdjustment,omitempty" yaml:"agentResourcesAdjustment"`
+
+	// agents versions which can be selected for workloads with the agentVersion instrumentation rule,
+	// in addition to the stable channel which is the installed odigos version.
+	//nolint:lll // AgentVersionChannels line is long due to struct tag requirements
+	AgentVersionChannels map[AgentVersionChannel]AgentVersionChannelConfiguration `json:"agentVersionChannels,omitempty" yaml:"agentVersionChannels"`
+
 	// traceIdSuffix when set, instruct odigos to use the "timedwall" id generator
 	// for generating trace ids.
 	// the below value should be a single byte hex value (for example "A3").
@@ -687,6 +834,10 @@ type OdigosConfiguration struct {
 	ComponentLogLevels *ComponentLogLevels `json:"componentLogLevels,omitempty" yaml:"componentLogLevels,omitempty"`
 
 	Profiling *ProfilingConfiguration `json:"profiling,omitempty" yaml:"profiling,omitempty"`
+
+	// CustomInspectors are declarative process discovery inspectors, evaluated by odiglet before the
+	// built-in language inspectors. Use them to detect in-house runtimes which are otherwise misdetected.
+	CustomInspectors []CustomInspector `json:"customInspectors,omitempty" yaml:"customInspectors,omitempty"`
 }
 
 // ProfilingPipelineActive reports whether profiling pipelines and related collector settings should be applied.
@@ -47,8 +47,9 @@ func isTransportUsable(t OpAmpTransport, mountMethod common.MountMethod) bool {
 		return true
 	case OpAmpTransportUnix:
 		// Unix socket cannot be reached from an init container that runs before odiglet's
-		// /var/odigos mount is populated on the target pod.
-		return mountMethod != common.K8sInitContainerMountMethod
+		// /var/odigos mount is populated on the target pod, nor from an image volume which
+		// does not share the odiglet exchange directory on the node.
+		return mountMethod != common.K8sInitContainerMountMethod && mountMethod != common.K8sImageVolumeMountMethod
 	default:
 		return false
 	}
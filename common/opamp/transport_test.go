@@ -31,6 +31,10 @@ func TestResolveTransport(t *testing.T) {
 	require.Equal(t, OpAmpTransportNone,
 		ResolveTransport(true, []OpAmpTransport{OpAmpTransportUnix}, common.K8sInitContainerMountMethod))
 
+	// unix only on image-volume -> none
+	require.Equal(t, OpAmpTransportNone,
+		ResolveTransport(true, []OpAmpTransport{OpAmpTransportUnix}, common.K8sImageVolumeMountMethod))
+
 	// preference order [unix, http]: unix not usable on init-container -> http fallback
 	require.Equal(t, OpAmpTransportHTTP,
 		ResolveTransport(true, []OpAmpTransport{OpAmpTransportUnix, OpAmpTransportHTTP}, common.K8sInitContainerMountMethod))
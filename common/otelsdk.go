@@ -31,3 +31,34 @@ var (
 	OtelSdkNativeEnterprise = OtelSdk{SdkType: NativeOtelSdkType, SdkTier: EnterpriseOtelSdkTier}
 	OtelSdkEbpfEnterprise   = OtelSdk{SdkType: EbpfOtelSdkType, SdkTier: EnterpriseOtelSdkTier}
 )
+
+// Propagator is a context propagation format, named as in the OTEL_PROPAGATORS environment variable.
+// +kubebuilder:validation:Enum=tracecontext;baggage;b3;b3multi;jaeger;xray;ottrace
+type Propagator string
+
+const (
+	// W3C trace context.
+	TraceContextPropagator Propagator = "tracecontext"
+	// W3C baggage.
+	BaggagePropagator Propagator = "baggage"
+	// B3 single header.
+	B3Propagator Propagator = "b3"
+	// B3 multiple headers.
+	B3MultiPropagator Propagator = "b3multi"
+	// Jaeger uber-trace-id header.
+	JaegerPropagator Propagator = "jaeger"
+	// AWS X-Ray.
+	XRayPropagator Propagator = "xray"
+	// OpenTracing ot-tracer headers.
+	OtTracePropagator Propagator = "ottrace"
+)
+
+// OtlpExporterProtocol is the transport protocol agents use to export telemetry to the node collector,
+// named as in the OTEL_EXPORTER_OTLP_PROTOCOL environment variable.
+// +kubebuilder:validation:Enum=grpc;http/protobuf
+type OtlpExporterProtocol string
+
+const (
+	OtlpGrpcExporterProtocol OtlpExporterProtocol = "grpc"
+	OtlpHttpExporterProtocol OtlpExporterProtocol = "http/protobuf"
+)
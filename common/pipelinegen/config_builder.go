@@ -542,10 +542,12 @@ func AddServiceGraphScrapeConfig(c *config.Config) error {
 				"targets": []string{fmt.Sprintf("127.0.0.1:%d", consts.ServiceGraphEndpointPort)},
 			},
 		},
+		// keep the request counts (which carry the "failed" label) and the server latency histogram series,
+		// from which the UI calculates the rate, error rate and latency percentiles of each edge.
 		"metric_relabel_configs": []config.GenericMap{
 			{
 				"source_labels": []string{"__name__"},
-				"regex":         "^servicegraph_traces_service_graph_request_total$",
+				"regex":         "^servicegraph_traces_service_graph_request_(total|server_seconds_(bucket|sum|count))$",
 				"action":        "keep",
 			},
 		},
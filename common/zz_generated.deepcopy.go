@@ -39,6 +39,61 @@ func (in *ComponentLogLevels) DeepCopy() *ComponentLogLevels {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomInspector) DeepCopyInto(out *CustomInspector) {
+	*out = *in
+	if in.ExePathGlobs != nil {
+		in, out := &in.ExePathGlobs, &out.ExePathGlobs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CmdLineRegexes != nil {
+		in, out := &in.CmdLineRegexes, &out.CmdLineRegexes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EnvVars != nil {
+		in, out := &in.EnvVars, &out.EnvVars
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MappedLibraries != nil {
+		in, out := &in.MappedLibraries, &out.MappedLibraries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RuntimeVersion != nil {
+		in, out := &in.RuntimeVersion, &out.RuntimeVersion
+		*out = new(CustomInspectorVersionRule)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomInspector.
+func (in *CustomInspector) DeepCopy() *CustomInspector {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomInspector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomInspectorVersionRule) DeepCopyInto(out *CustomInspectorVersionRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomInspectorVersionRule.
+func (in *CustomInspectorVersionRule) DeepCopy() *CustomInspectorVersionRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomInspectorVersionRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricsSourceAgentJavaRuntimeMetricsConfiguration) DeepCopyInto(out *MetricsSourceAgentJavaRuntimeMetricsConfiguration) {
 	*out = *in
@@ -337,7 +392,7 @@ func (in *ProfilingConfiguration) DeepCopyInto(out *ProfilingConfiguration) {
 	if in.Ui != nil {
 		in, out := &in.Ui, &out.Ui
 		*out = new(ProfilingUiConfiguration)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -351,6 +406,26 @@ func (in *ProfilingConfiguration) DeepCopy() *ProfilingConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfilingPersistenceConfiguration) DeepCopyInto(out *ProfilingPersistenceConfiguration) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfilingPersistenceConfiguration.
+func (in *ProfilingPersistenceConfiguration) DeepCopy() *ProfilingPersistenceConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfilingPersistenceConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProfilingSymbolizationConfiguration) DeepCopyInto(out *ProfilingSymbolizationConfiguration) {
 	*out = *in
@@ -374,6 +449,11 @@ func (in *ProfilingSymbolizationConfiguration) DeepCopy() *ProfilingSymbolizatio
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProfilingUiConfiguration) DeepCopyInto(out *ProfilingUiConfiguration) {
 	*out = *in
+	if in.Persistence != nil {
+		in, out := &in.Persistence, &out.Persistence
+		*out = new(ProfilingPersistenceConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfilingUiConfiguration.
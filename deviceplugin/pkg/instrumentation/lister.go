@@ -2,10 +2,12 @@ package instrumentation
 
 import (
 	"context"
+	"path/filepath"
 
 	"github.com/odigos-io/odigos-device-plugin/pkg/dpm"
 
 	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/consts"
 )
 
 // redefining here to avoid depending on the api package
@@ -13,8 +15,12 @@ var OdigosAgentsDirectory = "/var/odigos"
 
 const OdigosGenericPluginName = "generic"
 
+// each agent version channel other than stable is served by its own generic device,
+// which mounts the agents of the channel into the container in place of the installed ones.
+var agentVersionChannels = []common.AgentVersionChannel{common.PreviousAgentVersionChannel, common.CanaryAgentVersionChannel}
+
 type lister struct {
-	genericPlugin dpm.PluginInterface
+	plugins map[string]dpm.PluginInterface
 }
 
 func (l *lister) GetResourceNamespace() string {
@@ -22,11 +28,15 @@ func (l *lister) GetResourceNamespace() string {
 }
 
 func (l *lister) Discover(pluginNameLists chan dpm.PluginNameList) {
-	pluginNameLists <- dpm.PluginNameList{OdigosGenericPluginName}
+	pluginNames := dpm.PluginNameList{OdigosGenericPluginName}
+	for _, channel := range agentVersionChannels {
+		pluginNames = append(pluginNames, OdigosGenericPluginName+"-"+string(channel))
+	}
+	pluginNameLists <- pluginNames
 }
 
 func (l *lister) NewPlugin(s string) dpm.PluginInterface {
-	return l.genericPlugin
+	return l.plugins[s]
 }
 
 func NewLister(ctx context.Context) (dpm.ListerInterface, error) {
@@ -37,7 +47,15 @@ func NewLister(ctx context.Context) (dpm.ListerInterface, error) {
 	// thus set it to a large number to avoid any pod being rejected due to no available device amount.
 	initialDeviceSize := int64(1024)
 
+	plugins := map[string]dpm.PluginInterface{
+		OdigosGenericPluginName: NewPlugin(initialDeviceSize, OdigosAgentsDirectory),
+	}
+	for _, channel := range agentVersionChannels {
+		channelAgentsDirectory := filepath.Join(OdigosAgentsDirectory, consts.AgentVersionChannelsDirName, string(channel))
+		plugins[OdigosGenericPluginName+"-"+string(channel)] = NewPlugin(initialDeviceSize, channelAgentsDirectory)
+	}
+
 	return &lister{
-		genericPlugin: NewPlugin(initialDeviceSize),
+		plugins: plugins,
 	}, nil
 }
@@ -3,10 +3,12 @@ package instrumentation
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 
 	"github.com/google/uuid"
 	"github.com/odigos-io/odigos-device-plugin/pkg/dpm"
 
+	"github.com/odigos-io/odigos/common/consts"
 	commonlogger "github.com/odigos-io/odigos/common/logger"
 	"k8s.io/kubelet/pkg/apis/deviceplugin/v1beta1"
 )
@@ -15,9 +17,11 @@ type plugin struct {
 	v1beta1.UnimplementedDevicePluginServer
 	devices []*v1beta1.Device
 	stopCh  chan struct{}
+	// the mounts added to each container which is allocated a device
+	mounts []*v1beta1.Mount
 }
 
-func NewPlugin(initialSize int64) dpm.PluginInterface {
+func NewPlugin(initialSize int64, agentsHostDirectory string) dpm.PluginInterface {
 	devicesList := make([]*v1beta1.Device, initialSize)
 	for i := int64(0); i < initialSize; i++ {
 		devicesList[i] = &v1beta1.Device{
@@ -29,9 +33,32 @@ func NewPlugin(initialSize int64) dpm.PluginInterface {
 	return &plugin{
 		devices: devicesList,
 		stopCh:  make(chan struct{}),
+		mounts:  agentsMounts(agentsHostDirectory),
 	}
 }
 
+// agentsMounts returns the mounts serving the agents from agentsHostDirectory in the odigos agents directory.
+func agentsMounts(agentsHostDirectory string) []*v1beta1.Mount {
+	mounts := []*v1beta1.Mount{
+		{
+			ContainerPath: OdigosAgentsDirectory,
+			HostPath:      agentsHostDirectory,
+			ReadOnly:      true,
+		},
+	}
+	if agentsHostDirectory != OdigosAgentsDirectory {
+		// the agents of a channel are mounted in place of the installed ones, which hides the opamp exchange
+		// directory of odiglet, so it is mounted on its own for the agents to reach the opamp unix socket.
+		exchangeDirectory := filepath.Join(OdigosAgentsDirectory, consts.OpampExchangeDirName)
+		mounts = append(mounts, &v1beta1.Mount{
+			ContainerPath: exchangeDirectory,
+			HostPath:      exchangeDirectory,
+			ReadOnly:      true,
+		})
+	}
+	return mounts
+}
+
 func (p *plugin) GetDevicePluginOptions(ctx context.Context, empty *v1beta1.Empty) (*v1beta1.DevicePluginOptions, error) {
 	return &v1beta1.DevicePluginOptions{
 		PreStartRequired:                false,
@@ -85,13 +112,7 @@ func (p *plugin) Allocate(ctx context.Context, request *v1beta1.AllocateRequest)
 		}
 
 		containerAllocateResponse := &v1beta1.ContainerAllocateResponse{
-			Mounts: []*v1beta1.Mount{
-				{
-					ContainerPath: OdigosAgentsDirectory,
-					HostPath:      OdigosAgentsDirectory,
-					ReadOnly:      true,
-				},
-			},
+			Mounts: p.mounts,
 		}
 		res.ContainerResponses = append(res.ContainerResponses, containerAllocateResponse)
 	}
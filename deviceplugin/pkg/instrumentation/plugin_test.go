@@ -0,0 +1,67 @@
+package instrumentation
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/kubelet/pkg/apis/deviceplugin/v1beta1"
+)
+
+func allocateMounts(t *testing.T, p *plugin) map[string]string {
+	t.Helper()
+	res, err := p.Allocate(context.Background(), &v1beta1.AllocateRequest{
+		ContainerRequests: []*v1beta1.ContainerAllocateRequest{{DevicesIds: []string{"device"}}},
+	})
+	if err != nil {
+		t.Fatalf("Allocate failed: %v", err)
+	}
+	if len(res.ContainerResponses) != 1 {
+		t.Fatalf("expected 1 container response, got %d", len(res.ContainerResponses))
+	}
+	mounts := make(map[string]string)
+	for _, mount := range res.ContainerResponses[0].Mounts {
+		if !mount.ReadOnly {
+			t.Errorf("mount %s is not read-only", mount.ContainerPath)
+		}
+		mounts[mount.ContainerPath] = mount.HostPath
+	}
+	return mounts
+}
+
+func TestAllocateStableAgents(t *testing.T) {
+	lister, err := NewLister(context.Background())
+	if err != nil {
+		t.Fatalf("NewLister failed: %v", err)
+	}
+	mounts := allocateMounts(t, lister.NewPlugin(OdigosGenericPluginName).(*plugin))
+
+	if len(mounts) != 1 || mounts["/var/odigos"] != "/var/odigos" {
+		t.Errorf("unexpected mounts for the stable agents: %v", mounts)
+	}
+}
+
+func TestAllocateChannelAgentsKeepsExchangeDirectory(t *testing.T) {
+	lister, err := NewLister(context.Background())
+	if err != nil {
+		t.Fatalf("NewLister failed: %v", err)
+	}
+	mounts := allocateMounts(t, lister.NewPlugin(OdigosGenericPluginName+"-canary").(*plugin))
+
+	if mounts["/var/odigos"] != "/var/odigos/channels/canary" {
+		t.Errorf("expected the canary agents to be mounted as the agents directory, got %v", mounts)
+	}
+	// the opamp unix socket is served from the exchange directory, which the channel mount hides
+	if mounts["/var/odigos/exchange"] != "/var/odigos/exchange" {
+		t.Errorf("expected the exchange directory to be mounted, got %v", mounts)
+	}
+}
+
+func TestAllocateRejectsMultipleDevices(t *testing.T) {
+	p := NewPlugin(1, OdigosAgentsDirectory).(*plugin)
+	_, err := p.Allocate(context.Background(), &v1beta1.AllocateRequest{
+		ContainerRequests: []*v1beta1.ContainerAllocateRequest{{DevicesIds: []string{"a", "b"}}},
+	})
+	if err == nil {
+		t.Fatal("expected an error when more than one device is requested")
+	}
+}
@@ -1,6 +1,7 @@
 package distro
 
 import (
+	"slices"
 	"text/template"
 
 	"github.com/odigos-io/odigos/common"
@@ -9,8 +10,15 @@ import (
 
 const AgentPlaceholderDirectory = "{{ODIGOS_AGENTS_DIR}}"
 
+// replaced with the upper cased name of an instrumentation library, in DisabledInstrumentationLibraryEnvNamePattern.
+const InstrumentationLibraryPlaceholder = "{{INSTRUMENTATION_LIBRARY}}"
+
 const RuntimeVersionMajorMinorDistroParameterName = "RUNTIME_VERSION_MAJOR_MINOR"
 
+// EbpfInstrumentationDistroName is the name of the language agnostic eBPF instrumentation distro (OBI),
+// which instruments a process without injecting an agent into it.
+const EbpfInstrumentationDistroName = "opentelemetry-ebpf-instrumentation"
+
 type RuntimeEnvironment struct {
 	// the runtime environment this distribution targets.
 	// examples: nodejs, JVM, CPython, etc.
@@ -70,6 +78,16 @@ type EnvironmentVariables struct {
 
 	// list of environment variables that needs to be appended based on the existing value.
 	AppendOdigosVariables []AppendOdigosEnvironmentVariable `yaml:"appendOdigosVariables,omitempty"`
+
+	// environment variable which takes a comma separated list of instrumentation libraries to disable,
+	// e.g. OTEL_PYTHON_DISABLED_INSTRUMENTATIONS.
+	// used for the disabled instrumentation libraries of a container override.
+	DisabledInstrumentationLibrariesEnvName string `yaml:"disabledInstrumentationLibrariesEnvName,omitempty"`
+
+	// for agents which disable each instrumentation library with its own environment variable set to "false",
+	// the name of that variable, with {{INSTRUMENTATION_LIBRARY}} in place of the upper cased library name.
+	// e.g. OTEL_INSTRUMENTATION_{{INSTRUMENTATION_LIBRARY}}_ENABLED.
+	DisabledInstrumentationLibraryEnvNamePattern string `yaml:"disabledInstrumentationLibraryEnvNamePattern,omitempty"`
 }
 
 // this struct describes environment variables that needs to be set in the application runtime
@@ -133,6 +151,42 @@ type RuntimeAgent struct {
 	// runtime constraints (mount method, runtime version, …). Empty defaults to [http].
 	// Only consulted when EnvironmentVariables.OpAmpClientEnvironments is true.
 	OpAmpTransportsSupported []commonopamp.OpAmpTransport `yaml:"opAmpTransportsSupported,omitempty"`
+
+	// AgentFiles describes where the agent files of a user-defined distribution are taken from.
+	// Distributions shipped with odigos leave it empty, as their files are provided by odiglet or the odigos agents image.
+	AgentFiles *AgentFiles `yaml:"agentFiles,omitempty"`
+
+	// ResourceOverhead is an estimate of the additional resources the agent consumes in the instrumented container.
+	// It is used to warn about containers whose limits leave no headroom for the agent.
+	ResourceOverhead *ResourceOverhead `yaml:"resourceOverhead,omitempty"`
+}
+
+// ResourceOverhead values are kubernetes quantities, e.g. "100Mi" for memory and "50m" for cpu.
+// Empty values mean the overhead is unknown.
+type ResourceOverhead struct {
+	Memory string `yaml:"memory,omitempty"`
+	CPU    string `yaml:"cpu,omitempty"`
+}
+
+// AgentFiles is the source of the directoryNames of a user-defined distribution.
+// Exactly one of the sources should be set.
+// Each directory in directoryNames is looked up by its base name in the source.
+type AgentFiles struct {
+	// A container image which holds the agent directories under ImagePath.
+	// The directories are copied by an init container (using `sh` and `cp` from the image) into an emptyDir volume of the pod.
+	Image string `yaml:"image,omitempty"`
+
+	// The directory in Image which holds the agent directories.
+	// Defaults to /instrumentations, the layout of the odigos agents image.
+	ImagePath string `yaml:"imagePath,omitempty"`
+
+	// The name of a persistent volume claim which holds the agent directories.
+	// The claim is looked up in the namespace of the instrumented pod.
+	PersistentVolumeClaimName string `yaml:"persistentVolumeClaimName,omitempty"`
+
+	// A directory on the node which holds the agent directories.
+	// It is up to the user to provision it on every node the instrumented pods can run on.
+	HostPath string `yaml:"hostPath,omitempty"`
 }
 
 type Option struct {
@@ -163,6 +217,9 @@ type AgentMetrics struct {
 	// configuration for this distro's support for agent runtime metrics.
 	// these are runtime environment metrics like JVM metrics, heap usage, etc.
 	RuntimeMetrics *RuntimeMetrics `yaml:"runtimeMetrics,omitempty"`
+
+	// configuration for this distro's support for recording baggage entries as attributes of metric measurements.
+	BaggageAttributes *BaggageAttributes `yaml:"baggageAttributes,omitempty"`
 }
 
 type HeadSampling struct {
@@ -213,6 +270,30 @@ type TraceVerbosity struct {
 	DisablingAnyScopeSupported bool `yaml:"disablingAnyScopeSupported,omitempty"`
 }
 
+type ContextPropagation struct {
+	// the propagators the agent can extract and inject the trace context with.
+	// configured propagators which are not listed are not passed to the agent,
+	// and are reported as unsupported in the ContextPropagation condition of the InstrumentationConfig.
+	SupportedPropagators []common.Propagator `yaml:"supportedPropagators,omitempty"`
+}
+
+// SupportsPropagator returns true if the agent of the distro can extract and inject the trace context with the propagator.
+func (d *OtelDistro) SupportsPropagator(propagator common.Propagator) bool {
+	if d.Traces == nil || d.Traces.ContextPropagation == nil {
+		return true
+	}
+	return slices.Contains(d.Traces.ContextPropagation.SupportedPropagators, propagator)
+}
+
+type BaggageAttributes struct {
+	// if true, the distro supports recording selected baggage entries as attributes in the agent.
+	Supported bool `yaml:"supported,omitempty"`
+
+	// if set, the agent reads the baggage keys to record from this environment variable, as a comma separated list,
+	// and needs a restart when they change. otherwise the keys are sent to the agent with its remote config.
+	EnvName string `yaml:"envName,omitempty"`
+}
+
 type EbpfLogCapture struct {
 	// if true, the distro supports eBPF-based log capture.
 	Supported bool `yaml:"supported,omitempty"`
@@ -221,6 +302,9 @@ type EbpfLogCapture struct {
 type Logs struct {
 	// if set, the distro supports eBPF-based log capture instead of filelog.
 	EbpfLogCapture *EbpfLogCapture `yaml:"ebpfLogCapture,omitempty"`
+
+	// if set, the distro supports recording baggage entries as attributes of log records.
+	BaggageAttributes *BaggageAttributes `yaml:"baggageAttributes,omitempty"`
 }
 
 // document support by this distro for agent own diagnostics features
@@ -262,6 +346,14 @@ type Traces struct {
 	// if set, the distro supports configuring verbosity of traces - which spans should be included / excluded.
 	// the exact features and level of support is specified in the TraceVerbosity struct.
 	TraceVerbosity *TraceVerbosity `yaml:"traceVerbosity,omitempty"`
+
+	// if set, the distro only supports some of the context propagators.
+	// if not set, all the propagators configured for a container are passed to the agent.
+	ContextPropagation *ContextPropagation `yaml:"contextPropagation,omitempty"`
+
+	// if set, the distro supports recording baggage entries as attributes of spans.
+	// when not supported, the entries are parsed in the gateway collector from the recorded baggage request header.
+	BaggageAttributes *BaggageAttributes `yaml:"baggageAttributes,omitempty"`
 }
 
 // OtelDistro (Short for OpenTelemetry Distribution) is a collection of OpenTelemetry components,
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"text/template"
 
 	"github.com/hashicorp/go-version"
@@ -63,22 +64,78 @@ func (c *communityDefaulter) GetDefaultVmDistroNames() map[common.ProgrammingLan
 
 type Getter struct {
 	distrosByName map[string]*distro.OtelDistro
+
+	// user-defined distributions are loaded at runtime, and can be replaced at any time
+	// while the getter is in use, thus they are kept apart from the built-in ones and guarded by a lock.
+	userDefinedMu            sync.RWMutex
+	userDefinedDistrosByName map[string]*distro.OtelDistro
 }
 
 func (g *Getter) GetDistroByName(distroName string) *distro.OtelDistro {
-	return g.distrosByName[distroName]
+	if d, ok := g.distrosByName[distroName]; ok {
+		return d
+	}
+	g.userDefinedMu.RLock()
+	defer g.userDefinedMu.RUnlock()
+	return g.userDefinedDistrosByName[distroName]
 }
 
 // GetAllDistros returns all the distributions available in the getter.
 // used in the enterprise repo
 func (g *Getter) GetAllDistros() []*distro.OtelDistro {
-	distros := make([]*distro.OtelDistro, 0, len(g.distrosByName))
+	g.userDefinedMu.RLock()
+	defer g.userDefinedMu.RUnlock()
+	distros := make([]*distro.OtelDistro, 0, len(g.distrosByName)+len(g.userDefinedDistrosByName))
 	for _, d := range g.distrosByName {
 		distros = append(distros, d)
 	}
+	for _, d := range g.userDefinedDistrosByName {
+		distros = append(distros, d)
+	}
 	return distros
 }
 
+// SetUserDefinedDistros replaces the user-defined distributions available in the getter.
+// The distributions are expected to be parsed and validated with ParseUserDefinedDistro.
+// A user-defined distribution cannot shadow a built-in distribution with the same name.
+func (g *Getter) SetUserDefinedDistros(userDefinedDistros []*distro.OtelDistro) error {
+	distrosByName := make(map[string]*distro.OtelDistro, len(userDefinedDistros))
+	for _, d := range userDefinedDistros {
+		if _, ok := g.distrosByName[d.Name]; ok {
+			return fmt.Errorf("user-defined distribution %s conflicts with a built-in distribution", d.Name)
+		}
+		if _, ok := distrosByName[d.Name]; ok {
+			return fmt.Errorf("user-defined distribution %s is defined more than once", d.Name)
+		}
+		distrosByName[d.Name] = d
+	}
+	// the fallback chain is walked when resolving a distribution for a runtime version,
+	// so it must end, either at a built-in distribution or at one without a fallback.
+	for _, d := range userDefinedDistros {
+		visited := map[string]struct{}{d.Name: {}}
+		for current := d; current.FallbackDistro != nil; {
+			fallbackName := *current.FallbackDistro
+			if _, ok := g.distrosByName[fallbackName]; ok {
+				break
+			}
+			if _, ok := visited[fallbackName]; ok {
+				return fmt.Errorf("fallback chain of user-defined distribution %s has a cycle", d.Name)
+			}
+			visited[fallbackName] = struct{}{}
+			fallback, ok := distrosByName[fallbackName]
+			if !ok {
+				return fmt.Errorf("fallback distribution %s of user-defined distribution %s not found", fallbackName, d.Name)
+			}
+			current = fallback
+		}
+	}
+
+	g.userDefinedMu.Lock()
+	defer g.userDefinedMu.Unlock()
+	g.userDefinedDistrosByName = distrosByName
+	return nil
+}
+
 // ResolveDistroNameForVersion walks the fallbackDistro chain starting from defaultDistroName,
 // returning the name of the first distro whose SupportedVersions constraint matches runtimeVersion.
 // If runtimeVersion is empty or cannot be parsed, defaultDistroName is returned unchanged.
@@ -184,23 +241,30 @@ func GetDistrosMap(fs embed.FS) (map[string]*distro.OtelDistro, error) {
 			return nil, err
 		}
 
-		otelDistro := &otelDistroResource.Spec
-
-		// we pre-parse the templates and store it as a field in the distro.
-		// 1. this reduces the amount of work done at runtime
-		// 2. it allows us to validate the templates at startup, and not at runtime
-		// 3. pre-flag which env vars are templated, and which are not, to avoid unnecessary work at runtime
-		otelDistro.EnvironmentVariables.StaticVariables, err = addTemplatesToStaticEnvVars(otelDistro.EnvironmentVariables.StaticVariables)
+		otelDistro, err := prepareDistro(&otelDistroResource.Spec)
 		if err != nil {
 			return nil, err
 		}
 
-		distrosByName[otelDistroResource.Spec.Name] = otelDistro
+		distrosByName[otelDistro.Name] = otelDistro
 	}
 
 	return distrosByName, nil
 }
 
+func prepareDistro(otelDistro *distro.OtelDistro) (*distro.OtelDistro, error) {
+	var err error
+	// we pre-parse the templates and store it as a field in the distro.
+	// 1. this reduces the amount of work done at runtime
+	// 2. it allows us to validate the templates at startup, and not at runtime
+	// 3. pre-flag which env vars are templated, and which are not, to avoid unnecessary work at runtime
+	otelDistro.EnvironmentVariables.StaticVariables, err = addTemplatesToStaticEnvVars(otelDistro.EnvironmentVariables.StaticVariables)
+	if err != nil {
+		return nil, err
+	}
+	return otelDistro, nil
+}
+
 func getEnvVarTemplate(envVar *distro.StaticEnvironmentVariable) (*template.Template, error) {
 	if envVar.Template != nil {
 		// not expected to happen, but just in case
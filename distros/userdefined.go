@@ -0,0 +1,156 @@
+package distros
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/distros/distro"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	OtelDistributionApiVersion = "internal.odigos.io/v1beta1"
+	OtelDistributionKind       = "OtelDistribution"
+
+	// the name of a user-defined distribution is used to name the pod volume and init container
+	// which provide its agent files, so it is bounded to fit in a kubernetes name with a prefix.
+	userDefinedDistroNameMaxLength = 50
+)
+
+var userDefinedDistroNameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// the same layout as the yaml files of the built-in distributions.
+type userDefinedDistroResource struct {
+	ApiVersion string `yaml:"apiVersion"`
+	Kind       string `yaml:"kind"`
+	Metadata   struct {
+		Name string `yaml:"name"`
+	} `yaml:"metadata"`
+	Spec distro.OtelDistro `yaml:"spec"`
+}
+
+// ParseUserDefinedDistro parses and validates an OtelDistribution yaml document provided by the user at runtime,
+// in the same format as the yaml files of the built-in distributions.
+// Unknown fields are rejected, so typos in the definition do not silently change the injected agent.
+func ParseUserDefinedDistro(yamlBytes []byte) (*distro.OtelDistro, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(yamlBytes))
+	decoder.KnownFields(true)
+
+	resource := userDefinedDistroResource{}
+	if err := decoder.Decode(&resource); err != nil {
+		return nil, fmt.Errorf("failed to parse distribution: %w", err)
+	}
+	if resource.ApiVersion != OtelDistributionApiVersion || resource.Kind != OtelDistributionKind {
+		return nil, fmt.Errorf("expected apiVersion %s and kind %s, got %s %s", OtelDistributionApiVersion, OtelDistributionKind, resource.ApiVersion, resource.Kind)
+	}
+	if resource.Metadata.Name != "" && resource.Metadata.Name != resource.Spec.Name {
+		return nil, fmt.Errorf("metadata name %s does not match spec name %s", resource.Metadata.Name, resource.Spec.Name)
+	}
+
+	otelDistro := &resource.Spec
+	if err := validateUserDefinedDistro(otelDistro); err != nil {
+		return nil, fmt.Errorf("invalid distribution %s: %w", otelDistro.Name, err)
+	}
+	if otelDistro.DisplayName == "" {
+		otelDistro.DisplayName = otelDistro.Name
+	}
+
+	// templates are only computed from the env values, never taken from the yaml
+	for i := range otelDistro.EnvironmentVariables.StaticVariables {
+		otelDistro.EnvironmentVariables.StaticVariables[i].Template = nil
+	}
+	return prepareDistro(otelDistro)
+}
+
+// validateUserDefinedDistro rejects distributions which odigos cannot inject from a runtime definition.
+// eBPF distributions, and the agent features which depend on files shipped by odiglet (virtual device,
+// loader, wasp and instrumentation without restart), require code or files that only odigos provides.
+func validateUserDefinedDistro(d *distro.OtelDistro) error {
+	if len(d.Name) > userDefinedDistroNameMaxLength || !userDefinedDistroNameRegex.MatchString(d.Name) {
+		return fmt.Errorf("name must be a lowercase alphanumeric string with hyphens of up to %d characters", userDefinedDistroNameMaxLength)
+	}
+	if d.Language == "" || d.Language == common.UnknownProgrammingLanguage || common.IsProgrammingLanguageWildcard(d.Language) {
+		return errors.New("language must be a specific programming language")
+	}
+	if d.IsEbpf {
+		return errors.New("eBPF distributions cannot be user-defined")
+	}
+
+	for _, runtimeEnvironment := range d.RuntimeEnvironments {
+		if runtimeEnvironment.SupportedVersions == "" {
+			continue
+		}
+		if _, err := version.NewConstraint(runtimeEnvironment.SupportedVersions); err != nil {
+			return fmt.Errorf("invalid supportedVersions of runtime environment %s: %w", runtimeEnvironment.Name, err)
+		}
+	}
+
+	for _, envVar := range d.EnvironmentVariables.StaticVariables {
+		if envVar.EnvName == "" {
+			return errors.New("static environment variable without envName")
+		}
+	}
+	for _, envVar := range d.EnvironmentVariables.AppendOdigosVariables {
+		if envVar.EnvName == "" {
+			return errors.New("append environment variable without envName")
+		}
+		// without the placeholder, the value set by the user for the variable would be dropped
+		if !strings.Contains(envVar.ReplacePattern, distro.OriginalEnvValuePlaceholder) {
+			return fmt.Errorf("replacePattern of %s must contain %s", envVar.EnvName, distro.OriginalEnvValuePlaceholder)
+		}
+	}
+
+	// a distribution without a runtime agent is instrumented by odiglet, which only knows the built-in ones
+	if d.RuntimeAgent == nil {
+		return errors.New("runtimeAgent is required")
+	}
+	return validateUserDefinedRuntimeAgent(d.RuntimeAgent)
+}
+
+func validateUserDefinedRuntimeAgent(runtimeAgent *distro.RuntimeAgent) error {
+	if runtimeAgent.Device != nil {
+		return errors.New("runtimeAgent.device is not supported for user-defined distributions")
+	}
+	if runtimeAgent.LdPreloadInjectionSupported || runtimeAgent.WaspSupported || runtimeAgent.NoRestartRequired {
+		return errors.New("runtimeAgent.ldPreloadInjectionSupported, waspSupported and noRestartRequired are not supported for user-defined distributions")
+	}
+
+	// directories are mounted into the container by their base name, so they must be direct children of the agents directory
+	for _, directoryName := range runtimeAgent.DirectoryNames {
+		relative, ok := strings.CutPrefix(directoryName, distro.AgentPlaceholderDirectory+"/")
+		if !ok || relative == "" || strings.Contains(relative, "/") || relative == "." || relative == ".." {
+			return fmt.Errorf("directory %s must be a direct child of %s", directoryName, distro.AgentPlaceholderDirectory)
+		}
+	}
+
+	agentFiles := runtimeAgent.AgentFiles
+	if agentFiles == nil {
+		return nil
+	}
+	if len(runtimeAgent.DirectoryNames) == 0 {
+		return errors.New("runtimeAgent.agentFiles requires runtimeAgent.directoryNames")
+	}
+	sources := 0
+	for _, source := range []string{agentFiles.Image, agentFiles.PersistentVolumeClaimName, agentFiles.HostPath} {
+		if source != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return errors.New("exactly one of runtimeAgent.agentFiles image, persistentVolumeClaimName or hostPath must be set")
+	}
+	if agentFiles.ImagePath != "" && (agentFiles.Image == "" || !path.IsAbs(agentFiles.ImagePath)) {
+		return errors.New("runtimeAgent.agentFiles.imagePath must be an absolute path and requires an image")
+	}
+	if agentFiles.HostPath != "" && !path.IsAbs(agentFiles.HostPath) {
+		return errors.New("runtimeAgent.agentFiles.hostPath must be an absolute path")
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.70
+
+import (
+	"context"
+
+	"github.com/odigos-io/odigos/frontend/graph/model"
+	"github.com/odigos-io/odigos/frontend/services/anomalies"
+)
+
+// Anomalies is the resolver for the anomalies field.
+func (r *queryResolver) Anomalies(ctx context.Context, filter *model.WorkloadFilter, includeResolved *bool) ([]*model.Anomaly, error) {
+	enabled, err := anomalies.IsEnabled(ctx, r.K8sCacheClient)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled || r.AnomalyStore == nil {
+		return nil, anomalies.ErrNotEnabled
+	}
+
+	out := []*model.Anomaly{}
+	for _, a := range r.AnomalyStore.List(includeResolved != nil && *includeResolved) {
+		if anomalyMatchesFilter(a, filter) {
+			out = append(out, anomalyToGql(a))
+		}
+	}
+	return out, nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/odigos-io/odigos/api/odigos/v1alpha1"
 	"github.com/odigos-io/odigos/common"
@@ -13,6 +14,8 @@ import (
 	"github.com/odigos-io/odigos/common/config/testconnection"
 	"github.com/odigos-io/odigos/frontend/graph/model"
 	"github.com/odigos-io/odigos/frontend/services"
+	"github.com/odigos-io/odigos/frontend/services/anomalies"
+	"github.com/odigos-io/odigos/frontend/services/profiles"
 	"github.com/odigos-io/odigos/k8sutils/pkg/workload"
 )
 
@@ -128,9 +131,42 @@ func instrumentationConfigToActualSource(ctx context.Context, instruConfig v1alp
 		Conditions:                services.ConvertConditions(instruConfig.Status.Conditions),
 		ManifestYaml:              &manifestYAML,
 		InstrumentationConfigYaml: &instrumentationConfigYAML,
+		AgentOverhead:             agentOverheadToModel(instruConfig.Status.AgentOverhead),
 	}, nil
 }
 
+func agentOverheadToModel(overhead *v1alpha1.AgentOverhead) *model.SourceAgentOverhead {
+	if overhead == nil {
+		return nil
+	}
+	containers := make([]*model.ContainerAgentOverhead, 0, len(overhead.Containers))
+	for _, c := range overhead.Containers {
+		container := &model.ContainerAgentOverhead{
+			ContainerName:       c.ContainerName,
+			CPUMillicoresBefore: int(c.CPUMillicoresBefore),
+			CPUMillicoresAfter:  int(c.CPUMillicoresAfter),
+			MemoryBytesBefore:   float64(c.MemoryBytesBefore),
+			MemoryBytesAfter:    float64(c.MemoryBytesAfter),
+		}
+		if c.RuntimeCPUMillicoresAfter != nil {
+			cpuMillicores := int(*c.RuntimeCPUMillicoresAfter)
+			container.RuntimeCPUMillicoresAfter = &cpuMillicores
+		}
+		if c.RuntimeMemoryBytesAfter != nil {
+			memoryBytes := float64(*c.RuntimeMemoryBytesAfter)
+			container.RuntimeMemoryBytesAfter = &memoryBytes
+		}
+		containers = append(containers, container)
+	}
+	return &model.SourceAgentOverhead{
+		InstrumentationTime: overhead.InstrumentationTime.UTC().Format(time.RFC3339),
+		Window:              overhead.Window,
+		MeasurementTime:     overhead.MeasurementTime.UTC().Format(time.RFC3339),
+		Containers:          containers,
+		Message:             services.StringPtrIfNotEmpty(overhead.Message),
+	}
+}
+
 func RemoteConfigToModel(config *common.OdigosConfiguration) *model.RemoteConfig {
 	if config == nil {
 		return nil
@@ -1177,3 +1213,95 @@ func destinationInputToConfigurer(destination model.DestinationInput) *testconne
 		Signals:         signals,
 	}
 }
+
+func profileSelectorFromInput(input model.ProfileSelectorInput) (profiles.ProfileSelector, error) {
+	selector := profiles.ProfileSelector{}
+	if input.From != nil && *input.From != "" {
+		from, err := time.Parse(time.RFC3339, *input.From)
+		if err != nil {
+			return selector, fmt.Errorf("invalid profile selector start %q: %w", *input.From, err)
+		}
+		selector.From = from
+	}
+	if input.To != nil && *input.To != "" {
+		to, err := time.Parse(time.RFC3339, *input.To)
+		if err != nil {
+			return selector, fmt.Errorf("invalid profile selector end %q: %w", *input.To, err)
+		}
+		selector.To = to
+	}
+	if len(input.ResourceAttributes) > 0 {
+		selector.ResourceAttributes = make(map[string]string, len(input.ResourceAttributes))
+		for _, attr := range input.ResourceAttributes {
+			selector.ResourceAttributes[attr.Key] = attr.Value
+		}
+	}
+	return selector, nil
+}
+
+func spanFilterFromInput(input model.ProfileSpanFilterInput) profiles.SpanFilter {
+	filter := profiles.SpanFilter{}
+	if input.TraceID != nil {
+		filter.TraceID = *input.TraceID
+	}
+	if input.SpanID != nil {
+		filter.SpanID = *input.SpanID
+	}
+	if input.HTTPRoute != nil {
+		filter.HTTPRoute = *input.HTTPRoute
+	}
+	return filter
+}
+
+var anomalySignalToGql = map[anomalies.Signal]model.AnomalySignal{
+	anomalies.SignalLatency:    model.AnomalySignalLatency,
+	anomalies.SignalErrorRate:  model.AnomalySignalErrorRate,
+	anomalies.SignalThroughput: model.AnomalySignalThroughput,
+}
+
+func anomalyToGql(a anomalies.Anomaly) *model.Anomaly {
+	direction := model.AnomalyDirectionIncrease
+	if a.Direction == anomalies.DirectionDecrease {
+		direction = model.AnomalyDirectionDecrease
+	}
+	out := &model.Anomaly{
+		ID:             a.ID,
+		Namespace:      a.Endpoint.Namespace,
+		Kind:           kindToGql(a.Endpoint.Kind),
+		Name:           a.Endpoint.Name,
+		ContainerName:  a.Endpoint.Container,
+		Endpoint:       a.Endpoint.SpanName,
+		Signal:         anomalySignalToGql[a.Signal],
+		Direction:      direction,
+		Value:          a.Value,
+		BaselineMean:   a.BaselineMean,
+		BaselineStddev: a.BaselineStddev,
+		Deviation:      a.Deviation,
+		StartedAt:      a.StartedAt.UTC().Format(time.RFC3339),
+		LastSeenAt:     a.LastSeenAt.UTC().Format(time.RFC3339),
+	}
+	if a.Endpoint.HttpRoute != "" {
+		out.HTTPRoute = &a.Endpoint.HttpRoute
+	}
+	if a.ResolvedAt != nil {
+		resolvedAt := a.ResolvedAt.UTC().Format(time.RFC3339)
+		out.ResolvedAt = &resolvedAt
+	}
+	return out
+}
+
+func anomalyMatchesFilter(a anomalies.Anomaly, filter *model.WorkloadFilter) bool {
+	if filter == nil {
+		return true
+	}
+	if filter.Namespace != nil && *filter.Namespace != a.Endpoint.Namespace {
+		return false
+	}
+	if filter.Kind != nil && !strings.EqualFold(string(*filter.Kind), a.Endpoint.Kind) {
+		return false
+	}
+	if filter.Name != nil && *filter.Name != a.Endpoint.Name {
+		return false
+	}
+	return true
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/odigos-io/odigos/frontend/graph/model"
+	"github.com/odigos-io/odigos/frontend/services"
 	"github.com/odigos-io/odigos/frontend/services/describe/odigos_describe"
 	"github.com/odigos-io/odigos/frontend/services/describe/source_describe"
 )
@@ -21,3 +22,13 @@ func (r *queryResolver) DescribeOdigos(ctx context.Context) (*model.OdigosAnalyz
 func (r *queryResolver) DescribeSource(ctx context.Context, namespace string, kind string, name string) (*model.SourceAnalyze, error) {
 	return source_describe.GetSourceDescription(ctx, namespace, kind, name)
 }
+
+// InjectionPreview is the resolver for the injectionPreview field.
+func (r *queryResolver) InjectionPreview(ctx context.Context, namespace string, kind string, name string) (*model.InjectionPreview, error) {
+	return services.GetInjectionPreview(ctx, namespace, kind, name)
+}
+
+// CompatibilityReport is the resolver for the compatibilityReport field.
+func (r *queryResolver) CompatibilityReport(ctx context.Context, namespace string) (*model.NamespaceCompatibilityReport, error) {
+	return services.GetCompatibilityReport(ctx, namespace)
+}
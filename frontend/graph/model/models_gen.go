@@ -50,6 +50,7 @@ type ActionFields struct {
 	Scopes                         *SourcesScopes                   `json:"scopes,omitempty"`
 	TemplatizeLiterals             *bool                            `json:"templatizeLiterals,omitempty"`
 	RemovePostgresCastOperator     *bool                            `json:"removePostgresCastOperator,omitempty"`
+	BaggageKeys                    []string                         `json:"baggageKeys,omitempty"`
 }
 
 type ActionFieldsInput struct {
@@ -72,6 +73,7 @@ type ActionFieldsInput struct {
 	Scopes                         *SourcesScopesInput                   `json:"scopes,omitempty"`
 	TemplatizeLiterals             *bool                                 `json:"templatizeLiterals,omitempty"`
 	RemovePostgresCastOperator     *bool                                 `json:"removePostgresCastOperator,omitempty"`
+	BaggageKeys                    []string                              `json:"baggageKeys,omitempty"`
 }
 
 type ActionInput struct {
@@ -105,6 +107,25 @@ type AllowConcurrentAgentsConfig struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
 
+type Anomaly struct {
+	ID             string           `json:"id"`
+	Namespace      string           `json:"namespace"`
+	Kind           K8sResourceKind  `json:"kind"`
+	Name           string           `json:"name"`
+	ContainerName  string           `json:"containerName"`
+	Endpoint       string           `json:"endpoint"`
+	HTTPRoute      *string          `json:"httpRoute,omitempty"`
+	Signal         AnomalySignal    `json:"signal"`
+	Direction      AnomalyDirection `json:"direction"`
+	Value          float64          `json:"value"`
+	BaselineMean   float64          `json:"baselineMean"`
+	BaselineStddev float64          `json:"baselineStddev"`
+	Deviation      float64          `json:"deviation"`
+	StartedAt      string           `json:"startedAt"`
+	LastSeenAt     string           `json:"lastSeenAt"`
+	ResolvedAt     *string          `json:"resolvedAt,omitempty"`
+}
+
 type APIToken struct {
 	Token     string  `json:"token"`
 	Name      string  `json:"name"`
@@ -220,6 +241,11 @@ type CollectorPodMetrics struct {
 	LastScrape         *string `json:"lastScrape,omitempty"`
 }
 
+type CompatibilityBlocker struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
 type ComponentLogLevelsConfig struct {
 	Default      *OdigosLogLevel `json:"default,omitempty"`
 	Autoscaler   *OdigosLogLevel `json:"autoscaler,omitempty"`
@@ -289,6 +315,29 @@ type ContainerAgentConfigAnalyze struct {
 	OtelDistroName *EntityProperty `json:"otelDistroName,omitempty"`
 }
 
+// Average usage of a container, per pod, before and after its agent was enabled
+type ContainerAgentOverhead struct {
+	ContainerName       string  `json:"containerName"`
+	CPUMillicoresBefore int     `json:"cpuMillicoresBefore"`
+	CPUMillicoresAfter  int     `json:"cpuMillicoresAfter"`
+	MemoryBytesBefore   float64 `json:"memoryBytesBefore"`
+	MemoryBytesAfter    float64 `json:"memoryBytesAfter"`
+	// Usage of the instrumented process after its agent was enabled, as reported by the runtime metrics of the agent itself
+	RuntimeCPUMillicoresAfter *int     `json:"runtimeCpuMillicoresAfter,omitempty"`
+	RuntimeMemoryBytesAfter   *float64 `json:"runtimeMemoryBytesAfter,omitempty"`
+}
+
+type ContainerCompatibility struct {
+	ContainerName  string                  `json:"containerName"`
+	Language       *string                 `json:"language,omitempty"`
+	RuntimeVersion *string                 `json:"runtimeVersion,omitempty"`
+	OtelDistroName *string                 `json:"otelDistroName,omitempty"`
+	Supported      bool                    `json:"supported"`
+	Reason         *string                 `json:"reason,omitempty"`
+	Message        *string                 `json:"message,omitempty"`
+	Blockers       []*CompatibilityBlocker `json:"blockers"`
+}
+
 type ContainerOverview struct {
 	Name        string                   `json:"name"`
 	Image       *string                  `json:"image,omitempty"`
@@ -720,6 +769,18 @@ type HTTPPayloadCollectionInput struct {
 	DropPartialPayloads *bool     `json:"dropPartialPayloads,omitempty"`
 }
 
+type InjectionPatchOperation struct {
+	Op    string  `json:"op"`
+	Path  string  `json:"path"`
+	Value *string `json:"value,omitempty"`
+}
+
+type InjectionPreview struct {
+	Injected bool                       `json:"injected"`
+	Patch    []*InjectionPatchOperation `json:"patch"`
+	Reasons  []string                   `json:"reasons"`
+}
+
 type InstrumentationInstanceAnalyze struct {
 	Healthy               *EntityProperty   `json:"healthy"`
 	Message               *EntityProperty   `json:"message,omitempty"`
@@ -763,6 +824,7 @@ type InstrumentationRule struct {
 	PayloadCollection        *PayloadCollection                 `json:"payloadCollection,omitempty"`
 	CustomInstrumentations   *CustomInstrumentations            `json:"customInstrumentations,omitempty"`
 	NetworkMetrics           *bool                              `json:"networkMetrics,omitempty"`
+	Profiling                *ProfilingRule                     `json:"profiling,omitempty"`
 }
 
 type InstrumentationRuleFieldYamlProperties struct {
@@ -786,6 +848,7 @@ type InstrumentationRuleInput struct {
 	PayloadCollection        *PayloadCollectionInput                 `json:"payloadCollection,omitempty"`
 	CustomInstrumentations   *CustomInstrumentationsInput            `json:"customInstrumentations,omitempty"`
 	NetworkMetrics           *bool                                   `json:"networkMetrics,omitempty"`
+	Profiling                *ProfilingRuleInput                     `json:"profiling,omitempty"`
 }
 
 type InstrumentationRuleSourcesScope struct {
@@ -855,6 +918,8 @@ type K8sActualSource struct {
 	Conditions                []*Condition       `json:"conditions,omitempty"`
 	ManifestYaml              *string            `json:"manifestYAML,omitempty"`
 	InstrumentationConfigYaml *string            `json:"instrumentationConfigYAML,omitempty"`
+	// CPU and memory overhead of the agents, measured when agent overhead accounting is enabled
+	AgentOverhead *SourceAgentOverhead `json:"agentOverhead,omitempty"`
 	// Buffered CPU profile for this source
 	Profiling *SourceProfilingResult `json:"profiling,omitempty"`
 }
@@ -1340,6 +1405,12 @@ type MetricsSourceSpanMetricsConfig struct {
 type Mutation struct {
 }
 
+type NamespaceCompatibilityReport struct {
+	Namespace       string                   `json:"namespace"`
+	Workloads       []*WorkloadCompatibility `json:"workloads"`
+	InspectionError *string                  `json:"inspectionError,omitempty"`
+}
+
 type NodeCollectorAnalyze struct {
 	Enabled        *EntityProperty `json:"enabled"`
 	CollectorGroup *EntityProperty `json:"collectorGroup"`
@@ -1531,10 +1602,43 @@ type PodWorkloadInput struct {
 	Name      string          `json:"name"`
 }
 
+// Resource attribute a profile must have, e.g. k8s.pod.name or service.version
+type ProfileResourceAttributeInput struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Selects buffered profiles for one side of a diff. Omitted fields match all profiles.
+// Times are RFC3339.
+type ProfileSelectorInput struct {
+	From               *string                          `json:"from,omitempty"`
+	To                 *string                          `json:"to,omitempty"`
+	ResourceAttributes []*ProfileResourceAttributeInput `json:"resourceAttributes,omitempty"`
+}
+
+// Selects the profile samples taken while a span was active. At least one field is required.
+// Samples carry a span when the agent publishes its active span to the profiler
+// (sample links, or trace_id/span_id/http.route labels on the sampled thread).
+type ProfileSpanFilterInput struct {
+	TraceID   *string `json:"traceId,omitempty"`
+	SpanID    *string `json:"spanId,omitempty"`
+	HTTPRoute *string `json:"httpRoute,omitempty"`
+}
+
 type ProfilingConfig struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
 
+type ProfilingRule struct {
+	ProfileTypes        []ProfilingRuleProfileType `json:"profileTypes,omitempty"`
+	SamplingFrequencyHz *int                       `json:"samplingFrequencyHz,omitempty"`
+}
+
+type ProfilingRuleInput struct {
+	ProfileTypes        []ProfilingRuleProfileType `json:"profileTypes,omitempty"`
+	SamplingFrequencyHz *int                       `json:"samplingFrequencyHz,omitempty"`
+}
+
 // In-memory profiling buffer stats
 type ProfilingSlots struct {
 	ActiveKeys          []string `json:"activeKeys"`
@@ -1671,8 +1775,66 @@ type SamplingRules struct {
 	CostReductionRules       []*CostReductionRule           `json:"costReductionRules"`
 }
 
+type ServiceLevelObjective struct {
+	Name                    string                           `json:"name"`
+	Namespace               string                           `json:"namespace"`
+	Kind                    K8sResourceKind                  `json:"kind"`
+	WorkloadName            string                           `json:"workloadName"`
+	Type                    ServiceLevelObjectiveType        `json:"type"`
+	Target                  float64                          `json:"target"`
+	LatencyThresholdMs      *int                             `json:"latencyThresholdMs,omitempty"`
+	HTTPRoute               *string                          `json:"httpRoute,omitempty"`
+	HTTPRoutePrefix         *string                          `json:"httpRoutePrefix,omitempty"`
+	HTTPMethod              *string                          `json:"httpMethod,omitempty"`
+	KeepBudgetBurningTraces bool                             `json:"keepBudgetBurningTraces"`
+	Sli                     *float64                         `json:"sli,omitempty"`
+	SliWindow               *string                          `json:"sliWindow,omitempty"`
+	ErrorBudgetRemaining    *float64                         `json:"errorBudgetRemaining,omitempty"`
+	BurnRates               []*ServiceLevelObjectiveBurnRate `json:"burnRates"`
+	LastEvaluationTime      *string                          `json:"lastEvaluationTime,omitempty"`
+	Conditions              []*Condition                     `json:"conditions"`
+}
+
+type ServiceLevelObjectiveBurnRate struct {
+	Window   string  `json:"window"`
+	BurnRate float64 `json:"burnRate"`
+}
+
 type ServiceMap struct {
-	Services []*ServiceMapFromSource `json:"services"`
+	Services         []*ServiceMapFromSource     `json:"services"`
+	From             string                      `json:"from"`
+	To               string                      `json:"to"`
+	HealthThresholds *ServiceMapHealthThresholds `json:"healthThresholds"`
+}
+
+type ServiceMapDiff struct {
+	BaselineFrom string                `json:"baselineFrom"`
+	BaselineTo   string                `json:"baselineTo"`
+	CurrentFrom  string                `json:"currentFrom"`
+	CurrentTo    string                `json:"currentTo"`
+	Edges        []*ServiceMapEdgeDiff `json:"edges"`
+}
+
+type ServiceMapEdgeDiff struct {
+	FromNodeID      string                 `json:"fromNodeId"`
+	FromServiceName string                 `json:"fromServiceName"`
+	ToNodeID        string                 `json:"toNodeId"`
+	ToServiceName   string                 `json:"toServiceName"`
+	IsVirtual       bool                   `json:"isVirtual"`
+	Change          ServiceMapEdgeChange   `json:"change"`
+	Details         []string               `json:"details"`
+	Baseline        *ServiceMapEdgeMetrics `json:"baseline"`
+	Current         *ServiceMapEdgeMetrics `json:"current"`
+}
+
+type ServiceMapEdgeMetrics struct {
+	Requests     int                  `json:"requests"`
+	RequestRate  float64              `json:"requestRate"`
+	ErrorRate    float64              `json:"errorRate"`
+	LatencyP50Ms *float64             `json:"latencyP50Ms,omitempty"`
+	LatencyP95Ms *float64             `json:"latencyP95Ms,omitempty"`
+	LatencyP99Ms *float64             `json:"latencyP99Ms,omitempty"`
+	Health       ServiceMapEdgeHealth `json:"health"`
 }
 
 type ServiceMapFromSource struct {
@@ -1681,6 +1843,25 @@ type ServiceMapFromSource struct {
 	Services    []*ServiceMapToSource `json:"services"`
 }
 
+type ServiceMapHealthThresholds struct {
+	ErrorRateWarning     float64 `json:"errorRateWarning"`
+	ErrorRateCritical    float64 `json:"errorRateCritical"`
+	LatencyP95WarningMs  float64 `json:"latencyP95WarningMs"`
+	LatencyP95CriticalMs float64 `json:"latencyP95CriticalMs"`
+}
+
+type ServiceMapHealthThresholdsInput struct {
+	ErrorRateWarning     *float64 `json:"errorRateWarning,omitempty"`
+	ErrorRateCritical    *float64 `json:"errorRateCritical,omitempty"`
+	LatencyP95WarningMs  *float64 `json:"latencyP95WarningMs,omitempty"`
+	LatencyP95CriticalMs *float64 `json:"latencyP95CriticalMs,omitempty"`
+}
+
+type ServiceMapTimeRange struct {
+	From string  `json:"from"`
+	To   *string `json:"to,omitempty"`
+}
+
 type ServiceMapToSource struct {
 	NodeID         string                     `json:"nodeId"`
 	IsVirtual      bool                       `json:"isVirtual"`
@@ -1688,6 +1869,7 @@ type ServiceMapToSource struct {
 	Requests       int                        `json:"requests"`
 	DateTime       string                     `json:"dateTime"`
 	NodeAttributes []*NonIdentifyingAttribute `json:"nodeAttributes"`
+	Metrics        *ServiceMapEdgeMetrics     `json:"metrics,omitempty"`
 }
 
 type SingleDestinationMetricsResponse struct {
@@ -1704,6 +1886,17 @@ type SingleSourceMetricsResponse struct {
 	Throughput    int    `json:"throughput"`
 }
 
+type SourceAgentOverhead struct {
+	// RFC3339 time the agents were enabled, the usage is compared around it
+	InstrumentationTime string `json:"instrumentationTime"`
+	// Length of the windows before and after the instrumentation time the usage is averaged over
+	Window          string                    `json:"window"`
+	MeasurementTime string                    `json:"measurementTime"`
+	Containers      []*ContainerAgentOverhead `json:"containers"`
+	// Why some or all of the containers could not be measured
+	Message *string `json:"message,omitempty"`
+}
+
 type SourceAnalyze struct {
 	Name            *EntityProperty                `json:"name"`
 	Kind            *EntityProperty                `json:"kind"`
@@ -1733,6 +1926,13 @@ type SourceContainer struct {
 	OtelDistroName         *string `json:"otelDistroName,omitempty"`
 }
 
+// Pyroscope-style differential flame profile (JSON string, "double" format).
+// Left is the baseline and right is the comparison; symbols lists the functions
+// whose self/total share grew or shrank, largest self change first.
+type SourceProfilingDiffResult struct {
+	ProfileJSON string `json:"profileJson"`
+}
+
 // Pyroscope-style flame profile for one source (JSON string).
 type SourceProfilingResult struct {
 	ProfileJSON string `json:"profileJson"`
@@ -1833,14 +2033,22 @@ type TraceCorrelationsConfig struct {
 }
 
 type TraceCorrelationsInputGroup struct {
-	Attributes []*NonIdentifyingAttribute       `json:"attributes"`
-	Outputs    []*TraceCorrelationsOutputSeries `json:"outputs"`
+	Attributes     []*NonIdentifyingAttribute       `json:"attributes"`
+	HTTPRoute      *string                          `json:"httpRoute,omitempty"`
+	Outputs        []*TraceCorrelationsOutputSeries `json:"outputs"`
+	DominantOutput *TraceCorrelationsOutputSeries   `json:"dominantOutput,omitempty"`
 }
 
 type TraceCorrelationsOutputSeries struct {
-	Attributes      []*NonIdentifyingAttribute `json:"attributes"`
-	ConnectionCount int                        `json:"connectionCount"`
-	FirstDetectedAt string                     `json:"firstDetectedAt"`
+	Attributes       []*NonIdentifyingAttribute `json:"attributes"`
+	ConnectionCount  int                        `json:"connectionCount"`
+	ErrorCount       int                        `json:"errorCount"`
+	ErrorRate        float64                    `json:"errorRate"`
+	LatencyP50Ms     *float64                   `json:"latencyP50Ms,omitempty"`
+	LatencyP95Ms     *float64                   `json:"latencyP95Ms,omitempty"`
+	AverageLatencyMs *float64                   `json:"averageLatencyMs,omitempty"`
+	LatencyShare     *float64                   `json:"latencyShare,omitempty"`
+	FirstDetectedAt  string                     `json:"firstDetectedAt"`
 }
 
 type TraceCorrelationsServiceIOConfig struct {
@@ -1930,6 +2138,13 @@ type WaspConfig struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
 
+type WorkloadCompatibility struct {
+	Kind         string                    `json:"kind"`
+	Name         string                    `json:"name"`
+	Instrumented bool                      `json:"instrumented"`
+	Containers   []*ContainerCompatibility `json:"containers"`
+}
+
 type WorkloadFilter struct {
 	Namespace                *string          `json:"namespace,omitempty"`
 	Kind                     *K8sResourceKind `json:"kind,omitempty"`
@@ -1949,6 +2164,7 @@ const (
 	ActionTypeExtractAttribute      ActionType = "ExtractAttribute"
 	ActionTypeDbQueryTemplatization ActionType = "DbQueryTemplatization"
 	ActionTypeInferDbAttributes     ActionType = "InferDbAttributes"
+	ActionTypeBaggageAttributes     ActionType = "BaggageAttributes"
 	ActionTypeUnknownType           ActionType = "UnknownType"
 )
 
@@ -1962,12 +2178,13 @@ var AllActionType = []ActionType{
 	ActionTypeExtractAttribute,
 	ActionTypeDbQueryTemplatization,
 	ActionTypeInferDbAttributes,
+	ActionTypeBaggageAttributes,
 	ActionTypeUnknownType,
 }
 
 func (e ActionType) IsValid() bool {
 	switch e {
-	case ActionTypeK8sAttributesResolver, ActionTypeAddClusterInfo, ActionTypeDeleteAttribute, ActionTypeRenameAttribute, ActionTypePiiMasking, ActionTypeURLTemplatization, ActionTypeExtractAttribute, ActionTypeDbQueryTemplatization, ActionTypeInferDbAttributes, ActionTypeUnknownType:
+	case ActionTypeK8sAttributesResolver, ActionTypeAddClusterInfo, ActionTypeDeleteAttribute, ActionTypeRenameAttribute, ActionTypePiiMasking, ActionTypeURLTemplatization, ActionTypeExtractAttribute, ActionTypeDbQueryTemplatization, ActionTypeInferDbAttributes, ActionTypeBaggageAttributes, ActionTypeUnknownType:
 		return true
 	}
 	return false
@@ -1994,6 +2211,90 @@ func (e ActionType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type AnomalyDirection string
+
+const (
+	AnomalyDirectionIncrease AnomalyDirection = "Increase"
+	AnomalyDirectionDecrease AnomalyDirection = "Decrease"
+)
+
+var AllAnomalyDirection = []AnomalyDirection{
+	AnomalyDirectionIncrease,
+	AnomalyDirectionDecrease,
+}
+
+func (e AnomalyDirection) IsValid() bool {
+	switch e {
+	case AnomalyDirectionIncrease, AnomalyDirectionDecrease:
+		return true
+	}
+	return false
+}
+
+func (e AnomalyDirection) String() string {
+	return string(e)
+}
+
+func (e *AnomalyDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AnomalyDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AnomalyDirection", str)
+	}
+	return nil
+}
+
+func (e AnomalyDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type AnomalySignal string
+
+const (
+	AnomalySignalLatency    AnomalySignal = "Latency"
+	AnomalySignalErrorRate  AnomalySignal = "ErrorRate"
+	AnomalySignalThroughput AnomalySignal = "Throughput"
+)
+
+var AllAnomalySignal = []AnomalySignal{
+	AnomalySignalLatency,
+	AnomalySignalErrorRate,
+	AnomalySignalThroughput,
+}
+
+func (e AnomalySignal) IsValid() bool {
+	switch e {
+	case AnomalySignalLatency, AnomalySignalErrorRate, AnomalySignalThroughput:
+		return true
+	}
+	return false
+}
+
+func (e AnomalySignal) String() string {
+	return string(e)
+}
+
+func (e *AnomalySignal) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AnomalySignal(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AnomalySignal", str)
+	}
+	return nil
+}
+
+func (e AnomalySignal) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ComputePlatformType string
 
 const (
@@ -2415,6 +2716,7 @@ const (
 	InstrumentationRuleTypePayloadCollection     InstrumentationRuleType = "PayloadCollection"
 	InstrumentationRuleTypeCustomInstrumentation InstrumentationRuleType = "CustomInstrumentation"
 	InstrumentationRuleTypeNetworkMetrics        InstrumentationRuleType = "NetworkMetrics"
+	InstrumentationRuleTypeProfiling             InstrumentationRuleType = "Profiling"
 	InstrumentationRuleTypeUnknownType           InstrumentationRuleType = "UnknownType"
 )
 
@@ -2424,12 +2726,13 @@ var AllInstrumentationRuleType = []InstrumentationRuleType{
 	InstrumentationRuleTypePayloadCollection,
 	InstrumentationRuleTypeCustomInstrumentation,
 	InstrumentationRuleTypeNetworkMetrics,
+	InstrumentationRuleTypeProfiling,
 	InstrumentationRuleTypeUnknownType,
 }
 
 func (e InstrumentationRuleType) IsValid() bool {
 	switch e {
-	case InstrumentationRuleTypeCodeAttributes, InstrumentationRuleTypeHeadersCollection, InstrumentationRuleTypePayloadCollection, InstrumentationRuleTypeCustomInstrumentation, InstrumentationRuleTypeNetworkMetrics, InstrumentationRuleTypeUnknownType:
+	case InstrumentationRuleTypeCodeAttributes, InstrumentationRuleTypeHeadersCollection, InstrumentationRuleTypePayloadCollection, InstrumentationRuleTypeCustomInstrumentation, InstrumentationRuleTypeNetworkMetrics, InstrumentationRuleTypeProfiling, InstrumentationRuleTypeUnknownType:
 		return true
 	}
 	return false
@@ -2690,6 +2993,7 @@ const (
 	MountMethodK8sHostPath      MountMethod = "k8s_host_path"
 	MountMethodK8sInitContainer MountMethod = "k8s_init_container"
 	MountMethodK8sCsiDriver     MountMethod = "k8s_csi_driver"
+	MountMethodK8sImageVolume   MountMethod = "k8s_image_volume"
 )
 
 var AllMountMethod = []MountMethod{
@@ -2697,11 +3001,12 @@ var AllMountMethod = []MountMethod{
 	MountMethodK8sHostPath,
 	MountMethodK8sInitContainer,
 	MountMethodK8sCsiDriver,
+	MountMethodK8sImageVolume,
 }
 
 func (e MountMethod) IsValid() bool {
 	switch e {
-	case MountMethodK8sVirtualDevice, MountMethodK8sHostPath, MountMethodK8sInitContainer, MountMethodK8sCsiDriver:
+	case MountMethodK8sVirtualDevice, MountMethodK8sHostPath, MountMethodK8sInitContainer, MountMethodK8sCsiDriver, MountMethodK8sImageVolume:
 		return true
 	}
 	return false
@@ -2918,6 +3223,47 @@ func (e PodPhase) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ProfilingRuleProfileType string
+
+const (
+	ProfilingRuleProfileTypeCPU    ProfilingRuleProfileType = "Cpu"
+	ProfilingRuleProfileTypeOffCPU ProfilingRuleProfileType = "OffCpu"
+)
+
+var AllProfilingRuleProfileType = []ProfilingRuleProfileType{
+	ProfilingRuleProfileTypeCPU,
+	ProfilingRuleProfileTypeOffCPU,
+}
+
+func (e ProfilingRuleProfileType) IsValid() bool {
+	switch e {
+	case ProfilingRuleProfileTypeCPU, ProfilingRuleProfileTypeOffCPU:
+		return true
+	}
+	return false
+}
+
+func (e ProfilingRuleProfileType) String() string {
+	return string(e)
+}
+
+func (e *ProfilingRuleProfileType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProfilingRuleProfileType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProfilingRuleProfileType", str)
+	}
+	return nil
+}
+
+func (e ProfilingRuleProfileType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ProgrammingLanguage string
 
 const (
@@ -3065,6 +3411,135 @@ func (e SamplingWorkloadLanguage) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type ServiceLevelObjectiveType string
+
+const (
+	ServiceLevelObjectiveTypeLatency      ServiceLevelObjectiveType = "Latency"
+	ServiceLevelObjectiveTypeAvailability ServiceLevelObjectiveType = "Availability"
+)
+
+var AllServiceLevelObjectiveType = []ServiceLevelObjectiveType{
+	ServiceLevelObjectiveTypeLatency,
+	ServiceLevelObjectiveTypeAvailability,
+}
+
+func (e ServiceLevelObjectiveType) IsValid() bool {
+	switch e {
+	case ServiceLevelObjectiveTypeLatency, ServiceLevelObjectiveTypeAvailability:
+		return true
+	}
+	return false
+}
+
+func (e ServiceLevelObjectiveType) String() string {
+	return string(e)
+}
+
+func (e *ServiceLevelObjectiveType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ServiceLevelObjectiveType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ServiceLevelObjectiveType", str)
+	}
+	return nil
+}
+
+func (e ServiceLevelObjectiveType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ServiceMapEdgeChange string
+
+const (
+	ServiceMapEdgeChangeAdded   ServiceMapEdgeChange = "Added"
+	ServiceMapEdgeChangeRemoved ServiceMapEdgeChange = "Removed"
+	ServiceMapEdgeChangeChanged ServiceMapEdgeChange = "Changed"
+)
+
+var AllServiceMapEdgeChange = []ServiceMapEdgeChange{
+	ServiceMapEdgeChangeAdded,
+	ServiceMapEdgeChangeRemoved,
+	ServiceMapEdgeChangeChanged,
+}
+
+func (e ServiceMapEdgeChange) IsValid() bool {
+	switch e {
+	case ServiceMapEdgeChangeAdded, ServiceMapEdgeChangeRemoved, ServiceMapEdgeChangeChanged:
+		return true
+	}
+	return false
+}
+
+func (e ServiceMapEdgeChange) String() string {
+	return string(e)
+}
+
+func (e *ServiceMapEdgeChange) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ServiceMapEdgeChange(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ServiceMapEdgeChange", str)
+	}
+	return nil
+}
+
+func (e ServiceMapEdgeChange) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ServiceMapEdgeHealth string
+
+const (
+	ServiceMapEdgeHealthHealthy  ServiceMapEdgeHealth = "Healthy"
+	ServiceMapEdgeHealthWarning  ServiceMapEdgeHealth = "Warning"
+	ServiceMapEdgeHealthCritical ServiceMapEdgeHealth = "Critical"
+	ServiceMapEdgeHealthUnknown  ServiceMapEdgeHealth = "Unknown"
+)
+
+var AllServiceMapEdgeHealth = []ServiceMapEdgeHealth{
+	ServiceMapEdgeHealthHealthy,
+	ServiceMapEdgeHealthWarning,
+	ServiceMapEdgeHealthCritical,
+	ServiceMapEdgeHealthUnknown,
+}
+
+func (e ServiceMapEdgeHealth) IsValid() bool {
+	switch e {
+	case ServiceMapEdgeHealthHealthy, ServiceMapEdgeHealthWarning, ServiceMapEdgeHealthCritical, ServiceMapEdgeHealthUnknown:
+		return true
+	}
+	return false
+}
+
+func (e ServiceMapEdgeHealth) String() string {
+	return string(e)
+}
+
+func (e *ServiceMapEdgeHealth) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ServiceMapEdgeHealth(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ServiceMapEdgeHealth", str)
+	}
+	return nil
+}
+
+func (e ServiceMapEdgeHealth) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type SignalType string
 
 const (
@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/odigos-io/odigos/common"
 	"github.com/odigos-io/odigos/frontend/graph/model"
@@ -134,3 +135,66 @@ func (r *queryResolver) ProfilingSlots(ctx context.Context) (*model.ProfilingSlo
 		SlotTTLSeconds:      stats.SlotTTLSeconds,
 	}, nil
 }
+
+// SourceProfilingDiff is the resolver for the sourceProfilingDiff field.
+func (r *queryResolver) SourceProfilingDiff(ctx context.Context, namespace string, kind string, name string, baseline model.ProfileSelectorInput, comparison model.ProfileSelectorInput) (*model.SourceProfilingDiffResult, error) {
+	if r.ProfileStore == nil {
+		return nil, fmt.Errorf("profiling store not configured")
+	}
+	baselineSelector, err := profileSelectorFromInput(baseline)
+	if err != nil {
+		return nil, err
+	}
+	comparisonSelector, err := profileSelectorFromInput(comparison)
+	if err != nil {
+		return nil, err
+	}
+	profileJSON, err := profiles.GetProfilingDiffForSource(ctx, r.ProfileStore, namespace, kind, name, baselineSelector, comparisonSelector)
+	if err != nil {
+		return nil, err
+	}
+	return &model.SourceProfilingDiffResult{ProfileJSON: profileJSON}, nil
+}
+
+// SourceSpanProfiling is the resolver for the sourceSpanProfiling field.
+func (r *queryResolver) SourceSpanProfiling(ctx context.Context, namespace string, kind string, name string, filter model.ProfileSpanFilterInput) (*model.SourceProfilingResult, error) {
+	if r.ProfileStore == nil {
+		return nil, fmt.Errorf("profiling store not configured")
+	}
+	out, err := profiles.GetSpanProfilingForSource(ctx, r.ProfileStore, namespace, kind, name, spanFilterFromInput(filter))
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(out.Profile)
+	if err != nil {
+		return nil, err
+	}
+	return &model.SourceProfilingResult{ProfileJSON: string(b)}, nil
+}
+
+// SourcePersistedProfiling is the resolver for the sourcePersistedProfiling field.
+func (r *queryResolver) SourcePersistedProfiling(ctx context.Context, namespace string, kind string, name string, from string, to *string) (*model.SourceProfilingResult, error) {
+	if r.ProfilePersistence == nil {
+		return nil, fmt.Errorf("profile persistence is not enabled")
+	}
+	fromTime, err := time.Parse(time.RFC3339, from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid profile range start %q: %w", from, err)
+	}
+	toTime := time.Now()
+	if to != nil && *to != "" {
+		toTime, err = time.Parse(time.RFC3339, *to)
+		if err != nil {
+			return nil, fmt.Errorf("invalid profile range end %q: %w", *to, err)
+		}
+	}
+	out, err := profiles.GetPersistedProfilingForSource(ctx, r.ProfilePersistence, namespace, kind, name, fromTime, toTime)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(out.Profile)
+	if err != nil {
+		return nil, err
+	}
+	return &model.SourceProfilingResult{ProfileJSON: string(b)}, nil
+}
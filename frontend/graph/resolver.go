@@ -2,8 +2,10 @@ package graph
 
 import (
 	"github.com/go-logr/logr"
+	"github.com/odigos-io/odigos/frontend/services/anomalies"
 	collectormetrics "github.com/odigos-io/odigos/frontend/services/collector_metrics"
 	fecommon "github.com/odigos-io/odigos/frontend/services/common"
+	"github.com/odigos-io/odigos/frontend/services/profiles"
 	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -22,4 +24,8 @@ type Resolver struct {
 	K8sCacheClient client.Client
 	// ProfileStore is the in-memory profile buffer for GraphQL.
 	ProfileStore fecommon.ProfileStoreRef
+	// ProfilePersistence is the on-disk profile storage; nil unless profiling.ui.persistence is enabled.
+	ProfilePersistence *profiles.PersistentProfileStore
+	// AnomalyStore holds the anomalies detected on span metrics in the own metrics store.
+	AnomalyStore *anomalies.Store
 }
@@ -0,0 +1,17 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.70
+
+import (
+	"context"
+
+	"github.com/odigos-io/odigos/frontend/graph/model"
+	"github.com/odigos-io/odigos/frontend/services"
+)
+
+// ServiceLevelObjectives is the resolver for the serviceLevelObjectives field.
+func (r *queryResolver) ServiceLevelObjectives(ctx context.Context, filter *model.WorkloadFilter) ([]*model.ServiceLevelObjective, error) {
+	return services.GetServiceLevelObjectives(ctx, filter)
+}
@@ -7,35 +7,49 @@ package graph
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/odigos-io/odigos/frontend/graph/model"
 	"github.com/odigos-io/odigos/frontend/services"
 )
 
 // GetServiceMap is the resolver for the getServiceMap field.
-func (r *queryResolver) GetServiceMap(ctx context.Context) (*model.ServiceMap, error) {
+func (r *queryResolver) GetServiceMap(ctx context.Context, timeRange *model.ServiceMapTimeRange, healthThresholds *model.ServiceMapHealthThresholdsInput) (*model.ServiceMap, error) {
 	if r.MetricsConsumer == nil {
 		return nil, fmt.Errorf("metrics consumer not initialized")
 	}
 
+	from, to, err := services.ServiceMapTimeWindow(timeRange, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	thresholds := services.ServiceMapHealthThresholds(healthThresholds)
+
 	serviceMap := r.MetricsConsumer.GetServiceGraphEdges()
 	mapServices := make([]*model.ServiceMapFromSource, 0)
 
-	for compositeKey, toServices := range serviceMap {
-		to := make([]*model.ServiceMapToSource, 0)
+	for compositeKey, edges := range serviceMap {
+		toServices := make([]*model.ServiceMapToSource, 0)
 
-		for toCompositeKey, info := range toServices {
-			to = append(to, services.EdgeToModel(toCompositeKey, info, services.ServiceGraphNodeAttributesForServer(info.Attributes)))
+		for toCompositeKey, info := range edges {
+			toSource := services.EdgeToModel(toCompositeKey, info, services.ServiceGraphNodeAttributesForServer(info.Attributes))
+			toSource.Metrics = services.EdgeMetricsToModel(info, from, to, thresholds)
+			toServices = append(toServices, toSource)
 		}
 
 		mapServices = append(mapServices, &model.ServiceMapFromSource{
 			NodeID:      compositeKey,
 			ServiceName: services.BaseServiceName(compositeKey),
-			Services:    to,
+			Services:    toServices,
 		})
 	}
 
-	return &model.ServiceMap{Services: mapServices}, nil
+	return &model.ServiceMap{
+		Services:         mapServices,
+		From:             from.Format(time.RFC3339),
+		To:               to.Format(time.RFC3339),
+		HealthThresholds: thresholds,
+	}, nil
 }
 
 // PeerSources is the resolver for the peerSources field.
@@ -47,3 +61,23 @@ func (r *queryResolver) PeerSources(ctx context.Context, serviceName string) (*m
 	allEdges := r.MetricsConsumer.GetServiceGraphEdges()
 	return services.PeerSources(allEdges, serviceName), nil
 }
+
+// ServiceMapDiff is the resolver for the serviceMapDiff field.
+func (r *queryResolver) ServiceMapDiff(ctx context.Context, baseline model.ServiceMapTimeRange, current *model.ServiceMapTimeRange) (*model.ServiceMapDiff, error) {
+	if r.MetricsConsumer == nil {
+		return nil, fmt.Errorf("metrics consumer not initialized")
+	}
+
+	now := time.Now()
+	baselineFrom, baselineTo, err := services.ServiceMapTimeWindow(&baseline, now)
+	if err != nil {
+		return nil, err
+	}
+	currentFrom, currentTo, err := services.ServiceMapTimeWindow(current, now)
+	if err != nil {
+		return nil, err
+	}
+
+	allEdges := r.MetricsConsumer.GetServiceGraphEdges()
+	return services.ServiceMapDiff(allEdges, baselineFrom, baselineTo, currentFrom, currentTo), nil
+}
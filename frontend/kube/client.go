@@ -20,6 +20,7 @@ import (
 	"k8s.io/client-go/metadata"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
 )
 
@@ -52,6 +53,7 @@ type Client struct {
 	MetadataClient metadata.Interface
 	DynamicClient  dynamic.Interface
 	RESTMapper     meta.RESTMapper
+	Config         *rest.Config
 }
 
 func CreateClient(kubeConfig string, kContext string) (*Client, error) {
@@ -107,6 +109,7 @@ func CreateClient(kubeConfig string, kContext string) (*Client, error) {
 		MetadataClient: metadataClient,
 		DynamicClient:  dynamicClient,
 		RESTMapper:     mapper,
+		Config:         config,
 	}, nil
 }
 
@@ -3,6 +3,8 @@ package server
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sync"
 
 	"github.com/go-logr/logr"
@@ -21,7 +23,9 @@ import (
 	"github.com/odigos-io/odigos/frontend/kube"
 	"github.com/odigos-io/odigos/frontend/kube/watchers"
 	"github.com/odigos-io/odigos/frontend/services"
+	"github.com/odigos-io/odigos/frontend/services/anomalies"
 	collectormetrics "github.com/odigos-io/odigos/frontend/services/collector_metrics"
+	"github.com/odigos-io/odigos/frontend/services/db"
 	"github.com/odigos-io/odigos/frontend/services/metrics"
 	"github.com/odigos-io/odigos/frontend/services/otlp"
 	"github.com/odigos-io/odigos/frontend/services/profiles"
@@ -45,7 +49,11 @@ type Deps struct {
 	ProfileStore     *profiles.ProfileStore
 	ProfilingGate    *profiles.IngestGate
 	ProfilesConsumer *profiles.OdigosProfilesConsumer
-	OtlpReceiver     *otlp.Receiver
+	// ProfilePersistence is nil unless profiling.ui.persistence is enabled.
+	ProfilePersistence *profiles.PersistentProfileStore
+	OtlpReceiver       *otlp.Receiver
+	// AnomalyStore is filled by the anomaly detector started in StartBackground.
+	AnomalyStore *anomalies.Store
 }
 
 // Bootstrap performs the synchronous startup work: load embedded destination
@@ -103,7 +111,18 @@ func Bootstrap(ctx context.Context, flags Flags, logger logr.Logger) (*Deps, err
 	})
 	go profileStore.RunCleanup(ctx)
 
-	profilesConsumer, err := profiles.NewOdigosProfilesConsumer(profileStore, profilingGate)
+	// On-disk profile storage is read once at startup; changing it requires a UI restart
+	// since it is backed by a volume of the UI pod.
+	var profilePersistence *profiles.PersistentProfileStore
+	if profCfg.Persistence != nil {
+		profilePersistence, err = openProfilePersistence(*profCfg.Persistence)
+		if err != nil {
+			log.Error("profiling: could not open the profile storage; profiles are kept in memory only", "err", err)
+			profilePersistence = nil
+		}
+	}
+
+	profilesConsumer, err := profiles.NewOdigosProfilesConsumer(profileStore, profilingGate, profilePersistence)
 	if err != nil {
 		log.Warn("profiles consumer init failed", "err", err)
 		profilesConsumer = nil
@@ -145,13 +164,15 @@ func Bootstrap(ctx context.Context, flags Flags, logger logr.Logger) (*Deps, err
 		ProfileStore:                profileStore,
 		ProfilingGate:               profilingGate,
 		ProfilesConsumer:            profilesConsumer,
+		ProfilePersistence:          profilePersistence,
 		OtlpReceiver:                otlpReceiver,
+		AnomalyStore:                anomalies.NewStore(),
 	}, nil
 }
 
 // StartBackground launches the long-running goroutines: the metrics-consumer
-// delete watcher, the OTLP receiver lifecycle, and the source/destination/
-// profiling-config watchers. Returns a WaitGroup the caller must Wait on at
+// delete watcher, the OTLP receiver lifecycle, the anomaly detector, and the
+// source/destination/profiling-config watchers. Returns a WaitGroup the caller must Wait on at
 // shutdown (after cancelling the context).
 func StartBackground(ctx context.Context, deps *Deps) (*sync.WaitGroup, error) {
 	var wg sync.WaitGroup
@@ -185,6 +206,33 @@ func StartBackground(ctx context.Context, deps *Deps) (*sync.WaitGroup, error) {
 		}
 	}
 
+	if deps.ProfilePersistence != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			deps.ProfilePersistence.Run(ctx)
+		}()
+	}
+
+	// Anomaly detection reads the effective config on every evaluation, so it always runs
+	// and stays idle while the feature is disabled.
+	var anomalyForwarder anomalies.Forwarder
+	logForwarder, err := anomalies.NewLogForwarder(deps.Flags.Namespace)
+	if err != nil {
+		commonlogger.LoggerCompat().With("subsystem", "bootstrap").Warn("anomalies log forwarder init failed", "err", err)
+	} else {
+		anomalyForwarder = logForwarder
+	}
+	anomalyDetector := anomalies.NewDetector(deps.PromAPI, deps.K8sCacheClient, deps.AnomalyStore, anomalyForwarder)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		anomalyDetector.Run(ctx)
+		if logForwarder != nil {
+			_ = logForwarder.Close()
+		}
+	}()
+
 	// In-cluster watchers (Source/Destination/Profiling).
 	var ingestGate *profiles.IngestGate
 	var store *profiles.ProfileStore
@@ -199,6 +247,20 @@ func StartBackground(ctx context.Context, deps *Deps) (*sync.WaitGroup, error) {
 	return &wg, nil
 }
 
+// openProfilePersistence opens (or creates) the SQLite database of the on-disk profile storage.
+func openProfilePersistence(cfg profiles.PersistenceConfig) (*profiles.PersistentProfileStore, error) {
+	dbPath := env.GetEnvVarOrDefault(profiles.PersistenceDBPathEnv, profiles.DefaultPersistenceDBPath)
+	if err := os.MkdirAll(filepath.Dir(dbPath), 0o755); err != nil {
+		return nil, fmt.Errorf("creating profile storage directory: %w", err)
+	}
+	database, err := db.NewSQLiteDB(dbPath)
+	if err != nil {
+		return nil, err
+	}
+	db.InitializeDatabaseSchema(database.GetDB())
+	return profiles.NewPersistentProfileStore(database, cfg), nil
+}
+
 // initKubernetesClient creates and installs the default kube client + workload
 // kind availability map. Kept package-private — out-of-tree mains call
 // Bootstrap (which calls this).
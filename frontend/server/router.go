@@ -81,6 +81,8 @@ func BuildRouter(ctx context.Context, deps *Deps, opts RouterOpts) (*gin.Engine,
 			CorrelationsMetricsStoreURL: deps.CorrelationsMetricsStoreURL,
 			K8sCacheClient:              deps.K8sCacheClient,
 			ProfileStore:                deps.ProfileStore,
+			ProfilePersistence:          deps.ProfilePersistence,
+			AnomalyStore:                deps.AnomalyStore,
 		},
 	})
 	gqlExecutor := executor.New(gqlSchema)
@@ -103,6 +105,12 @@ func BuildRouter(ctx context.Context, deps *Deps, opts RouterOpts) (*gin.Engine,
 	r.POST("/token/update", services.UpdateToken)
 	r.GET("/describe/odigos", services.DescribeOdigos)
 	r.GET("/describe/source/namespace/:namespace/kind/:kind/name/:name", services.DescribeSource)
+	r.GET("/describe/servicemap/diff", func(c *gin.Context) {
+		services.DescribeServiceMapDiff(c, deps.OdigosMetrics)
+	})
+	r.GET("/servicemap/export", func(c *gin.Context) {
+		services.ExportServiceMapHandler(c, deps.OdigosMetrics)
+	})
 	r.GET("/workload", func(c *gin.Context) {
 		services.DescribeWorkload(c, deps.Logger, gqlExecutor, nil, deps.K8sCacheClient)
 	})
@@ -19,7 +19,7 @@ import (
 )
 
 func deriveTypeFromAction(action *model.Action, crd *v1alpha1.Action) model.ActionType {
-	// DbQueryTemplatization and InferDbAttributes share ActionFields.scopes, and
+	// DbQueryTemplatization, InferDbAttributes and BaggageAttributes share ActionFields.scopes, and
 	// InferDbAttributes may have empty fields when scoped to all sources — derive
 	// these from the CRD config rather than field presence.
 	if crd.Spec.DbQueryTemplatization != nil {
@@ -28,6 +28,9 @@ func deriveTypeFromAction(action *model.Action, crd *v1alpha1.Action) model.Acti
 	if crd.Spec.InferDbAttributes != nil {
 		return model.ActionTypeInferDbAttributes
 	}
+	if crd.Spec.BaggageAttributes != nil {
+		return model.ActionTypeBaggageAttributes
+	}
 	if action.Fields.CollectContainerAttributes != nil || action.Fields.CollectReplicaSetAttributes != nil || action.Fields.CollectWorkloadID != nil || action.Fields.CollectClusterID != nil || action.Fields.LabelsAttributes != nil || action.Fields.AnnotationsAttributes != nil {
 		return model.ActionTypeK8sAttributesResolver
 	}
@@ -203,6 +206,7 @@ func getSpecFromInput(input model.ActionInput, existingAction *v1alpha1.Action)
 	spec.ExtractAttribute = convertExtractAttributeFromInput(input.Fields, existingAction)
 	spec.DbQueryTemplatization = convertDbQueryTemplatizationFromInput(input.Type, input.Fields, existingAction)
 	spec.InferDbAttributes = convertInferDbAttributesFromInput(input.Type, input.Fields, existingAction)
+	spec.BaggageAttributes = convertBaggageAttributesFromInput(input.Type, input.Fields, existingAction)
 
 	return &spec, nil
 }
@@ -508,6 +512,12 @@ func convertActionToModel(action *v1alpha1.Action) (*model.Action, error) {
 		responseFields.AttributeNamesToDelete = action.Spec.DeleteAttribute.AttributeNamesToDelete
 	}
 
+	// Handle BaggageAttributes fields
+	if action.Spec.BaggageAttributes != nil {
+		responseFields.Scopes = SourcesScopesCRDToModel(action.Spec.BaggageAttributes.Scopes)
+		responseFields.BaggageKeys = action.Spec.BaggageAttributes.BaggageKeys
+	}
+
 	signals := []model.SignalType{}
 	seen := make(map[model.SignalType]bool)
 	for _, s := range action.Spec.Signals {
@@ -868,6 +878,22 @@ func convertInferDbAttributesFromInput(actionType model.ActionType, details *mod
 	}
 }
 
+func convertBaggageAttributesFromInput(actionType model.ActionType, details *model.ActionFieldsInput, existingAction *v1alpha1.Action) *apiactions.BaggageAttributesConfig {
+	if actionType != model.ActionTypeBaggageAttributes {
+		return nil
+	}
+
+	config := &apiactions.BaggageAttributesConfig{
+		Scopes: SourcesScopesInputToCRD(details.Scopes),
+	}
+	if details.BaggageKeys != nil {
+		config.BaggageKeys = details.BaggageKeys
+	} else if existingAction != nil && existingAction.Spec.BaggageAttributes != nil {
+		config.BaggageKeys = existingAction.Spec.BaggageAttributes.BaggageKeys
+	}
+	return config
+}
+
 func convertDbActionFieldsToModel(action *v1alpha1.Action) (*model.SourcesScopes, *bool, *bool) {
 	if action.Spec.DbQueryTemplatization != nil {
 		templatizeLiterals := action.Spec.DbQueryTemplatization.TemplatizeLiterals
@@ -0,0 +1,60 @@
+package anomalies
+
+import (
+	"time"
+
+	"github.com/odigos-io/odigos/common"
+)
+
+const (
+	defaultBaselineWindow     = time.Hour
+	defaultEvaluationWindow   = 5 * time.Minute
+	defaultEvaluationInterval = time.Minute
+	defaultDeviationThreshold = 3
+)
+
+// Config holds the resolved anomaly detection settings, with defaults applied.
+type Config struct {
+	BaselineWindow     time.Duration
+	EvaluationWindow   time.Duration
+	EvaluationInterval time.Duration
+	// DeviationThreshold is the number of baseline standard deviations a signal has to cross.
+	DeviationThreshold    float64
+	ForwardToDestinations bool
+}
+
+// ConfigFromOdigosConfig resolves the anomaly detection settings from the effective config.
+// The second return value is false when anomaly detection is not active.
+func ConfigFromOdigosConfig(cfg *common.OdigosConfiguration) (Config, bool) {
+	if cfg == nil || !common.AnomalyDetectionActive(cfg.OdigosOwnTelemetryStore) {
+		return Config{}, false
+	}
+	ad := cfg.OdigosOwnTelemetryStore.AnomalyDetection
+
+	out := Config{
+		BaselineWindow:        parseDurationOrDefault(ad.BaselineWindow, defaultBaselineWindow),
+		EvaluationWindow:      parseDurationOrDefault(ad.EvaluationWindow, defaultEvaluationWindow),
+		EvaluationInterval:    parseDurationOrDefault(ad.EvaluationInterval, defaultEvaluationInterval),
+		DeviationThreshold:    defaultDeviationThreshold,
+		ForwardToDestinations: ad.ForwardToDestinations != nil && *ad.ForwardToDestinations,
+	}
+	if ad.DeviationThreshold > 0 {
+		out.DeviationThreshold = float64(ad.DeviationThreshold)
+	}
+	// the baseline has to span several evaluation windows to say anything about the usual variance
+	if out.BaselineWindow <= out.EvaluationWindow {
+		out.BaselineWindow = 12 * out.EvaluationWindow
+	}
+	return out, true
+}
+
+func parseDurationOrDefault(value string, fallback time.Duration) time.Duration {
+	if value == "" {
+		return fallback
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return fallback
+	}
+	return d
+}
@@ -0,0 +1,57 @@
+package anomalies
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/odigos-io/odigos/common"
+)
+
+func boolPtr(b bool) *bool { return &b }
+
+func TestConfigFromOdigosConfig(t *testing.T) {
+	_, active := ConfigFromOdigosConfig(&common.OdigosConfiguration{})
+	require.False(t, active)
+
+	// the own metrics store is required
+	_, active = ConfigFromOdigosConfig(&common.OdigosConfiguration{
+		OdigosOwnTelemetryStore: &common.OdigosOwnTelemetryConfiguration{
+			MetricsStoreDisabled: boolPtr(true),
+			AnomalyDetection:     &common.AnomalyDetectionConfiguration{Enabled: boolPtr(true)},
+		},
+	})
+	require.False(t, active)
+
+	cfg, active := ConfigFromOdigosConfig(&common.OdigosConfiguration{
+		OdigosOwnTelemetryStore: &common.OdigosOwnTelemetryConfiguration{
+			AnomalyDetection: &common.AnomalyDetectionConfiguration{Enabled: boolPtr(true), EvaluationWindow: "not-a-duration"},
+		},
+	})
+	require.True(t, active)
+	require.Equal(t, Config{
+		BaselineWindow:     time.Hour,
+		EvaluationWindow:   5 * time.Minute,
+		EvaluationInterval: time.Minute,
+		DeviationThreshold: 3,
+	}, cfg)
+}
+
+func TestConfigFromOdigosConfig_BaselineLongerThanEvaluationWindow(t *testing.T) {
+	cfg, active := ConfigFromOdigosConfig(&common.OdigosConfiguration{
+		OdigosOwnTelemetryStore: &common.OdigosOwnTelemetryConfiguration{
+			AnomalyDetection: &common.AnomalyDetectionConfiguration{
+				Enabled:               boolPtr(true),
+				BaselineWindow:        "10m",
+				EvaluationWindow:      "10m",
+				DeviationThreshold:    4,
+				ForwardToDestinations: boolPtr(true),
+			},
+		},
+	})
+	require.True(t, active)
+	require.Equal(t, 2*time.Hour, cfg.BaselineWindow)
+	require.Equal(t, 4.0, cfg.DeviationThreshold)
+	require.True(t, cfg.ForwardToDestinations)
+}
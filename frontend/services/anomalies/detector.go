@@ -0,0 +1,165 @@
+package anomalies
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	commonlogger "github.com/odigos-io/odigos/common/logger"
+	"github.com/odigos-io/odigos/frontend/services"
+)
+
+const (
+	// endpoints with fewer calls per second in their baseline are too quiet for a meaningful baseline.
+	minBaselineThroughput = 0.05
+
+	// a flat baseline has (almost) no variance, so the deviation is measured against at least
+	// this fraction of the baseline mean, or the absolute floor of the signal.
+	relativeStddevFloor = 0.1
+)
+
+var absoluteStddevFloor = map[Signal]float64{
+	SignalLatency:    5,    // ms
+	SignalErrorRate:  0.02, // 2 percentage points
+	SignalThroughput: minBaselineThroughput,
+}
+
+// Forwarder sends the opened and resolved anomalies to the user destinations.
+type Forwarder interface {
+	Forward(ctx context.Context, opened, resolved []Anomaly) error
+}
+
+// Detector periodically evaluates the span metrics in the own metrics store and records the anomalies in the store.
+type Detector struct {
+	api       v1.API
+	client    client.Client
+	store     *Store
+	forwarder Forwarder
+}
+
+// NewDetector returns a detector reading span metrics from api. forwarder may be nil.
+func NewDetector(api v1.API, c client.Client, store *Store, forwarder Forwarder) *Detector {
+	return &Detector{
+		api:       api,
+		client:    c,
+		store:     store,
+		forwarder: forwarder,
+	}
+}
+
+// Run evaluates the signals every evaluation interval until ctx is done.
+// The effective config is read on every evaluation, so changes apply without a restart.
+func (d *Detector) Run(ctx context.Context) {
+	log := commonlogger.LoggerCompat().With("subsystem", "anomaly-detection")
+	timer := time.NewTimer(defaultEvaluationInterval)
+	defer timer.Stop()
+	for {
+		var now time.Time
+		select {
+		case <-ctx.Done():
+			return
+		case now = <-timer.C:
+		}
+
+		interval := defaultEvaluationInterval
+		odigosConfig, err := services.GetEffectiveConfig(ctx, d.client)
+		if err != nil {
+			log.Warn("anomaly_detection", "err", err)
+		} else if cfg, active := ConfigFromOdigosConfig(odigosConfig); !active {
+			d.store.Clear()
+		} else {
+			interval = cfg.EvaluationInterval
+			if err := d.evaluate(ctx, cfg, now); err != nil {
+				log.Warn("anomaly_detection", "err", err)
+			}
+		}
+		timer.Reset(interval)
+	}
+}
+
+// evaluate queries all the signals and applies the flagged anomalies to the store.
+// A signal which cannot be queried is skipped, so its open anomalies are not resolved by mistake.
+func (d *Detector) evaluate(ctx context.Context, cfg Config, now time.Time) error {
+	if d.api == nil {
+		return errors.New("metrics store is unavailable")
+	}
+
+	// the throughput baseline decides which endpoints have enough traffic to evaluate at all
+	throughput, err := querySignal(ctx, d.api, SignalThroughput, cfg, now)
+	if err != nil {
+		return err
+	}
+	eligible := func(endpoint Endpoint) bool {
+		return throughput.mean[endpoint] >= minBaselineThroughput
+	}
+
+	var errs error
+	for _, signal := range []Signal{SignalThroughput, SignalLatency, SignalErrorRate} {
+		snapshot := throughput
+		if signal != SignalThroughput {
+			snapshot, err = querySignal(ctx, d.api, signal, cfg, now)
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+		}
+
+		opened, resolved := d.store.Apply(now, signal, detect(signal, snapshot, cfg.DeviationThreshold, eligible))
+		if cfg.ForwardToDestinations && d.forwarder != nil && len(opened)+len(resolved) > 0 {
+			if err := d.forwarder.Forward(ctx, opened, resolved); err != nil {
+				errs = errors.Join(errs, err)
+			}
+		}
+	}
+	return errs
+}
+
+// detect returns the endpoints whose current value of the signal deviates from the baseline by at least threshold.
+// Latency and error rate are only flagged when they increase; throughput is flagged in both directions.
+func detect(signal Signal, snapshot signalSnapshot, threshold float64, eligible func(Endpoint) bool) []Anomaly {
+	var out []Anomaly
+	for endpoint, mean := range snapshot.mean {
+		if !eligible(endpoint) {
+			continue
+		}
+		current, ok := snapshot.current[endpoint]
+		if !ok {
+			if signal != SignalThroughput {
+				continue
+			}
+			// the calls series of an endpoint which stopped receiving traffic expires
+			current = 0
+		}
+		if math.IsNaN(current) || math.IsNaN(mean) {
+			continue
+		}
+
+		stddev := snapshot.stddev[endpoint]
+		deviation := (current - mean) / math.Max(stddev, math.Max(relativeStddevFloor*math.Abs(mean), absoluteStddevFloor[signal]))
+		direction := DirectionIncrease
+		if deviation < 0 {
+			if signal != SignalThroughput {
+				continue
+			}
+			direction = DirectionDecrease
+		}
+		if math.Abs(deviation) < threshold {
+			continue
+		}
+
+		out = append(out, Anomaly{
+			Endpoint:       endpoint,
+			Signal:         signal,
+			Direction:      direction,
+			Value:          current,
+			BaselineMean:   mean,
+			BaselineStddev: stddev,
+			Deviation:      deviation,
+		})
+	}
+	return out
+}
@@ -0,0 +1,82 @@
+package anomalies
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	checkout = Endpoint{Namespace: "shop", Kind: "Deployment", Name: "checkout", Container: "app", SpanName: "POST /checkout", HttpRoute: "/checkout"}
+	catalog  = Endpoint{Namespace: "shop", Kind: "Deployment", Name: "catalog", Container: "app", SpanName: "GET /items"}
+)
+
+func allEligible(Endpoint) bool { return true }
+
+func TestDetect_LatencyIncrease(t *testing.T) {
+	snapshot := signalSnapshot{
+		current: signalValues{checkout: 900, catalog: 130},
+		mean:    signalValues{checkout: 200, catalog: 120},
+		stddev:  signalValues{checkout: 30, catalog: 20},
+	}
+
+	got := detect(SignalLatency, snapshot, 3, allEligible)
+
+	require.Len(t, got, 1)
+	require.Equal(t, checkout, got[0].Endpoint)
+	require.Equal(t, DirectionIncrease, got[0].Direction)
+	require.InDelta(t, 700.0/30, got[0].Deviation, 0.001) // (900-200)/max(30, 0.1*200, 5)
+}
+
+func TestDetect_LatencyDecreaseIsNotAnAnomaly(t *testing.T) {
+	snapshot := signalSnapshot{
+		current: signalValues{checkout: 10},
+		mean:    signalValues{checkout: 200},
+		stddev:  signalValues{checkout: 5},
+	}
+	require.Empty(t, detect(SignalLatency, snapshot, 3, allEligible))
+}
+
+func TestDetect_FlatBaselineUsesStddevFloor(t *testing.T) {
+	// no errors at all in the baseline: 1% errors now is below the 2 points floor, 10% is not
+	snapshot := signalSnapshot{
+		current: signalValues{checkout: 0.01, catalog: 0.1},
+		mean:    signalValues{checkout: 0, catalog: 0},
+		stddev:  signalValues{checkout: 0, catalog: 0},
+	}
+
+	got := detect(SignalErrorRate, snapshot, 3, allEligible)
+
+	require.Len(t, got, 1)
+	require.Equal(t, catalog, got[0].Endpoint)
+	require.InDelta(t, 5.0, got[0].Deviation, 0.001)
+}
+
+func TestDetect_ThroughputDropIncludesExpiredSeries(t *testing.T) {
+	snapshot := signalSnapshot{
+		current: signalValues{},
+		mean:    signalValues{checkout: 10},
+		stddev:  signalValues{checkout: 1},
+	}
+
+	got := detect(SignalThroughput, snapshot, 3, allEligible)
+
+	require.Len(t, got, 1)
+	require.Equal(t, DirectionDecrease, got[0].Direction)
+	require.Equal(t, 0.0, got[0].Value)
+	require.InDelta(t, -10.0, got[0].Deviation, 0.001)
+}
+
+func TestDetect_SkipsIneligibleEndpoints(t *testing.T) {
+	snapshot := signalSnapshot{
+		current: signalValues{checkout: 900, catalog: 900},
+		mean:    signalValues{checkout: 200, catalog: 200},
+		stddev:  signalValues{checkout: 30, catalog: 30},
+	}
+	onlyCatalog := func(e Endpoint) bool { return e == catalog }
+
+	got := detect(SignalLatency, snapshot, 3, onlyCatalog)
+
+	require.Len(t, got, 1)
+	require.Equal(t, catalog, got[0].Endpoint)
+}
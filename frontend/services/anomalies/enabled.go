@@ -0,0 +1,22 @@
+package anomalies
+
+import (
+	"context"
+	"errors"
+
+	"github.com/odigos-io/odigos/frontend/services"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ErrNotEnabled is returned when anomaly detection is disabled in the effective config.
+var ErrNotEnabled = errors.New("anomaly detection is not enabled")
+
+// IsEnabled reports whether anomaly detection is enabled in effective-config.
+func IsEnabled(ctx context.Context, c client.Client) (bool, error) {
+	cfg, err := services.GetEffectiveConfig(ctx, c)
+	if err != nil {
+		return false, err
+	}
+	_, active := ConfigFromOdigosConfig(cfg)
+	return active, nil
+}
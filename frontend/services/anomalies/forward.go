@@ -0,0 +1,143 @@
+package anomalies
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/plog"
+	"go.opentelemetry.io/collector/pdata/plog/plogotlp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	"github.com/odigos-io/odigos/common/consts"
+	"github.com/odigos-io/odigos/k8sutils/pkg/metricsstore"
+)
+
+const (
+	logsScopeName = "odigos-anomaly-detection"
+
+	anomalyIdAttribute             = "odigos.anomaly.id"
+	anomalySignalAttribute         = "odigos.anomaly.signal"
+	anomalyDirectionAttribute      = "odigos.anomaly.direction"
+	anomalyStateAttribute          = "odigos.anomaly.state"
+	anomalyValueAttribute          = "odigos.anomaly.value"
+	anomalyBaselineMeanAttribute   = "odigos.anomaly.baseline.mean"
+	anomalyBaselineStddevAttribute = "odigos.anomaly.baseline.stddev"
+	anomalyDeviationAttribute      = "odigos.anomaly.deviation"
+)
+
+// LogForwarder sends anomalies as OTLP log records to the cluster gateway.
+// The records carry the resource attributes of the affected source, so the gateway routes them
+// to the logs destinations of that source like any other log record it emits.
+type LogForwarder struct {
+	conn   *grpc.ClientConn
+	client plogotlp.GRPCClient
+}
+
+func NewLogForwarder(odigosNamespace string) (*LogForwarder, error) {
+	endpoint := k8sconsts.OtlpGrpcDNSEndpoint(k8sconsts.OdigosClusterCollectorServiceName, odigosNamespace, consts.OTLPPort)
+	conn, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("creating anomalies logs client: %w", err)
+	}
+	return &LogForwarder{conn: conn, client: plogotlp.NewGRPCClient(conn)}, nil
+}
+
+func (f *LogForwarder) Forward(ctx context.Context, opened, resolved []Anomaly) error {
+	logs := anomaliesToLogs(opened, resolved, time.Now())
+	if _, err := f.client.Export(ctx, plogotlp.NewExportRequestFromLogs(logs)); err != nil {
+		return fmt.Errorf("forwarding anomalies: %w", err)
+	}
+	return nil
+}
+
+func (f *LogForwarder) Close() error {
+	return f.conn.Close()
+}
+
+func anomaliesToLogs(opened, resolved []Anomaly, now time.Time) plog.Logs {
+	logs := plog.NewLogs()
+	for _, a := range opened {
+		appendAnomalyLog(logs, a, "open", now)
+	}
+	for _, a := range resolved {
+		appendAnomalyLog(logs, a, "resolved", now)
+	}
+	return logs
+}
+
+func appendAnomalyLog(logs plog.Logs, a Anomaly, state string, now time.Time) {
+	rl := logs.ResourceLogs().AppendEmpty()
+	res := rl.Resource().Attributes()
+	res.PutStr("k8s.namespace.name", a.Endpoint.Namespace)
+	res.PutStr(consts.OdigosWorkloadKindAttribute, a.Endpoint.Kind)
+	res.PutStr(consts.OdigosWorkloadNameAttribute, a.Endpoint.Name)
+	for _, candidate := range metricsstore.K8sWorkloadNameLabels {
+		if strings.EqualFold(string(candidate.Kind), a.Endpoint.Kind) {
+			res.PutStr(candidate.Label, a.Endpoint.Name)
+			break
+		}
+	}
+	if a.Endpoint.Container != "" {
+		res.PutStr("k8s.container.name", a.Endpoint.Container)
+	}
+
+	sl := rl.ScopeLogs().AppendEmpty()
+	sl.Scope().SetName(logsScopeName)
+	record := sl.LogRecords().AppendEmpty()
+	record.SetTimestamp(pcommon.NewTimestampFromTime(now))
+	record.SetObservedTimestamp(pcommon.NewTimestampFromTime(now))
+	if state == "open" {
+		record.SetSeverityNumber(plog.SeverityNumberWarn)
+		record.SetSeverityText("WARN")
+	} else {
+		record.SetSeverityNumber(plog.SeverityNumberInfo)
+		record.SetSeverityText("INFO")
+	}
+	record.Body().SetStr(anomalySummary(a, state))
+
+	attrs := record.Attributes()
+	attrs.PutStr(anomalyIdAttribute, a.ID)
+	attrs.PutStr(anomalySignalAttribute, string(a.Signal))
+	attrs.PutStr(anomalyDirectionAttribute, string(a.Direction))
+	attrs.PutStr(anomalyStateAttribute, state)
+	attrs.PutDouble(anomalyValueAttribute, a.Value)
+	attrs.PutDouble(anomalyBaselineMeanAttribute, a.BaselineMean)
+	attrs.PutDouble(anomalyBaselineStddevAttribute, a.BaselineStddev)
+	attrs.PutDouble(anomalyDeviationAttribute, a.Deviation)
+	attrs.PutStr("span.name", a.Endpoint.SpanName)
+	if a.Endpoint.HttpRoute != "" {
+		attrs.PutStr("http.route", a.Endpoint.HttpRoute)
+	}
+}
+
+// anomalySummary returns a human readable description of the anomaly, e.g.
+// "p95 latency of GET /users on Deployment default/frontend increased to 820ms (baseline 120ms)".
+func anomalySummary(a Anomaly, state string) string {
+	var signal, value, baseline string
+	switch a.Signal {
+	case SignalLatency:
+		signal = "p95 latency"
+		value, baseline = fmt.Sprintf("%.0fms", a.Value), fmt.Sprintf("%.0fms", a.BaselineMean)
+	case SignalErrorRate:
+		signal = "error rate"
+		value, baseline = fmt.Sprintf("%.1f%%", a.Value*100), fmt.Sprintf("%.1f%%", a.BaselineMean*100)
+	default:
+		signal = "throughput"
+		value, baseline = fmt.Sprintf("%.2f/s", a.Value), fmt.Sprintf("%.2f/s", a.BaselineMean)
+	}
+
+	verb := "increased to"
+	if a.Direction == DirectionDecrease {
+		verb = "decreased to"
+	}
+	if state == "resolved" {
+		verb = "is back from"
+	}
+	return fmt.Sprintf("%s of %s on %s %s/%s %s %s (baseline %s)",
+		signal, a.Endpoint.SpanName, a.Endpoint.Kind, a.Endpoint.Namespace, a.Endpoint.Name, verb, value, baseline)
+}
@@ -0,0 +1,53 @@
+package anomalies
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/plog"
+
+	"github.com/odigos-io/odigos/common/consts"
+)
+
+func TestAnomaliesToLogs(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	a := Anomaly{
+		ID:           "id-1",
+		Endpoint:     checkout,
+		Signal:       SignalLatency,
+		Direction:    DirectionIncrease,
+		Value:        820,
+		BaselineMean: 120,
+		Deviation:    14,
+	}
+
+	logs := anomaliesToLogs([]Anomaly{a}, []Anomaly{a}, now)
+
+	require.Equal(t, 2, logs.ResourceLogs().Len())
+	res := logs.ResourceLogs().At(0).Resource().Attributes()
+	for key, want := range map[string]string{
+		"k8s.namespace.name":               "shop",
+		"k8s.deployment.name":              "checkout",
+		"k8s.container.name":               "app",
+		consts.OdigosWorkloadKindAttribute: "Deployment",
+		consts.OdigosWorkloadNameAttribute: "checkout",
+	} {
+		v, ok := res.Get(key)
+		require.True(t, ok, key)
+		require.Equal(t, want, v.Str(), key)
+	}
+
+	openRecord := logs.ResourceLogs().At(0).ScopeLogs().At(0).LogRecords().At(0)
+	require.Equal(t, plog.SeverityNumberWarn, openRecord.SeverityNumber())
+	require.Equal(t, "p95 latency of POST /checkout on Deployment shop/checkout increased to 820ms (baseline 120ms)", openRecord.Body().Str())
+	state, _ := openRecord.Attributes().Get(anomalyStateAttribute)
+	require.Equal(t, "open", state.Str())
+	route, _ := openRecord.Attributes().Get("http.route")
+	require.Equal(t, "/checkout", route.Str())
+
+	resolvedRecord := logs.ResourceLogs().At(1).ScopeLogs().At(0).LogRecords().At(0)
+	require.Equal(t, plog.SeverityNumberInfo, resolvedRecord.SeverityNumber())
+	state, _ = resolvedRecord.Attributes().Get(anomalyStateAttribute)
+	require.Equal(t, "resolved", state.Str())
+}
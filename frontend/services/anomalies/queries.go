@@ -0,0 +1,137 @@
+package anomalies
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	prommodel "github.com/prometheus/common/model"
+
+	"github.com/odigos-io/odigos/common/consts"
+	"github.com/odigos-io/odigos/k8sutils/pkg/metricsstore"
+)
+
+// latencyQuantile is the quantile of the latency signal.
+// Only server spans are evaluated, since they represent the endpoints a source serves.
+const latencyQuantile = 0.95
+
+// groupingLabels identify one endpoint of a source. Only one of the workload name labels is set on each series.
+var groupingLabels = []string{
+	"k8s.namespace.name",
+	"k8s.container.name",
+	consts.OdigosWorkloadKindAttribute,
+	consts.OdigosWorkloadNameAttribute,
+	"k8s.deployment.name",
+	"k8s.statefulset.name",
+	"k8s.daemonset.name",
+	"k8s.cronjob.name",
+	"k8s.job.name",
+	"k8s.argoproj.rollout.name",
+	"span.name",
+	"http.route",
+}
+
+// signalValues holds one value per endpoint.
+type signalValues map[Endpoint]float64
+
+// signalQuery returns the PromQL expression computing the signal per endpoint over the given window.
+func signalQuery(signal Signal, window time.Duration) string {
+	by := strings.Join(groupingLabels, ", ")
+	w := metricsstore.PromDuration(window)
+	calls := fmt.Sprintf(`{__name__=~"%s", %s}`, metricsstore.SpanMetricsCallsName, metricsstore.ServerSpanKindMatcher)
+
+	switch signal {
+	case SignalLatency:
+		buckets := fmt.Sprintf(`{__name__=~"%s", %s}`, metricsstore.SpanMetricsDurationBucketName, metricsstore.ServerSpanKindMatcher)
+		return fmt.Sprintf(`histogram_quantile(%g, sum by (%s, le) (rate(%s[%s])))`, latencyQuantile, by, buckets, w)
+	case SignalErrorRate:
+		errorCalls := fmt.Sprintf(`{__name__=~"%s", %s, %s}`, metricsstore.SpanMetricsCallsName, metricsstore.ServerSpanKindMatcher, metricsstore.ErrorStatusMatcher)
+		total := fmt.Sprintf(`sum by (%s) (rate(%s[%s]))`, by, calls, w)
+		// endpoints without errors have no error series; fill them with 0 so they still have a baseline
+		return fmt.Sprintf(`(sum by (%s) (rate(%s[%s])) or %s * 0) / %s`, by, errorCalls, w, total, total)
+	default:
+		return fmt.Sprintf(`sum by (%s) (rate(%s[%s]))`, by, calls, w)
+	}
+}
+
+// baselineQueries return the expressions for the mean and standard deviation of the signal over the baseline window,
+// which ends where the evaluation window starts so an ongoing anomaly does not shift its own baseline.
+func baselineQueries(signal Signal, cfg Config) (string, string) {
+	expr := signalQuery(signal, cfg.EvaluationWindow)
+	subquery := fmt.Sprintf(`(%s)[%s:%s] offset %s`,
+		expr, metricsstore.PromDuration(cfg.BaselineWindow), metricsstore.PromDuration(cfg.EvaluationInterval), metricsstore.PromDuration(cfg.EvaluationWindow))
+	return fmt.Sprintf(`avg_over_time(%s)`, subquery), fmt.Sprintf(`stddev_over_time(%s)`, subquery)
+}
+
+// signalSnapshot is the current value and the baseline of a signal for all the endpoints.
+type signalSnapshot struct {
+	current signalValues
+	mean    signalValues
+	stddev  signalValues
+}
+
+func querySignal(ctx context.Context, api v1.API, signal Signal, cfg Config, now time.Time) (signalSnapshot, error) {
+	meanQuery, stddevQuery := baselineQueries(signal, cfg)
+	var out signalSnapshot
+	for _, q := range []struct {
+		query string
+		dst   *signalValues
+	}{
+		{signalQuery(signal, cfg.EvaluationWindow), &out.current},
+		{meanQuery, &out.mean},
+		{stddevQuery, &out.stddev},
+	} {
+		values, err := queryValues(ctx, api, q.query, now)
+		if err != nil {
+			return signalSnapshot{}, fmt.Errorf("query %s: %w", signal, err)
+		}
+		*q.dst = values
+	}
+	return out, nil
+}
+
+func queryValues(ctx context.Context, api v1.API, query string, ts time.Time) (signalValues, error) {
+	val, _, err := api.Query(ctx, query, ts)
+	if err != nil {
+		return nil, err
+	}
+	out := signalValues{}
+	vec, ok := val.(prommodel.Vector)
+	if !ok {
+		return out, nil
+	}
+	for _, sample := range vec {
+		key, ok := endpointFromMetric(sample.Metric)
+		if !ok {
+			continue
+		}
+		out[key] = float64(sample.Value)
+	}
+	return out, nil
+}
+
+func endpointFromMetric(labels prommodel.Metric) (Endpoint, bool) {
+	key := Endpoint{
+		Namespace: string(labels["k8s.namespace.name"]),
+		Container: string(labels["k8s.container.name"]),
+		Kind:      string(labels[consts.OdigosWorkloadKindAttribute]),
+		Name:      string(labels[consts.OdigosWorkloadNameAttribute]),
+		SpanName:  string(labels["span.name"]),
+		HttpRoute: string(labels["http.route"]),
+	}
+	if key.Kind == "" || key.Name == "" {
+		for _, candidate := range metricsstore.K8sWorkloadNameLabels {
+			if value := string(labels[prommodel.LabelName(candidate.Label)]); value != "" {
+				key.Kind = string(candidate.Kind)
+				key.Name = value
+				break
+			}
+		}
+	}
+	if key.Namespace == "" || key.Kind == "" || key.Name == "" || key.SpanName == "" {
+		return Endpoint{}, false
+	}
+	return key, true
+}
@@ -0,0 +1,178 @@
+package anomalies
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// resolved anomalies are kept for this long, so recent incidents can still be reviewed in the UI.
+	resolvedRetention = 24 * time.Hour
+	maxResolved       = 1000
+)
+
+type Signal string
+
+const (
+	// SignalLatency is the p95 latency of the endpoint in milliseconds.
+	SignalLatency Signal = "latency"
+	// SignalErrorRate is the ratio (0..1) of the endpoint calls which ended with an error status.
+	SignalErrorRate Signal = "errorRate"
+	// SignalThroughput is the endpoint calls per second.
+	SignalThroughput Signal = "throughput"
+)
+
+type Direction string
+
+const (
+	DirectionIncrease Direction = "increase"
+	DirectionDecrease Direction = "decrease"
+)
+
+// Endpoint identifies one endpoint (server span name and route) of a source container.
+type Endpoint struct {
+	Namespace string
+	Kind      string
+	Name      string
+	Container string
+	SpanName  string
+	HttpRoute string
+}
+
+// Anomaly is a signal of a source endpoint which deviates from its baseline.
+// It is open while consecutive evaluations keep flagging it, and resolved on the first one which does not.
+type Anomaly struct {
+	ID        string
+	Endpoint  Endpoint
+	Signal    Signal
+	Direction Direction
+	// Value is the latest value of the signal, BaselineMean and BaselineStddev describe the baseline it was compared to.
+	Value          float64
+	BaselineMean   float64
+	BaselineStddev float64
+	// Deviation is the distance of Value from BaselineMean in baseline standard deviations.
+	Deviation  float64
+	StartedAt  time.Time
+	LastSeenAt time.Time
+	ResolvedAt *time.Time
+}
+
+// Store keeps the open anomalies and the recently resolved ones in memory.
+type Store struct {
+	mu       sync.Mutex
+	open     map[anomalyKey]*Anomaly
+	resolved []Anomaly
+}
+
+type anomalyKey struct {
+	endpoint Endpoint
+	signal   Signal
+}
+
+func NewStore() *Store {
+	return &Store{open: make(map[anomalyKey]*Anomaly)}
+}
+
+// Apply records the anomalies flagged for one signal in an evaluation.
+// Open anomalies of the signal which were not flagged again are resolved.
+// It returns the anomalies opened and resolved by this evaluation.
+func (s *Store) Apply(now time.Time, signal Signal, flagged []Anomaly) (opened, resolved []Anomaly) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seen := make(map[anomalyKey]struct{}, len(flagged))
+	for _, a := range flagged {
+		key := anomalyKey{endpoint: a.Endpoint, signal: signal}
+		seen[key] = struct{}{}
+		if current, ok := s.open[key]; ok {
+			current.Value = a.Value
+			current.BaselineMean = a.BaselineMean
+			current.BaselineStddev = a.BaselineStddev
+			current.Deviation = a.Deviation
+			current.Direction = a.Direction
+			current.LastSeenAt = now
+			continue
+		}
+		a.Signal = signal
+		a.StartedAt = now
+		a.LastSeenAt = now
+		a.ID = anomalyID(key, now)
+		s.open[key] = &a
+		opened = append(opened, a)
+	}
+
+	for key, a := range s.open {
+		if key.signal != signal {
+			continue
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		resolvedAt := now
+		a.ResolvedAt = &resolvedAt
+		delete(s.open, key)
+		s.resolved = append(s.resolved, *a)
+		resolved = append(resolved, *a)
+	}
+
+	s.trimResolved(now)
+	return opened, resolved
+}
+
+// Clear drops all the anomalies, e.g. when anomaly detection is turned off.
+func (s *Store) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.open = make(map[anomalyKey]*Anomaly)
+	s.resolved = nil
+}
+
+// List returns the open anomalies, and the resolved ones if includeResolved is set, most recent first.
+func (s *Store) List(includeResolved bool) []Anomaly {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	out := make([]Anomaly, 0, len(s.open))
+	for _, a := range s.open {
+		out = append(out, *a)
+	}
+	if includeResolved {
+		out = append(out, s.resolved...)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if !out[i].StartedAt.Equal(out[j].StartedAt) {
+			return out[i].StartedAt.After(out[j].StartedAt)
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+// trimResolved drops resolved anomalies past retention, and the oldest ones above maxResolved.
+// resolved is ordered by resolution time, so the expired ones are a prefix.
+func (s *Store) trimResolved(now time.Time) {
+	cutoff := now.Add(-resolvedRetention)
+	drop := 0
+	for drop < len(s.resolved) && s.resolved[drop].ResolvedAt.Before(cutoff) {
+		drop++
+	}
+	if excess := len(s.resolved) - drop - maxResolved; excess > 0 {
+		drop += excess
+	}
+	if drop > 0 {
+		s.resolved = append([]Anomaly(nil), s.resolved[drop:]...)
+	}
+}
+
+func anomalyID(key anomalyKey, startedAt time.Time) string {
+	e := key.endpoint
+	h := fnv.New64a()
+	for _, part := range []string{e.Namespace, e.Kind, e.Name, e.Container, e.SpanName, e.HttpRoute, string(key.signal)} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return fmt.Sprintf("%x-%d", h.Sum64(), startedAt.Unix())
+}
@@ -0,0 +1,67 @@
+package anomalies
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStore_OpenUpdateResolve(t *testing.T) {
+	store := NewStore()
+	t0 := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	opened, resolved := store.Apply(t0, SignalLatency, []Anomaly{{Endpoint: checkout, Value: 900}})
+	require.Len(t, opened, 1)
+	require.Empty(t, resolved)
+	require.Equal(t, SignalLatency, opened[0].Signal)
+	require.Equal(t, t0, opened[0].StartedAt)
+	id := opened[0].ID
+
+	// flagged again: same anomaly, updated value
+	t1 := t0.Add(time.Minute)
+	opened, resolved = store.Apply(t1, SignalLatency, []Anomaly{{Endpoint: checkout, Value: 950}})
+	require.Empty(t, opened)
+	require.Empty(t, resolved)
+	list := store.List(false)
+	require.Len(t, list, 1)
+	require.Equal(t, id, list[0].ID)
+	require.Equal(t, 950.0, list[0].Value)
+	require.Equal(t, t1, list[0].LastSeenAt)
+
+	// other signals do not resolve it
+	_, resolved = store.Apply(t1, SignalThroughput, nil)
+	require.Empty(t, resolved)
+
+	t2 := t1.Add(time.Minute)
+	_, resolved = store.Apply(t2, SignalLatency, nil)
+	require.Len(t, resolved, 1)
+	require.Equal(t, t2, *resolved[0].ResolvedAt)
+	require.Empty(t, store.List(false))
+	require.Len(t, store.List(true), 1)
+}
+
+func TestStore_ResolvedRetention(t *testing.T) {
+	store := NewStore()
+	t0 := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	store.Apply(t0, SignalLatency, []Anomaly{{Endpoint: checkout}})
+	store.Apply(t0.Add(time.Minute), SignalLatency, nil)
+	require.Len(t, store.List(true), 1)
+
+	store.Apply(t0.Add(resolvedRetention+2*time.Minute), SignalLatency, nil)
+	require.Empty(t, store.List(true))
+}
+
+func TestStore_ListMostRecentFirst(t *testing.T) {
+	store := NewStore()
+	t0 := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	store.Apply(t0, SignalLatency, []Anomaly{{Endpoint: checkout}})
+	store.Apply(t0.Add(time.Minute), SignalErrorRate, []Anomaly{{Endpoint: catalog}})
+
+	list := store.List(false)
+	require.Len(t, list, 2)
+	require.Equal(t, catalog, list[0].Endpoint)
+	require.Equal(t, checkout, list[1].Endpoint)
+}
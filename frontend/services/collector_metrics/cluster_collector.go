@@ -21,6 +21,9 @@ const (
 	// This metric is added by the service graph exporter.
 	// It is used to estimate the number of service graph requests and build the service graph.
 	serviceGraphRequestMetricName = "servicegraph_traces_service_graph_request_total"
+	// This histogram is added by the service graph exporter.
+	// It records the request latency as measured by the server side of each edge.
+	serviceGraphServerLatencyMetricName = "servicegraph_traces_service_graph_request_server_seconds"
 
 	// Each metrics from the exporters has this attribute which is the name of the exporter.
 	exporterMetricAttributesKey = "exporter"
@@ -324,7 +327,15 @@ func (dm *clusterCollectorMetrics) handleClusterCollectorMetrics(senderPod strin
 				case serviceGraphRequestMetricName:
 					for d := 0; d < m.Sum().DataPoints().Len(); d++ {
 						dp := m.Sum().DataPoints().At(d)
-						dm.serviceGraph.UpdateFromDataPoint(dp)
+						dm.serviceGraph.UpdateFromDataPoint(senderPod, dp)
+					}
+				case serviceGraphServerLatencyMetricName:
+					if m.Type() != pmetric.MetricTypeHistogram {
+						continue
+					}
+					for d := 0; d < m.Histogram().DataPoints().Len(); d++ {
+						dp := m.Histogram().DataPoints().At(d)
+						dm.serviceGraph.UpdateLatencyFromDataPoint(senderPod, dp)
 					}
 				}
 			}
@@ -402,7 +413,7 @@ func (ccm *clusterCollectorMetrics) serviceGraphEdges() map[string]map[string]Se
 	for client, servers := range ccm.serviceGraph.edges {
 		result[client] = make(map[string]ServiceGraphEdge, len(servers))
 		for server, edge := range servers {
-			result[client][server] = *edge
+			result[client][server] = edge.snapshot()
 		}
 	}
 
@@ -430,10 +441,18 @@ type ServiceGraphEdge struct {
 
 	// set to true if the "To" side of the edge is for a virtual node
 	ToNodeIsVirtual bool
-	RequestCount    int64
-	LastUpdated     time.Time
+	// RequestCount is the cumulative number of requests, summed over all the series of the edge
+	RequestCount int64
+	LastUpdated  time.Time
 	// Attributes holds service-graph datapoint metric labels: client* and server*
 	Attributes map[string]string
+	// Samples holds the cumulative state of the edge at each scrape, oldest first.
+	// It is trimmed to serviceGraphSamplesRetention, and used to calculate stats over a time window.
+	Samples []ServiceGraphEdgeSample
+
+	// series holds the latest cumulative values of each series reported for this edge,
+	// keyed by the sender collector and the non client/server labels (e.g. "failed").
+	series map[string]*serviceGraphSeries
 }
 
 func newServiceGraph() *ServiceGraph {
@@ -442,19 +461,14 @@ func newServiceGraph() *ServiceGraph {
 	}
 }
 
-func (sg *ServiceGraph) UpdateFromDataPoint(dp pmetric.NumberDataPoint) {
+func (sg *ServiceGraph) UpdateFromDataPoint(senderPod string, dp pmetric.NumberDataPoint) {
 	attrs := dp.Attributes()
 
-	clientBase, ok1 := attrs.Get("client")
-	serverBase, ok2 := attrs.Get("server")
-
-	if !ok1 || !ok2 || clientBase.Str() == "unknown" || serverBase.Str() == "unknown" {
+	clientID, serverID, ok := edgeNodeIDs(attrs)
+	if !ok {
 		return
 	}
 
-	clientID := buildNodeID(clientBase.Str(), "client", attrs)
-	serverID := buildNodeID(serverBase.Str(), "server", attrs)
-
 	val := int64(0)
 	switch dp.ValueType() {
 	case pmetric.NumberDataPointValueTypeInt:
@@ -463,11 +477,49 @@ func (sg *ServiceGraph) UpdateFromDataPoint(dp pmetric.NumberDataPoint) {
 		val = int64(dp.DoubleValue())
 	}
 
-	timestamp := dp.Timestamp().AsTime()
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+
+	edge := sg.getOrCreateEdge(clientID, serverID, attrs)
+	series := edge.seriesFor(senderPod, attrs)
+	// always overwrite because it's a cumulative counter
+	series.requests = val
+	edge.recordSample(dp.Timestamp().AsTime())
+}
+
+// UpdateLatencyFromDataPoint records the server latency histogram of an edge.
+func (sg *ServiceGraph) UpdateLatencyFromDataPoint(senderPod string, dp pmetric.HistogramDataPoint) {
+	attrs := dp.Attributes()
+
+	clientID, serverID, ok := edgeNodeIDs(attrs)
+	if !ok {
+		return
+	}
 
 	sg.mu.Lock()
 	defer sg.mu.Unlock()
 
+	edge := sg.getOrCreateEdge(clientID, serverID, attrs)
+	series := edge.seriesFor(senderPod, attrs)
+	series.latencyBounds = dp.ExplicitBounds().AsRaw()
+	series.latencyBucketCounts = dp.BucketCounts().AsRaw()
+	edge.recordSample(dp.Timestamp().AsTime())
+}
+
+// edgeNodeIDs returns the client and server node IDs of the edge a datapoint belongs to.
+func edgeNodeIDs(attrs pcommon.Map) (string, string, bool) {
+	clientBase, ok1 := attrs.Get("client")
+	serverBase, ok2 := attrs.Get("server")
+
+	if !ok1 || !ok2 || clientBase.Str() == "unknown" || serverBase.Str() == "unknown" {
+		return "", "", false
+	}
+
+	return buildNodeID(clientBase.Str(), "client", attrs), buildNodeID(serverBase.Str(), "server", attrs), true
+}
+
+// getOrCreateEdge must be called with sg.mu held.
+func (sg *ServiceGraph) getOrCreateEdge(clientID, serverID string, attrs pcommon.Map) *ServiceGraphEdge {
 	if _, ok := sg.edges[clientID]; !ok {
 		sg.edges[clientID] = make(map[string]*ServiceGraphEdge)
 	}
@@ -476,17 +528,14 @@ func (sg *ServiceGraph) UpdateFromDataPoint(dp pmetric.NumberDataPoint) {
 	if !exists {
 		// a server node is not virtual if it has service.name attribute
 		_, isServerInstrumentedNode := attrs.Get("server_service_name")
-		sg.edges[clientID][serverID] = &ServiceGraphEdge{
+		edge = &ServiceGraphEdge{
 			ToNodeIsVirtual: !isServerInstrumentedNode,
-			RequestCount:    val,
-			LastUpdated:     timestamp,
 			Attributes:      copyClientServerStringAttrs(attrs),
+			series:          make(map[string]*serviceGraphSeries),
 		}
-	} else {
-		// always overwrite because it's a cumulative counter
-		edge.RequestCount = val
-		edge.LastUpdated = timestamp
+		sg.edges[clientID][serverID] = edge
 	}
+	return edge
 }
 
 // Returns a new map of all string attributes whose keys start with
@@ -0,0 +1,250 @@
+package collectormetrics
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+)
+
+// serviceGraphSamplesRetention is the maximum time window service graph stats can be calculated for.
+const serviceGraphSamplesRetention = time.Hour
+
+// ServiceGraphEdgeSample is the cumulative state of a service graph edge at a single scrape.
+type ServiceGraphEdgeSample struct {
+	Timestamp      time.Time
+	Requests       int64
+	FailedRequests int64
+	// LatencyBounds are the explicit upper bounds (in seconds) of the server latency histogram.
+	LatencyBounds []float64
+	// LatencyBucketCounts are the counts of each latency bucket (not cumulative across buckets),
+	// with an extra last bucket for values above the highest bound.
+	LatencyBucketCounts []uint64
+}
+
+// ServiceGraphEdgeStats summarizes the requests of an edge during a time window.
+type ServiceGraphEdgeStats struct {
+	// From and To are the timestamps of the samples the stats are calculated between,
+	// which can be narrower than the requested window.
+	From, To       time.Time
+	Requests       int64
+	FailedRequests int64
+	// RequestRate is the average number of requests per second.
+	RequestRate float64
+	// ErrorRate is the fraction (0-1) of the requests that failed.
+	ErrorRate float64
+	// HasLatency is false when no latency histogram was reported for the edge in the window,
+	// in which case the latency percentiles should be ignored.
+	HasLatency bool
+	// Latency percentiles, in seconds.
+	LatencyP50, LatencyP95, LatencyP99 float64
+}
+
+// serviceGraphSeries holds the latest cumulative values of a single series reported for an edge.
+type serviceGraphSeries struct {
+	requests            int64
+	failed              bool
+	latencyBounds       []float64
+	latencyBucketCounts []uint64
+}
+
+// seriesFor returns the series of the edge matching the datapoint labels.
+// The servicegraph connector splits each edge into multiple series (e.g. failed="true" / failed="false"),
+// and each cluster collector replica reports its own counters, so these have to be tracked separately and summed.
+func (e *ServiceGraphEdge) seriesFor(senderPod string, attrs pcommon.Map) *serviceGraphSeries {
+	key := serviceGraphSeriesKey(senderPod, attrs)
+	series, ok := e.series[key]
+	if !ok {
+		failed := false
+		if v, exists := attrs.Get("failed"); exists {
+			// bool when sent by the connector, string after the prometheus round-trip
+			failed = v.AsString() == "true"
+		}
+		series = &serviceGraphSeries{failed: failed}
+		e.series[key] = series
+	}
+	return series
+}
+
+func serviceGraphSeriesKey(senderPod string, attrs pcommon.Map) string {
+	parts := []string{senderPod}
+	attrs.Range(func(k string, v pcommon.Value) bool {
+		if !strings.HasPrefix(k, "client") && !strings.HasPrefix(k, "server") {
+			parts = append(parts, k+"="+v.AsString())
+		}
+		return true
+	})
+	sort.Strings(parts[1:])
+	return strings.Join(parts, "|")
+}
+
+// recordSample sums the current values of all the series into a sample at the given time.
+// Datapoints of the same scrape share a timestamp, so they update the same sample.
+func (e *ServiceGraphEdge) recordSample(timestamp time.Time) {
+	sample := ServiceGraphEdgeSample{}
+	for _, series := range e.series {
+		sample.Requests += series.requests
+		if series.failed {
+			sample.FailedRequests += series.requests
+		}
+		if len(series.latencyBucketCounts) == 0 {
+			continue
+		}
+		if sample.LatencyBucketCounts == nil {
+			sample.LatencyBounds = series.latencyBounds
+			sample.LatencyBucketCounts = slices.Clone(series.latencyBucketCounts)
+			continue
+		}
+		// all series are produced by the same connector, so the buckets are expected to match
+		if !slices.Equal(sample.LatencyBounds, series.latencyBounds) {
+			continue
+		}
+		for i, count := range series.latencyBucketCounts {
+			sample.LatencyBucketCounts[i] += count
+		}
+	}
+
+	e.RequestCount = sample.Requests
+
+	last := len(e.Samples) - 1
+	if last >= 0 && !timestamp.After(e.Samples[last].Timestamp) {
+		// same scrape, or an older timestamp from another collector replica.
+		sample.Timestamp = e.Samples[last].Timestamp
+		e.Samples[last] = sample
+		return
+	}
+
+	sample.Timestamp = timestamp
+	e.LastUpdated = timestamp
+	e.Samples = append(e.Samples, sample)
+
+	// keep a single sample older than the retention, as the baseline for a window starting at the retention edge.
+	cutoff := timestamp.Add(-serviceGraphSamplesRetention)
+	firstInRetention := sort.Search(len(e.Samples), func(i int) bool {
+		return !e.Samples[i].Timestamp.Before(cutoff)
+	})
+	if firstInRetention > 1 {
+		e.Samples = slices.Delete(e.Samples, 0, firstInRetention-1)
+	}
+}
+
+// snapshot returns a copy of the edge which is safe to use without holding the service graph lock.
+func (e *ServiceGraphEdge) snapshot() ServiceGraphEdge {
+	edge := *e
+	edge.Samples = slices.Clone(e.Samples)
+	edge.series = nil
+	return edge
+}
+
+// StatsInWindow calculates the edge stats between the given times.
+// The stats are based on the difference between the last sample at or before "from"
+// (or the first sample if the edge appeared during the window) and the last sample at or before "to".
+// false is returned if the edge doesn't have enough samples in the window.
+func (e ServiceGraphEdge) StatsInWindow(from, to time.Time) (ServiceGraphEdgeStats, bool) {
+	end := -1
+	start := -1
+	for i, sample := range e.Samples {
+		if sample.Timestamp.After(to) {
+			break
+		}
+		end = i
+		if !sample.Timestamp.After(from) {
+			start = i
+		}
+	}
+	if start == -1 {
+		start = 0
+	}
+	if end <= start {
+		return ServiceGraphEdgeStats{}, false
+	}
+
+	first, last := e.Samples[start], e.Samples[end]
+	stats := ServiceGraphEdgeStats{
+		From:           first.Timestamp,
+		To:             last.Timestamp,
+		Requests:       counterDelta(first.Requests, last.Requests),
+		FailedRequests: counterDelta(first.FailedRequests, last.FailedRequests),
+	}
+
+	stats.RequestRate = float64(stats.Requests) / last.Timestamp.Sub(first.Timestamp).Seconds()
+	if stats.Requests > 0 {
+		stats.ErrorRate = float64(stats.FailedRequests) / float64(stats.Requests)
+	}
+
+	buckets := bucketCountsDelta(first, last)
+	var total uint64
+	for _, count := range buckets {
+		total += count
+	}
+	if total > 0 {
+		stats.HasLatency = true
+		stats.LatencyP50 = histogramQuantile(0.5, last.LatencyBounds, buckets)
+		stats.LatencyP95 = histogramQuantile(0.95, last.LatencyBounds, buckets)
+		stats.LatencyP99 = histogramQuantile(0.99, last.LatencyBounds, buckets)
+	}
+
+	return stats, true
+}
+
+// counterDelta returns the increase of a cumulative counter, handling resets (e.g. collector restarts).
+func counterDelta(start, end int64) int64 {
+	if end < start {
+		return end
+	}
+	return end - start
+}
+
+func bucketCountsDelta(first, last ServiceGraphEdgeSample) []uint64 {
+	if len(last.LatencyBucketCounts) == 0 {
+		return nil
+	}
+	if !slices.Equal(first.LatencyBounds, last.LatencyBounds) || len(first.LatencyBucketCounts) != len(last.LatencyBucketCounts) {
+		return last.LatencyBucketCounts
+	}
+	delta := make([]uint64, len(last.LatencyBucketCounts))
+	for i := range last.LatencyBucketCounts {
+		if last.LatencyBucketCounts[i] < first.LatencyBucketCounts[i] {
+			// counter reset, the last sample holds everything since the reset
+			return last.LatencyBucketCounts
+		}
+		delta[i] = last.LatencyBucketCounts[i] - first.LatencyBucketCounts[i]
+	}
+	return delta
+}
+
+// histogramQuantile estimates the q quantile of an explicit bucket histogram.
+// Like prometheus histogram_quantile, values are assumed to be linearly distributed inside a bucket,
+// the first bucket starts at 0, and the highest bound is returned for ranks in the overflow bucket.
+func histogramQuantile(q float64, bounds []float64, counts []uint64) float64 {
+	var total uint64
+	for _, count := range counts {
+		total += count
+	}
+	if total == 0 || len(bounds) == 0 {
+		return 0
+	}
+
+	rank := q * float64(total)
+	var cumulative float64
+	for i, count := range counts {
+		if cumulative+float64(count) < rank {
+			cumulative += float64(count)
+			continue
+		}
+		if i >= len(bounds) {
+			break
+		}
+		lower := 0.0
+		if i > 0 {
+			lower = bounds[i-1]
+		}
+		if count == 0 {
+			return bounds[i]
+		}
+		return lower + (bounds[i]-lower)*(rank-cumulative)/float64(count)
+	}
+	return bounds[len(bounds)-1]
+}
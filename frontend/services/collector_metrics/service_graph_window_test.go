@@ -0,0 +1,130 @@
+package collectormetrics
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pmetric"
+)
+
+func TestHistogramQuantile(t *testing.T) {
+	bounds := []float64{0.1, 0.5, 1}
+	cases := []struct {
+		name   string
+		q      float64
+		counts []uint64
+		want   float64
+	}{
+		{name: "empty histogram", q: 0.5, counts: []uint64{0, 0, 0, 0}, want: 0},
+		{name: "median in first bucket", q: 0.5, counts: []uint64{10, 0, 0, 0}, want: 0.05},
+		{name: "median on bucket border", q: 0.5, counts: []uint64{5, 5, 0, 0}, want: 0.1},
+		{name: "p95 interpolated in second bucket", q: 0.95, counts: []uint64{50, 50, 0, 0}, want: 0.46},
+		{name: "p99 in overflow bucket", q: 0.99, counts: []uint64{0, 0, 0, 10}, want: 1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := histogramQuantile(c.q, bounds, c.counts)
+			if math.Abs(got-c.want) > 1e-9 {
+				t.Errorf("histogramQuantile(%f, %v, %v) = %f, want %f", c.q, bounds, c.counts, got, c.want)
+			}
+		})
+	}
+}
+
+func newServiceGraphRequestsDataPoint(failed string, requests int64, ts time.Time) pmetric.NumberDataPoint {
+	dp := pmetric.NewNumberDataPoint()
+	dp.Attributes().PutStr("client", "frontend")
+	dp.Attributes().PutStr("server", "coupon")
+	dp.Attributes().PutStr("server_service_name", "coupon")
+	dp.Attributes().PutStr("failed", failed)
+	dp.SetDoubleValue(float64(requests))
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	return dp
+}
+
+func newServiceGraphLatencyDataPoint(failed string, counts []uint64, ts time.Time) pmetric.HistogramDataPoint {
+	dp := pmetric.NewHistogramDataPoint()
+	dp.Attributes().PutStr("client", "frontend")
+	dp.Attributes().PutStr("server", "coupon")
+	dp.Attributes().PutStr("server_service_name", "coupon")
+	dp.Attributes().PutStr("failed", failed)
+	dp.ExplicitBounds().FromRaw([]float64{0.1, 0.5, 1})
+	dp.BucketCounts().FromRaw(counts)
+	dp.SetTimestamp(pcommon.NewTimestampFromTime(ts))
+	return dp
+}
+
+func TestServiceGraphEdgeStatsInWindow(t *testing.T) {
+	start := time.Date(2025, 6, 24, 16, 0, 0, 0, time.UTC)
+	sg := newServiceGraph()
+
+	// first scrape: 100 ok requests, 10 failed
+	sg.UpdateFromDataPoint("gateway-1", newServiceGraphRequestsDataPoint("false", 100, start))
+	sg.UpdateFromDataPoint("gateway-1", newServiceGraphRequestsDataPoint("true", 10, start))
+	sg.UpdateLatencyFromDataPoint("gateway-1", newServiceGraphLatencyDataPoint("false", []uint64{100, 0, 0, 0}, start))
+	sg.UpdateLatencyFromDataPoint("gateway-1", newServiceGraphLatencyDataPoint("true", []uint64{0, 0, 10, 0}, start))
+
+	// second scrape, 60 seconds later: 90 more ok requests, 30 more failed
+	second := start.Add(time.Minute)
+	sg.UpdateFromDataPoint("gateway-1", newServiceGraphRequestsDataPoint("false", 190, second))
+	sg.UpdateFromDataPoint("gateway-1", newServiceGraphRequestsDataPoint("true", 40, second))
+	sg.UpdateLatencyFromDataPoint("gateway-1", newServiceGraphLatencyDataPoint("false", []uint64{190, 0, 0, 0}, second))
+	sg.UpdateLatencyFromDataPoint("gateway-1", newServiceGraphLatencyDataPoint("true", []uint64{0, 0, 40, 0}, second))
+
+	edge := sg.edges["frontend"]["coupon"].snapshot()
+	if edge.RequestCount != 230 {
+		t.Fatalf("RequestCount = %d, want 230", edge.RequestCount)
+	}
+	if len(edge.Samples) != 2 {
+		t.Fatalf("got %d samples, want 2", len(edge.Samples))
+	}
+
+	t.Run("window covering both scrapes", func(t *testing.T) {
+		stats, ok := edge.StatsInWindow(start, second)
+		if !ok {
+			t.Fatal("expected stats for the window")
+		}
+		if stats.Requests != 120 || stats.FailedRequests != 30 {
+			t.Errorf("requests = %d, failed = %d, want 120 and 30", stats.Requests, stats.FailedRequests)
+		}
+		if stats.RequestRate != 2 {
+			t.Errorf("RequestRate = %f, want 2", stats.RequestRate)
+		}
+		if stats.ErrorRate != 0.25 {
+			t.Errorf("ErrorRate = %f, want 0.25", stats.ErrorRate)
+		}
+		if !stats.HasLatency {
+			t.Fatal("expected latency stats")
+		}
+		// 90 requests in the first bucket and 30 in the third one
+		if math.Abs(stats.LatencyP50-0.1*60/90) > 1e-9 {
+			t.Errorf("LatencyP50 = %f", stats.LatencyP50)
+		}
+		if stats.LatencyP99 <= 0.5 || stats.LatencyP99 > 1 {
+			t.Errorf("LatencyP99 = %f, expected in the (0.5, 1] bucket", stats.LatencyP99)
+		}
+	})
+
+	t.Run("window with a single sample", func(t *testing.T) {
+		if _, ok := edge.StatsInWindow(second, second.Add(time.Minute)); ok {
+			t.Error("expected no stats for a window with a single sample")
+		}
+	})
+
+	t.Run("counter reset", func(t *testing.T) {
+		third := second.Add(time.Minute)
+		sg.UpdateFromDataPoint("gateway-1", newServiceGraphRequestsDataPoint("false", 5, third))
+		sg.UpdateFromDataPoint("gateway-1", newServiceGraphRequestsDataPoint("true", 0, third))
+
+		stats, ok := sg.edges["frontend"]["coupon"].snapshot().StatsInWindow(second, third)
+		if !ok {
+			t.Fatal("expected stats for the window")
+		}
+		if stats.Requests != 5 || stats.FailedRequests != 0 {
+			t.Errorf("requests = %d, failed = %d, want 5 and 0", stats.Requests, stats.FailedRequests)
+		}
+	})
+}
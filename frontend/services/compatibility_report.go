@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+
+	"github.com/odigos-io/odigos/frontend/graph/model"
+	"github.com/odigos-io/odigos/frontend/kube"
+	"github.com/odigos-io/odigos/k8sutils/pkg/compatibility"
+	"github.com/odigos-io/odigos/k8sutils/pkg/env"
+)
+
+// GetCompatibilityReport returns the pre-flight compatibility report of the workloads in a namespace,
+// computed by the instrumentor before the namespace is instrumented.
+func GetCompatibilityReport(ctx context.Context, namespace string) (*model.NamespaceCompatibilityReport, error) {
+	report, err := compatibility.Get(ctx, kube.DefaultClient.Interface, env.GetCurrentNamespace(), namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.NamespaceCompatibilityReport{
+		Namespace:       report.Namespace,
+		Workloads:       make([]*model.WorkloadCompatibility, 0, len(report.Workloads)),
+		InspectionError: StringPtrIfNotEmpty(report.InspectionError),
+	}
+	for _, workloadReport := range report.Workloads {
+		gqlWorkload := &model.WorkloadCompatibility{
+			Kind:         string(workloadReport.Kind),
+			Name:         workloadReport.Name,
+			Instrumented: workloadReport.Instrumented,
+			Containers:   make([]*model.ContainerCompatibility, 0, len(workloadReport.Containers)),
+		}
+		for _, containerReport := range workloadReport.Containers {
+			gqlContainer := &model.ContainerCompatibility{
+				ContainerName:  containerReport.ContainerName,
+				Language:       StringPtrIfNotEmpty(string(containerReport.Language)),
+				RuntimeVersion: StringPtrIfNotEmpty(containerReport.RuntimeVersion),
+				OtelDistroName: StringPtrIfNotEmpty(containerReport.OtelDistroName),
+				Supported:      containerReport.Supported,
+				Reason:         StringPtrIfNotEmpty(containerReport.Reason),
+				Message:        StringPtrIfNotEmpty(containerReport.Message),
+				Blockers:       make([]*model.CompatibilityBlocker, 0, len(containerReport.Blockers)),
+			}
+			for _, blocker := range containerReport.Blockers {
+				gqlContainer.Blockers = append(gqlContainer.Blockers, &model.CompatibilityBlocker{
+					Type:    string(blocker.Type),
+					Message: blocker.Message,
+				})
+			}
+			gqlWorkload.Containers = append(gqlWorkload.Containers, gqlContainer)
+		}
+		result.Workloads = append(result.Workloads, gqlWorkload)
+	}
+	return result, nil
+}
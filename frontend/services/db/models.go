@@ -58,9 +58,31 @@ func (InstrumentedProcessError) TableName() string {
 	return "instrumented_processes_errors"
 }
 
+// ProfileBucket stores the aggregated profile of a source over one time bucket.
+// Fresh buckets span one minute and are merged into coarser buckets as they age (downsampling).
+//
+//	**Primary Key Fields:**
+//
+// - `source_key` → The workload the profile belongs to (`namespace/Kind/name`).
+// - `bucket_start` → Start of the time bucket.
+// - `bucket_seconds` → Bucket length, so a downsampled bucket never collides with a fresh one at the same start.
+
+type ProfileBucket struct {
+	SourceKey     string    `gorm:"primaryKey; column:source_key; type:text; not null"`
+	BucketStart   time.Time `gorm:"primaryKey; column:bucket_start; not null; index"`
+	BucketSeconds int       `gorm:"primaryKey; column:bucket_seconds; not null"`
+	Stacks        []byte    `gorm:"column:stacks; not null"` // gzip compressed stack counts
+	Bytes         int64     `gorm:"column:bytes; not null"`  // len(Stacks), kept for size accounting
+}
+
+func (ProfileBucket) TableName() string {
+	return "profile_buckets"
+}
+
 func InitializeDatabaseSchema(db *gorm.DB) {
 	db.AutoMigrate(&InstrumentedProcess{})
 	db.AutoMigrate(&InstrumentedProcessError{})
+	db.AutoMigrate(&ProfileBucket{})
 
 	// Drop existing triggers if they exist
 	db.Exec(`DROP TRIGGER IF EXISTS delete_process_errors;`)
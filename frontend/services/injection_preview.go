@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	"github.com/odigos-io/odigos/frontend/graph/model"
+	"github.com/odigos-io/odigos/frontend/kube"
+	"github.com/odigos-io/odigos/k8sutils/pkg/env"
+	"github.com/odigos-io/odigos/k8sutils/pkg/injectionpreview"
+	"github.com/odigos-io/odigos/k8sutils/pkg/workload"
+)
+
+// GetInjectionPreview returns the patch the odigos pods webhook would apply to new pods of the workload,
+// or the reasons the pods would not be mutated.
+func GetInjectionPreview(ctx context.Context, namespace string, kind string, name string) (*model.InjectionPreview, error) {
+	preview, err := injectionpreview.Get(ctx, kube.DefaultClient.Interface, env.GetCurrentNamespace(), k8sconsts.PodWorkload{
+		Namespace: namespace,
+		Kind:      workload.WorkloadKindFromString(kind),
+		Name:      name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.InjectionPreview{
+		Injected: preview.Injected,
+		Patch:    make([]*model.InjectionPatchOperation, 0, len(preview.Patch)),
+		Reasons:  preview.Reasons,
+	}
+	if result.Reasons == nil {
+		result.Reasons = []string{}
+	}
+	for _, op := range preview.Patch {
+		gqlOp := &model.InjectionPatchOperation{
+			Op:   op.Operation,
+			Path: op.Path,
+		}
+		if op.Value != nil {
+			value, err := json.Marshal(op.Value)
+			if err != nil {
+				return nil, err
+			}
+			valueStr := string(value)
+			gqlOp.Value = &valueStr
+		}
+		result.Patch = append(result.Patch, gqlOp)
+	}
+	return result, nil
+}
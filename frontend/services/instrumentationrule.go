@@ -42,6 +42,10 @@ func deriveTypeFromRule(rule *model.InstrumentationRule) model.InstrumentationRu
 		return model.InstrumentationRuleTypeNetworkMetrics
 	}
 
+	if rule.Profiling != nil {
+		return model.InstrumentationRuleTypeProfiling
+	}
+
 	return model.InstrumentationRuleTypeUnknownType
 }
 
@@ -64,6 +68,40 @@ func networkMetricsEnabledPtr(cfg *instrumentationrules.NetworkMetricsConfig) *b
 	return &enabled
 }
 
+var profileTypesToModel = map[instrumentationrules.ProfileType]model.ProfilingRuleProfileType{
+	instrumentationrules.ProfileTypeCpu:    model.ProfilingRuleProfileTypeCPU,
+	instrumentationrules.ProfileTypeOffCpu: model.ProfilingRuleProfileTypeOffCPU,
+}
+
+// getProfilingInput maps the GraphQL profiling input to the CRD config; nil leaves the rule without profiling.
+func getProfilingInput(input model.InstrumentationRuleInput) *instrumentationrules.Profiling {
+	if input.Profiling == nil {
+		return nil
+	}
+	profiling := &instrumentationrules.Profiling{SamplingFrequencyHz: input.Profiling.SamplingFrequencyHz}
+	for _, profileType := range input.Profiling.ProfileTypes {
+		for crdType, modelType := range profileTypesToModel {
+			if modelType == profileType {
+				profiling.ProfileTypes = append(profiling.ProfileTypes, crdType)
+			}
+		}
+	}
+	return profiling
+}
+
+func convertProfiling(profiling *instrumentationrules.Profiling) *model.ProfilingRule {
+	if profiling == nil {
+		return nil
+	}
+	out := &model.ProfilingRule{SamplingFrequencyHz: profiling.SamplingFrequencyHz}
+	for _, profileType := range profiling.ProfileTypes {
+		if modelType, ok := profileTypesToModel[profileType]; ok {
+			out.ProfileTypes = append(out.ProfileTypes, modelType)
+		}
+	}
+	return out
+}
+
 // GetInstrumentationRules fetches all instrumentation rules
 func GetInstrumentationRules(ctx context.Context) ([]*model.InstrumentationRule, error) {
 	ns := env.GetCurrentNamespace()
@@ -94,6 +132,7 @@ func GetInstrumentationRules(ctx context.Context) ([]*model.InstrumentationRule,
 			PayloadCollection:        convertPayloadCollection(r.Spec.PayloadCollection),
 			CustomInstrumentations:   convertCustomInstrumentations(r.Spec.CustomInstrumentations),
 			NetworkMetrics:           networkMetricsEnabledPtr(r.Spec.NetworkMetrics),
+			Profiling:                convertProfiling(r.Spec.Profiling),
 		}
 		rule.Type = deriveTypeFromRule(rule)
 
@@ -128,6 +167,7 @@ func GetInstrumentationRule(ctx context.Context, id string) (*model.Instrumentat
 		PayloadCollection:        convertPayloadCollection(r.Spec.PayloadCollection),
 		CustomInstrumentations:   convertCustomInstrumentations(r.Spec.CustomInstrumentations),
 		NetworkMetrics:           networkMetricsEnabledPtr(r.Spec.NetworkMetrics),
+		Profiling:                convertProfiling(r.Spec.Profiling),
 	}
 	rule.Type = deriveTypeFromRule(rule)
 
@@ -549,6 +589,7 @@ func UpdateInstrumentationRule(ctx context.Context, id string, input model.Instr
 	}
 
 	existingRule.Spec.NetworkMetrics = getNetworkMetricsInput(input)
+	existingRule.Spec.Profiling = getProfilingInput(input)
 	// Update rule in Kubernetes
 	updatedRule, err := kube.DefaultClient.OdigosClient.InstrumentationRules(ns).Update(ctx, existingRule, metav1.UpdateOptions{})
 	if err != nil {
@@ -572,6 +613,7 @@ func UpdateInstrumentationRule(ctx context.Context, id string, input model.Instr
 		PayloadCollection:        convertPayloadCollection(updatedRule.Spec.PayloadCollection),
 		CustomInstrumentations:   convertCustomInstrumentations(updatedRule.Spec.CustomInstrumentations),
 		NetworkMetrics:           networkMetricsEnabledPtr(updatedRule.Spec.NetworkMetrics),
+		Profiling:                convertProfiling(updatedRule.Spec.Profiling),
 	}
 	rule.Type = deriveTypeFromRule(&rule)
 	return &rule, nil
@@ -634,6 +676,7 @@ func CreateInstrumentationRule(ctx context.Context, input model.InstrumentationR
 			PayloadCollection:        getPayloadCollectionInput(input),
 			CustomInstrumentations:   customInstrumentations,
 			NetworkMetrics:           getNetworkMetricsInput(input),
+			Profiling:                getProfilingInput(input),
 		},
 	}
 	// Create the rule in Kubernetes
@@ -659,6 +702,7 @@ func CreateInstrumentationRule(ctx context.Context, input model.InstrumentationR
 		PayloadCollection:        convertPayloadCollection(createdRule.Spec.PayloadCollection),
 		CustomInstrumentations:   convertCustomInstrumentations(createdRule.Spec.CustomInstrumentations),
 		NetworkMetrics:           networkMetricsEnabledPtr(createdRule.Spec.NetworkMetrics),
+		Profiling:                convertProfiling(createdRule.Spec.Profiling),
 	}
 	rule.Type = deriveTypeFromRule(&rule)
 
@@ -2,6 +2,7 @@ package profiles
 
 import (
 	"context"
+	"time"
 
 	commonlogger "github.com/odigos-io/odigos/common/logger"
 	"github.com/odigos-io/odigos/common/profilecache"
@@ -16,15 +17,18 @@ import (
 type ProfileStore = profilecache.Store
 
 type OdigosProfilesConsumer struct {
-	store    *ProfileStore
-	gate     *IngestGate
-	profiles xconsumer.Profiles
+	store      *ProfileStore
+	persistent *PersistentProfileStore
+	gate       *IngestGate
+	profiles   xconsumer.Profiles
 }
 
 // NewOdigosProfilesConsumer builds a profiles consumer for the given store.
 // gate controls whether incoming OTLP profiles are stored; when false, batches are dropped.
-func NewOdigosProfilesConsumer(store *ProfileStore, gate *IngestGate) (*OdigosProfilesConsumer, error) {
-	profilesConsumer := &OdigosProfilesConsumer{store: store, gate: gate}
+// persistent is optional; when set, profiles of all sources are also aggregated to disk,
+// not only the ones with an active in-memory slot.
+func NewOdigosProfilesConsumer(store *ProfileStore, gate *IngestGate, persistent *PersistentProfileStore) (*OdigosProfilesConsumer, error) {
+	profilesConsumer := &OdigosProfilesConsumer{store: store, gate: gate, persistent: persistent}
 	profiles, err := xconsumer.NewProfiles(
 		profilesConsumer.consume,
 		consumer.WithCapabilities(consumer.Capabilities{MutatesData: false}),
@@ -58,25 +62,35 @@ func (c *OdigosProfilesConsumer) consume(ctx context.Context, incomingBatch ppro
 		if !ok {
 			continue
 		}
-		if !c.store.IsActive(sourceKey) {
+		active := c.store.IsActive(sourceKey)
+		if !active && c.persistent == nil {
 			continue
 		}
-		appendResourceProfileChunk(c.store, sourceKey, incomingBatch, resourceProfiles, idx)
+		chunkBytes, ok := marshalResourceProfileChunk(sourceKey, incomingBatch, resourceProfiles, idx)
+		if !ok {
+			continue
+		}
+		if active {
+			c.store.AddProfileData(sourceKey, chunkBytes)
+		}
+		if c.persistent != nil {
+			c.persistent.Add(sourceKey, chunkBytes, time.Now())
+		}
 	}
 	return nil
 }
 
-// appendResourceProfileChunk marshals a single resource's profiles as OTLP protobuf and appends to the slot
-func appendResourceProfileChunk(store *ProfileStore, sourceKey string, incomingBatch pprofile.Profiles, resourceProfiles pprofile.ResourceProfilesSlice, resourceIndex int) {
+// marshalResourceProfileChunk marshals a single resource's profiles as OTLP protobuf
+func marshalResourceProfileChunk(sourceKey string, incomingBatch pprofile.Profiles, resourceProfiles pprofile.ResourceProfilesSlice, resourceIndex int) ([]byte, bool) {
 	log := commonlogger.LoggerCompat().With("subsystem", "backend-profiling")
 	singleResourceChunk := buildSingleResourceProfilesFromBatch(incomingBatch, resourceProfiles, resourceIndex)
 	exportReq := pprofileotlp.NewExportRequestFromProfiles(singleResourceChunk)
 	chunkBytes, marshalErr := exportReq.MarshalProto()
 	if marshalErr != nil {
 		log.Warn("store_chunk", "sourceKey", sourceKey, "err", marshalErr)
-		return
+		return nil, false
 	}
-	store.AddProfileData(sourceKey, chunkBytes)
+	return chunkBytes, true
 }
 
 // buildSingleResourceProfilesFromBatch builds a standalone pprofile.Profiles message holding one ResourceProfiles entry from the batch.
@@ -0,0 +1,124 @@
+package profiles
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/odigos-io/odigos/frontend/services/common"
+	"github.com/odigos-io/odigos/frontend/services/profiles/flamegraph"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/pprofile/pprofileotlp"
+)
+
+// ProfileSelector selects the buffered profiles of a source which are used for one side of a diff.
+// Zero values match everything.
+type ProfileSelector struct {
+	From time.Time
+	To   time.Time
+	// ResourceAttributes must all be set on the profile resource, e.g. k8s.pod.name or service.version
+	// to compare pods running different versions of the same workload.
+	// For array attributes (e.g. container.image.tags) it is enough for the value to be one of the elements.
+	ResourceAttributes map[string]string
+}
+
+// GetProfilingDiffForSource returns a differential flame graph of the comparison profiles against the baseline profiles,
+// both selected from the buffered profiles of a workload.
+func GetProfilingDiffForSource(ctx context.Context, store common.ProfileStoreRef, namespace, kindStr, name string, baseline, comparison ProfileSelector) (string, error) {
+	id, err := SourceIDFromStrings(namespace, kindStr, name)
+	if err != nil {
+		return "", err
+	}
+	key := SourceKeyFromSourceID(id)
+	store.EnsureSlot(key)
+	chunks := store.GetProfileData(key)
+
+	const maxNodes = 2048
+	diff, err := flamegraph.BuildDiffFlamebearerViaPyroscope(ctx, selectChunks(chunks, baseline), selectChunks(chunks, comparison), maxNodes)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(diff)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// selectChunks returns the chunks matching the selector.
+// Chunks hold a single resource, so they are either kept or dropped by the resource attributes,
+// while profiles outside of the time range are removed from the chunks which are kept.
+func selectChunks(chunks [][]byte, selector ProfileSelector) [][]byte {
+	out := make([][]byte, 0, len(chunks))
+	for _, chunk := range chunks {
+		req := pprofileotlp.NewExportRequest()
+		if err := req.UnmarshalProto(chunk); err != nil {
+			continue
+		}
+		profiles := req.Profiles()
+
+		kept := 0
+		rps := profiles.ResourceProfiles()
+		rps.RemoveIf(func(rp pprofile.ResourceProfiles) bool {
+			if !resourceMatches(rp.Resource().Attributes(), selector.ResourceAttributes) {
+				return true
+			}
+			sps := rp.ScopeProfiles()
+			sps.RemoveIf(func(sp pprofile.ScopeProfiles) bool {
+				sp.Profiles().RemoveIf(func(p pprofile.Profile) bool {
+					return !timeMatches(p.Time().AsTime(), selector)
+				})
+				kept += sp.Profiles().Len()
+				return sp.Profiles().Len() == 0
+			})
+			return sps.Len() == 0
+		})
+		if kept == 0 {
+			continue
+		}
+
+		b, err := req.MarshalProto()
+		if err != nil {
+			continue
+		}
+		out = append(out, b)
+	}
+	return out
+}
+
+func timeMatches(t time.Time, selector ProfileSelector) bool {
+	if !selector.From.IsZero() && t.Before(selector.From) {
+		return false
+	}
+	if !selector.To.IsZero() && t.After(selector.To) {
+		return false
+	}
+	return true
+}
+
+func resourceMatches(attrs pcommon.Map, expected map[string]string) bool {
+	for key, value := range expected {
+		v, ok := attrs.Get(key)
+		if !ok {
+			return false
+		}
+		if v.Type() == pcommon.ValueTypeSlice {
+			found := false
+			for i := 0; i < v.Slice().Len(); i++ {
+				if v.Slice().At(i).AsString() == value {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+			continue
+		}
+		if v.AsString() != value {
+			return false
+		}
+	}
+	return true
+}
@@ -19,6 +19,16 @@ const (
 	DefaultProfilingSlotMaxBytes           = 8 * 1024 * 1024 // 8 MiB
 	DefaultProfilingSlotTTLSeconds         = 120             // seconds
 	DefaultProfilingCleanupIntervalSeconds = 15              // ProfileStore TTL sweep ticker period (pod-local only)
+
+	// Default settings for the on-disk profile storage (profiling.ui.persistence).
+	DefaultPersistenceRetentionHours            = 24
+	DefaultPersistenceDownsampleAfterMinutes    = 60
+	DefaultPersistenceDownsampleIntervalMinutes = 10
+	DefaultPersistenceMaxBytes                  = 256 * 1024 * 1024 // 256 MiB
+
+	// PersistenceDBPathEnv overrides where the on-disk profile storage is kept.
+	PersistenceDBPathEnv     = "PROFILES_DB_PATH"
+	DefaultPersistenceDBPath = "/data/profiles/profiles.db"
 )
 
 // StoreLimitsFromEnv returns profile store tuning from the UI pod's environment variables,
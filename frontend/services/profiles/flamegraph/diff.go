@@ -0,0 +1,121 @@
+package flamegraph
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	pyrofb "github.com/grafana/pyroscope/pkg/og/structs/flamebearer"
+)
+
+var (
+	ErrNoBaselineProfileData   = errors.New("no profiling data for the baseline")
+	ErrNoComparisonProfileData = errors.New("no profiling data for the comparison")
+)
+
+// DiffFlamebearerProfile is the JSON blob served as SourceProfilingDiffResult.profileJson.
+// The flamegraph is the upstream Pyroscope "double" format, where the left side is the baseline
+// and the right side is the comparison; Symbols is an Odigos-only extension, like in FlamebearerProfile.
+type DiffFlamebearerProfile struct {
+	*pyrofb.FlamebearerProfile
+	Symbols []SymbolDiff `json:"symbols,omitempty"`
+}
+
+// SymbolDiff compares the self/total weight of a function name between the baseline and the comparison.
+// The changes are in fractions of the total weight of each side (e.g. 0.1 = grew by 10 percentage points),
+// so profiles collected over windows of different lengths can be compared.
+type SymbolDiff struct {
+	Name            string  `json:"name"`
+	BaselineSelf    int64   `json:"baselineSelf"`
+	BaselineTotal   int64   `json:"baselineTotal"`
+	ComparisonSelf  int64   `json:"comparisonSelf"`
+	ComparisonTotal int64   `json:"comparisonTotal"`
+	SelfChange      float64 `json:"selfChange"`
+	TotalChange     float64 `json:"totalChange"`
+}
+
+// BuildDiffFlamebearerViaPyroscope builds a differential flame graph of the comparison chunks against the baseline chunks.
+func BuildDiffFlamebearerViaPyroscope(ctx context.Context, baselineChunks, comparisonChunks [][]byte, maxNodes int64) (*DiffFlamebearerProfile, error) {
+	if maxNodes <= 0 {
+		maxNodes = symdbFlameMaxNodesDefault
+	}
+
+	baseline, pt, err := FunctionNameTreeViaPyroscopeSymdb(ctx, baselineChunks, maxNodes)
+	if err != nil {
+		return nil, err
+	}
+	if baseline == nil || baseline.Total() == 0 {
+		return nil, ErrNoBaselineProfileData
+	}
+	comparison, _, err := FunctionNameTreeViaPyroscopeSymdb(ctx, comparisonChunks, maxNodes)
+	if err != nil {
+		return nil, err
+	}
+	if comparison == nil || comparison.Total() == 0 {
+		return nil, ErrNoComparisonProfileData
+	}
+
+	symbols := DiffSymbolStats(
+		SymbolStatsFromFunctionNameTree(baseline), baseline.Total(),
+		SymbolStatsFromFunctionNameTree(comparison), comparison.Total(),
+	)
+
+	fg, err := phlaremodel.NewFlamegraphDiff(baseline, comparison, maxNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DiffFlamebearerProfile{
+		FlamebearerProfile: phlaremodel.ExportDiffToFlamebearer(fg, pt),
+		Symbols:            symbols,
+	}, nil
+}
+
+// DiffSymbolStats compares the symbol tables of two profiles, sorted by the largest self change first.
+// Symbols which don't change are omitted.
+func DiffSymbolStats(baseline []SymbolStats, baselineTotal int64, comparison []SymbolStats, comparisonTotal int64) []SymbolDiff {
+	byName := make(map[string]*SymbolDiff, len(baseline))
+	for _, s := range baseline {
+		byName[s.Name] = &SymbolDiff{Name: s.Name, BaselineSelf: s.Self, BaselineTotal: s.Total}
+	}
+	for _, s := range comparison {
+		d, ok := byName[s.Name]
+		if !ok {
+			d = &SymbolDiff{Name: s.Name}
+			byName[s.Name] = d
+		}
+		d.ComparisonSelf = s.Self
+		d.ComparisonTotal = s.Total
+	}
+
+	out := make([]SymbolDiff, 0, len(byName))
+	for _, d := range byName {
+		d.SelfChange = fraction(d.ComparisonSelf, comparisonTotal) - fraction(d.BaselineSelf, baselineTotal)
+		d.TotalChange = fraction(d.ComparisonTotal, comparisonTotal) - fraction(d.BaselineTotal, baselineTotal)
+		if d.SelfChange == 0 && d.TotalChange == 0 {
+			continue
+		}
+		out = append(out, *d)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		ai, aj := math.Abs(out[i].SelfChange), math.Abs(out[j].SelfChange)
+		if ai != aj {
+			return ai > aj
+		}
+		ti, tj := math.Abs(out[i].TotalChange), math.Abs(out[j].TotalChange)
+		if ti != tj {
+			return ti > tj
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+func fraction(value, total int64) float64 {
+	if total <= 0 {
+		return 0
+	}
+	return float64(value) / float64(total)
+}
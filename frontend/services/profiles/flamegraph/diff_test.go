@@ -0,0 +1,43 @@
+package flamegraph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDiffSymbolStats(t *testing.T) {
+	baseline := []SymbolStats{
+		{Name: "main", Self: 0, Total: 100},
+		{Name: "handler", Self: 50, Total: 100},
+		{Name: "json.Marshal", Self: 50, Total: 50},
+	}
+	// twice as many samples, handler self share shrank and a new hot function appeared
+	comparison := []SymbolStats{
+		{Name: "main", Self: 0, Total: 200},
+		{Name: "handler", Self: 60, Total: 200},
+		{Name: "json.Marshal", Self: 100, Total: 100},
+		{Name: "regexp.Compile", Self: 60, Total: 60},
+	}
+
+	got := DiffSymbolStats(baseline, 100, comparison, 200)
+
+	// main and json.Marshal keep the same share of the profile
+	if len(got) != 2 {
+		t.Fatalf("got %d symbols, want 2: %+v", len(got), got)
+	}
+	want := []struct {
+		name       string
+		selfChange float64
+	}{
+		{name: "regexp.Compile", selfChange: 0.3},
+		{name: "handler", selfChange: 0.3 - 0.5},
+	}
+	for i, w := range want {
+		if got[i].Name != w.name || math.Abs(got[i].SelfChange-w.selfChange) > 1e-9 {
+			t.Errorf("symbol %d = %s (%f), want %s (%f)", i, got[i].Name, got[i].SelfChange, w.name, w.selfChange)
+		}
+	}
+	if got[0].BaselineSelf != 0 || got[0].ComparisonSelf != 60 {
+		t.Errorf("regexp.Compile self = %d -> %d, want 0 -> 60", got[0].BaselineSelf, got[0].ComparisonSelf)
+	}
+}
@@ -17,6 +17,20 @@ import (
 const symdbFlameMaxNodesDefault int64 = 2048
 
 func BuildFlamebearerViaPyroscopeSymdb(ctx context.Context, chunks [][]byte, maxNodes int64) (*pyrofb.FlamebearerProfile, *phlaremodel.FunctionNameTree, error) {
+	if maxNodes <= 0 {
+		maxNodes = symdbFlameMaxNodesDefault
+	}
+	tree, pt, err := FunctionNameTreeViaPyroscopeSymdb(ctx, chunks, maxNodes)
+	if err != nil || tree == nil {
+		return nil, nil, err
+	}
+	fg := phlaremodel.NewFlameGraph(tree, maxNodes)
+	return phlaremodel.ExportToFlamebearer(fg, pt), tree, nil
+}
+
+// FunctionNameTreeViaPyroscopeSymdb merges the chunks into a Pyroscope function name tree, before it is rendered as a flame graph.
+// A nil tree is returned when the chunks hold no samples.
+func FunctionNameTreeViaPyroscopeSymdb(ctx context.Context, chunks [][]byte, maxNodes int64) (*phlaremodel.FunctionNameTree, *typesv1.ProfileType, error) {
 	gp, pt, extra := MergedGoogleProfileForPyroscopeSymdb(chunks)
 	if maxNodes <= 0 {
 		maxNodes = symdbFlameMaxNodesDefault
@@ -25,12 +39,11 @@ func BuildFlamebearerViaPyroscopeSymdb(ctx context.Context, chunks [][]byte, max
 	// No merged pprof, but we still have per-profile stacks (intra-bucket merge failures, etc.).
 	if gp == nil || len(gp.Sample) == 0 {
 		if len(extra) == 0 {
-			return nil, nil, nil
+			return nil, pt, nil
 		}
 		t := new(phlaremodel.FunctionNameTree)
 		insertSamplesIntoFunctionNameTree(t, extra)
-		fg := phlaremodel.NewFlameGraph(t, maxNodes)
-		return phlaremodel.ExportToFlamebearer(fg, pt), t, nil
+		return t, pt, nil
 	}
 
 	raw := pprof.RawFromProto(proto.Clone(gp).(*googleProfile.Profile))
@@ -60,8 +73,7 @@ func BuildFlamebearerViaPyroscopeSymdb(ctx context.Context, chunks [][]byte, max
 		return nil, nil, fmt.Errorf("symdb resolver tree: %w", err)
 	}
 	insertSamplesIntoFunctionNameTree(tree, extra)
-	fg := phlaremodel.NewFlameGraph(tree, maxNodes)
-	return phlaremodel.ExportToFlamebearer(fg, pt), tree, nil
+	return tree, pt, nil
 }
 
 func insertSamplesIntoFunctionNameTree(tree *phlaremodel.FunctionNameTree, samples []Sample) {
@@ -0,0 +1,111 @@
+package flamegraph
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"strings"
+
+	phlaremodel "github.com/grafana/pyroscope/pkg/model"
+	pyrofb "github.com/grafana/pyroscope/pkg/og/structs/flamebearer"
+)
+
+// stackKeySeparator joins frames into a StackCounts key. Frame names may contain ';' (e.g. JVM signatures),
+// so the usual collapsed-stack separator can't be used.
+const stackKeySeparator = "\x00"
+
+// StackCounts aggregates sample values by root-first call stack.
+// It is the symbolized, format-independent representation used to persist profiles,
+// and can be merged without access to the original pprof/OTLP dictionaries.
+type StackCounts map[string]int64
+
+// SamplesFromChunks converts stored OTLP profile chunks into symbolized samples.
+func SamplesFromChunks(chunks [][]byte) []Sample {
+	var out []Sample
+	for _, p := range collectGoogleProfilesFromChunks(chunks) {
+		out = append(out, googleProfileToSamples(p)...)
+	}
+	return out
+}
+
+// AddSamples adds the sample values to the stack counts.
+func (s StackCounts) AddSamples(samples []Sample) {
+	for _, sample := range samples {
+		if sample.Value <= 0 || len(sample.Stack) == 0 {
+			continue
+		}
+		s[strings.Join(sample.Stack, stackKeySeparator)] += sample.Value
+	}
+}
+
+// Merge adds the values of other into s.
+func (s StackCounts) Merge(other StackCounts) {
+	for stack, value := range other {
+		s[stack] += value
+	}
+}
+
+// Total returns the sum of all values.
+func (s StackCounts) Total() int64 {
+	var total int64
+	for _, value := range s {
+		total += value
+	}
+	return total
+}
+
+// Samples returns the stack counts as samples, in no particular order.
+func (s StackCounts) Samples() []Sample {
+	out := make([]Sample, 0, len(s))
+	for stack, value := range s {
+		out = append(out, Sample{Stack: strings.Split(stack, stackKeySeparator), Value: value})
+	}
+	return out
+}
+
+// Marshal encodes the stack counts as gzip compressed JSON.
+func (s StackCounts) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(map[string]int64(s)); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalStackCounts decodes stack counts encoded by StackCounts.Marshal.
+func UnmarshalStackCounts(b []byte) (StackCounts, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+	out := StackCounts{}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BuildFlamebearerFromStackCounts renders stack counts as a Pyroscope flame graph.
+// The function name tree is returned as well for symbol statistics; both are nil when there are no samples.
+func BuildFlamebearerFromStackCounts(stacks StackCounts, maxNodes int64) (*pyrofb.FlamebearerProfile, *phlaremodel.FunctionNameTree) {
+	if stacks.Total() == 0 {
+		return nil, nil
+	}
+	if maxNodes <= 0 {
+		maxNodes = symdbFlameMaxNodesDefault
+	}
+	tree := new(phlaremodel.FunctionNameTree)
+	insertSamplesIntoFunctionNameTree(tree, stacks.Samples())
+	fg := phlaremodel.NewFlameGraph(tree, maxNodes)
+	return phlaremodel.ExportToFlamebearer(fg, DefaultProfileType()), tree
+}
@@ -0,0 +1,304 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	commonlogger "github.com/odigos-io/odigos/common/logger"
+	"github.com/odigos-io/odigos/frontend/services/db"
+	"github.com/odigos-io/odigos/frontend/services/profiles/flamegraph"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// persistedBucket is the size of fresh buckets, before downsampling.
+	persistedBucket = time.Minute
+	// persistenceMaintenanceInterval is how often pending buckets are flushed and downsampling/retention run.
+	persistenceMaintenanceInterval = time.Minute
+	// persistenceEvictBatch is how many of the oldest buckets are deleted at a time while over MaxBytes.
+	persistenceEvictBatch = 100
+)
+
+// PersistenceConfig is the resolved configuration of the on-disk profile storage.
+type PersistenceConfig struct {
+	Retention          time.Duration
+	DownsampleAfter    time.Duration
+	DownsampleInterval time.Duration
+	MaxBytes           int64
+}
+
+// PersistentProfileStore keeps aggregated profiles of all sources in SQLite, independent of the in-memory slots.
+// Incoming profiles are symbolized and aggregated in memory into one minute buckets, which are flushed periodically.
+// Older buckets are merged into DownsampleInterval buckets, and deleted after Retention or when MaxBytes is exceeded.
+type PersistentProfileStore struct {
+	db  *gorm.DB
+	cfg PersistenceConfig
+
+	mu      sync.Mutex
+	pending map[string]map[time.Time]flamegraph.StackCounts
+}
+
+func NewPersistentProfileStore(database db.Database, cfg PersistenceConfig) *PersistentProfileStore {
+	return &PersistentProfileStore{
+		db:      database.GetDB(),
+		cfg:     cfg,
+		pending: make(map[string]map[time.Time]flamegraph.StackCounts),
+	}
+}
+
+// Add aggregates an OTLP profiles chunk of a source into the current bucket.
+func (p *PersistentProfileStore) Add(sourceKey string, chunk []byte, now time.Time) {
+	samples := flamegraph.SamplesFromChunks([][]byte{chunk})
+	if len(samples) == 0 {
+		return
+	}
+	start := now.UTC().Truncate(persistedBucket)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	buckets, ok := p.pending[sourceKey]
+	if !ok {
+		buckets = make(map[time.Time]flamegraph.StackCounts)
+		p.pending[sourceKey] = buckets
+	}
+	stacks, ok := buckets[start]
+	if !ok {
+		stacks = flamegraph.StackCounts{}
+		buckets[start] = stacks
+	}
+	stacks.AddSamples(samples)
+}
+
+// Run flushes pending buckets and applies downsampling and retention until ctx is done,
+// then flushes what is left.
+func (p *PersistentProfileStore) Run(ctx context.Context) {
+	log := commonlogger.LoggerCompat().With("subsystem", "backend-profiling")
+	ticker := time.NewTicker(persistenceMaintenanceInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := p.flush(context.Background()); err != nil {
+				log.Warn("persist_profiles", "err", err)
+			}
+			return
+		case now := <-ticker.C:
+			if err := p.maintain(ctx, now); err != nil {
+				log.Warn("persist_profiles", "err", err)
+			}
+		}
+	}
+}
+
+func (p *PersistentProfileStore) maintain(ctx context.Context, now time.Time) error {
+	if err := p.flush(ctx); err != nil {
+		return err
+	}
+	if err := p.downsample(ctx, now); err != nil {
+		return err
+	}
+	return p.enforceRetention(ctx, now)
+}
+
+// flush merges the pending buckets into the stored ones.
+// If the transaction fails, the buckets are put back into pending so the next flush retries them.
+func (p *PersistentProfileStore) flush(ctx context.Context) error {
+	p.mu.Lock()
+	pending := p.pending
+	p.pending = make(map[string]map[time.Time]flamegraph.StackCounts)
+	p.mu.Unlock()
+
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for sourceKey, buckets := range pending {
+			for start, stacks := range buckets {
+				if err := mergeIntoBucket(tx, sourceKey, start, persistedBucket, stacks); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		p.restorePending(pending)
+	}
+	return err
+}
+
+// restorePending merges buckets which failed to persist back into the pending ones,
+// together with the samples that were recorded in the meantime.
+func (p *PersistentProfileStore) restorePending(failed map[string]map[time.Time]flamegraph.StackCounts) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for sourceKey, buckets := range failed {
+		current, ok := p.pending[sourceKey]
+		if !ok {
+			p.pending[sourceKey] = buckets
+			continue
+		}
+		for start, stacks := range buckets {
+			if existing, ok := current[start]; ok {
+				existing.Merge(stacks)
+			} else {
+				current[start] = stacks
+			}
+		}
+	}
+}
+
+// downsample merges the fresh buckets older than DownsampleAfter into DownsampleInterval buckets.
+// Only whole intervals are merged, so a downsampled bucket is never written to twice.
+func (p *PersistentProfileStore) downsample(ctx context.Context, now time.Time) error {
+	interval := p.cfg.DownsampleInterval
+	if interval <= persistedBucket {
+		return nil
+	}
+	cutoff := now.UTC().Add(-p.cfg.DownsampleAfter).Truncate(interval)
+
+	return p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var rows []db.ProfileBucket
+		if err := tx.Where("bucket_seconds < ? AND bucket_start < ?", int(interval.Seconds()), cutoff).Find(&rows).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+
+		type groupKey struct {
+			sourceKey string
+			start     time.Time
+		}
+		groups := make(map[groupKey]flamegraph.StackCounts)
+		for _, row := range rows {
+			stacks, err := flamegraph.UnmarshalStackCounts(row.Stacks)
+			if err != nil {
+				// a corrupted bucket is dropped with the rest of the rows below
+				continue
+			}
+			key := groupKey{sourceKey: row.SourceKey, start: row.BucketStart.UTC().Truncate(interval)}
+			if merged, ok := groups[key]; ok {
+				merged.Merge(stacks)
+			} else {
+				groups[key] = stacks
+			}
+		}
+
+		for _, row := range rows {
+			if err := tx.Delete(&row).Error; err != nil {
+				return err
+			}
+		}
+		for key, stacks := range groups {
+			if err := mergeIntoBucket(tx, key.sourceKey, key.start, interval, stacks); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// enforceRetention deletes buckets older than Retention, then the oldest buckets until the stored size is within MaxBytes.
+func (p *PersistentProfileStore) enforceRetention(ctx context.Context, now time.Time) error {
+	tx := p.db.WithContext(ctx)
+	if p.cfg.Retention > 0 {
+		if err := tx.Where("bucket_start < ?", now.UTC().Add(-p.cfg.Retention)).Delete(&db.ProfileBucket{}).Error; err != nil {
+			return err
+		}
+	}
+	if p.cfg.MaxBytes <= 0 {
+		return nil
+	}
+
+	var total int64
+	if err := tx.Model(&db.ProfileBucket{}).Select("COALESCE(SUM(bytes), 0)").Scan(&total).Error; err != nil {
+		return err
+	}
+	for total > p.cfg.MaxBytes {
+		var oldest []db.ProfileBucket
+		if err := tx.Select("source_key", "bucket_start", "bucket_seconds", "bytes").
+			Order("bucket_start").Limit(persistenceEvictBatch).Find(&oldest).Error; err != nil {
+			return err
+		}
+		if len(oldest) == 0 {
+			return nil
+		}
+		for _, row := range oldest {
+			if total <= p.cfg.MaxBytes {
+				break
+			}
+			if err := tx.Delete(&row).Error; err != nil {
+				return err
+			}
+			total -= row.Bytes
+		}
+	}
+	return nil
+}
+
+// StackCounts returns the merged profile of a source for all buckets overlapping [from, to],
+// including the ones not flushed yet. Downsampled buckets are included whole, so the
+// effective range may be widened up to DownsampleInterval on each side.
+func (p *PersistentProfileStore) StackCounts(ctx context.Context, sourceKey string, from, to time.Time) (flamegraph.StackCounts, error) {
+	from, to = from.UTC(), to.UTC()
+	out := flamegraph.StackCounts{}
+
+	earliest := from.Add(-max(p.cfg.DownsampleInterval, persistedBucket))
+	var rows []db.ProfileBucket
+	if err := p.db.WithContext(ctx).
+		Where("source_key = ? AND bucket_start > ? AND bucket_start <= ?", sourceKey, earliest, to).
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if !bucketOverlaps(row.BucketStart.UTC(), time.Duration(row.BucketSeconds)*time.Second, from, to) {
+			continue
+		}
+		stacks, err := flamegraph.UnmarshalStackCounts(row.Stacks)
+		if err != nil {
+			continue
+		}
+		out.Merge(stacks)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for start, stacks := range p.pending[sourceKey] {
+		if bucketOverlaps(start, persistedBucket, from, to) {
+			out.Merge(stacks)
+		}
+	}
+	return out, nil
+}
+
+func bucketOverlaps(start time.Time, length time.Duration, from, to time.Time) bool {
+	return start.Before(to.Add(time.Nanosecond)) && start.Add(length).After(from)
+}
+
+// mergeIntoBucket adds stacks to the stored bucket, creating it when missing. stacks may be modified.
+func mergeIntoBucket(tx *gorm.DB, sourceKey string, start time.Time, length time.Duration, stacks flamegraph.StackCounts) error {
+	seconds := int(length.Seconds())
+	var existing db.ProfileBucket
+	err := tx.Where("source_key = ? AND bucket_start = ? AND bucket_seconds = ?", sourceKey, start, seconds).Take(&existing).Error
+	switch {
+	case err == nil:
+		if stored, err := flamegraph.UnmarshalStackCounts(existing.Stacks); err == nil {
+			stacks.Merge(stored)
+		}
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return err
+	}
+
+	b, err := stacks.Marshal()
+	if err != nil {
+		return err
+	}
+	return tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(&db.ProfileBucket{
+		SourceKey:     sourceKey,
+		BucketStart:   start,
+		BucketSeconds: seconds,
+		Stacks:        b,
+		Bytes:         int64(len(b)),
+	}).Error
+}
@@ -0,0 +1,144 @@
+package profiles
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/odigos-io/odigos/frontend/services/db"
+	"github.com/odigos-io/odigos/frontend/services/profiles/flamegraph"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPersistentStore(t *testing.T, cfg PersistenceConfig) *PersistentProfileStore {
+	t.Helper()
+	database, err := db.NewSQLiteDB(filepath.Join(t.TempDir(), "profiles.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = database.Close() })
+	db.InitializeDatabaseSchema(database.GetDB())
+	return NewPersistentProfileStore(database, cfg)
+}
+
+func addPending(p *PersistentProfileStore, sourceKey string, at time.Time, value int64, stack ...string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	start := at.UTC().Truncate(persistedBucket)
+	if p.pending[sourceKey] == nil {
+		p.pending[sourceKey] = map[time.Time]flamegraph.StackCounts{}
+	}
+	if p.pending[sourceKey][start] == nil {
+		p.pending[sourceKey][start] = flamegraph.StackCounts{}
+	}
+	p.pending[sourceKey][start].AddSamples([]flamegraph.Sample{{Stack: stack, Value: value}})
+}
+
+func countBuckets(t *testing.T, p *PersistentProfileStore) int64 {
+	t.Helper()
+	var n int64
+	require.NoError(t, p.db.Model(&db.ProfileBucket{}).Count(&n).Error)
+	return n
+}
+
+func TestPersistentProfileStoreFlushMergesBuckets(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPersistentStore(t, PersistenceConfig{Retention: 24 * time.Hour, DownsampleAfter: time.Hour, DownsampleInterval: 10 * time.Minute})
+	now := time.Date(2026, 1, 2, 12, 0, 30, 0, time.UTC)
+
+	addPending(p, "default/Deployment/api", now, 5, "main", "handler")
+	require.NoError(t, p.flush(ctx))
+	addPending(p, "default/Deployment/api", now, 3, "main", "handler")
+	addPending(p, "default/Deployment/api", now, 2, "main", "gc")
+	require.NoError(t, p.flush(ctx))
+
+	require.EqualValues(t, 1, countBuckets(t, p))
+	stacks, err := p.StackCounts(ctx, "default/Deployment/api", now.Add(-time.Minute), now)
+	require.NoError(t, err)
+	require.EqualValues(t, 10, stacks.Total())
+
+	// unflushed data is visible as well
+	addPending(p, "default/Deployment/api", now, 4, "main")
+	stacks, err = p.StackCounts(ctx, "default/Deployment/api", now.Add(-time.Minute), now)
+	require.NoError(t, err)
+	require.EqualValues(t, 14, stacks.Total())
+
+	stacks, err = p.StackCounts(ctx, "default/Deployment/other", now.Add(-time.Minute), now)
+	require.NoError(t, err)
+	require.Zero(t, stacks.Total())
+}
+
+func TestPersistentProfileStoreFlushKeepsPendingOnError(t *testing.T) {
+	p := newTestPersistentStore(t, PersistenceConfig{Retention: 24 * time.Hour, DownsampleAfter: time.Hour, DownsampleInterval: 10 * time.Minute})
+	now := time.Date(2026, 1, 2, 12, 0, 30, 0, time.UTC)
+
+	addPending(p, "default/Deployment/api", now, 5, "main", "handler")
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Error(t, p.flush(canceled))
+	require.Zero(t, countBuckets(t, p))
+
+	// samples recorded after the failed flush are merged with the restored ones
+	addPending(p, "default/Deployment/api", now, 3, "main", "handler")
+	require.NoError(t, p.flush(context.Background()))
+	require.EqualValues(t, 1, countBuckets(t, p))
+	stacks, err := p.StackCounts(context.Background(), "default/Deployment/api", now.Add(-time.Minute), now)
+	require.NoError(t, err)
+	require.EqualValues(t, 8, stacks.Total())
+}
+
+func TestPersistentProfileStoreDownsample(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPersistentStore(t, PersistenceConfig{Retention: 24 * time.Hour, DownsampleAfter: time.Hour, DownsampleInterval: 10 * time.Minute})
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	// three minutes of an old interval, and one recent minute which is kept as is
+	old := now.Add(-2 * time.Hour)
+	for i := 0; i < 3; i++ {
+		addPending(p, "default/Deployment/api", old.Add(time.Duration(i)*time.Minute), 1, "main", "handler")
+	}
+	addPending(p, "default/Deployment/api", now.Add(-5*time.Minute), 1, "main", "handler")
+	require.NoError(t, p.flush(ctx))
+	require.EqualValues(t, 4, countBuckets(t, p))
+
+	require.NoError(t, p.downsample(ctx, now))
+	require.EqualValues(t, 2, countBuckets(t, p))
+
+	var downsampled db.ProfileBucket
+	require.NoError(t, p.db.Where("bucket_seconds = ?", 600).Take(&downsampled).Error)
+	require.True(t, downsampled.BucketStart.Equal(old.Truncate(10*time.Minute)))
+
+	// querying a single minute of the downsampled interval returns the whole bucket
+	stacks, err := p.StackCounts(ctx, "default/Deployment/api", old.Add(time.Minute), old.Add(2*time.Minute))
+	require.NoError(t, err)
+	require.EqualValues(t, 3, stacks.Total())
+
+	// downsampling again is a no-op
+	require.NoError(t, p.downsample(ctx, now))
+	require.EqualValues(t, 2, countBuckets(t, p))
+}
+
+func TestPersistentProfileStoreRetention(t *testing.T) {
+	ctx := context.Background()
+	p := newTestPersistentStore(t, PersistenceConfig{Retention: time.Hour, DownsampleAfter: time.Hour, DownsampleInterval: 10 * time.Minute})
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+
+	addPending(p, "default/Deployment/api", now.Add(-2*time.Hour), 1, "main")
+	addPending(p, "default/Deployment/api", now.Add(-3*time.Minute), 1, "main")
+	addPending(p, "default/Deployment/api", now.Add(-2*time.Minute), 1, "main")
+	addPending(p, "default/Deployment/api", now.Add(-1*time.Minute), 1, "main")
+	require.NoError(t, p.flush(ctx))
+
+	require.NoError(t, p.enforceRetention(ctx, now))
+	require.EqualValues(t, 3, countBuckets(t, p))
+
+	// cap the size to a single bucket, the oldest ones go first
+	var one db.ProfileBucket
+	require.NoError(t, p.db.Take(&one).Error)
+	p.cfg.MaxBytes = one.Bytes
+	require.NoError(t, p.enforceRetention(ctx, now))
+	require.EqualValues(t, 1, countBuckets(t, p))
+
+	var kept db.ProfileBucket
+	require.NoError(t, p.db.Take(&kept).Error)
+	require.True(t, kept.BucketStart.Equal(now.Add(-1*time.Minute)))
+}
@@ -3,6 +3,7 @@ package profiles
 import (
 	"context"
 	"errors"
+	"time"
 
 	pyrofb "github.com/grafana/pyroscope/pkg/og/structs/flamebearer"
 	"github.com/odigos-io/odigos/frontend/services/common"
@@ -113,6 +114,35 @@ func GetProfilingForSource(ctx context.Context, store common.ProfileStoreRef, na
 	return &GetProfilingOutput{Profile: buildPyroscopeProfileFromChunks(ctx, chunks)}, nil
 }
 
+// GetPersistedProfilingForSource returns the aggregated profile of a workload between from and to,
+// read from the on-disk profile storage rather than the in-memory slot.
+func GetPersistedProfilingForSource(ctx context.Context, persistent *PersistentProfileStore, namespace, kindStr, name string, from, to time.Time) (*GetProfilingOutput, error) {
+	id, err := SourceIDFromStrings(namespace, kindStr, name)
+	if err != nil {
+		return nil, err
+	}
+	if !from.Before(to) {
+		return nil, errors.New("profile time range start must be before its end")
+	}
+	stacks, err := persistent.StackCounts(ctx, SourceKeyFromSourceID(id), from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	const maxNodes = 2048
+	flamebearerProfile, functionNameTree := flamegraph.BuildFlamebearerFromStackCounts(stacks, maxNodes)
+	if flamebearerProfile == nil {
+		return &GetProfilingOutput{Profile: emptyFlamebearerProfile()}, nil
+	}
+	timeline := pyroscopeTimeline(int64(flamebearerProfile.Flamebearer.NumTicks), from.Unix())
+	symbols := flamegraph.SymbolStatsFromFunctionNameTree(functionNameTree)
+	adapted := flamegraph.AdaptPyroscopeFlamebearerProfile(flamebearerProfile, timeline, symbols)
+	if adapted.FlamebearerProfile.Metadata.Format == "" {
+		adapted.FlamebearerProfile.Metadata = pyroscopeMetadata()
+	}
+	return &GetProfilingOutput{Profile: adapted}, nil
+}
+
 func emptyFlamebearerProfile() flamegraph.FlamebearerProfile {
 	return flamegraph.FlamebearerProfile{
 		FlamebearerProfile: &pyrofb.FlamebearerProfile{
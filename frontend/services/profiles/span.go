@@ -0,0 +1,159 @@
+package profiles
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/odigos-io/odigos/frontend/services/common"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/pprofile/pprofileotlp"
+)
+
+// profilerContextLabelPrefix is the prefix the eBPF profiler puts on sample attributes read from the
+// process context of the sampled thread, e.g. Go pprof labels set by the application while a span is active.
+const profilerContextLabelPrefix = "process.context.label."
+
+var (
+	traceIDLabelKeys   = []string{"trace_id", "trace.id", "traceid"}
+	spanIDLabelKeys    = []string{"span_id", "span.id", "spanid"}
+	httpRouteLabelKeys = []string{"http.route", "http_route"}
+)
+
+// SpanFilter selects the profile samples taken while a span was active. Empty fields match everything.
+//
+// The span context of a sample comes either from the sample link (set by the profiler for agents
+// publishing their active span, e.g. through the APM integration), or from trace_id/span_id/http.route
+// labels the agent set on the sampled thread (e.g. Go pprof labels).
+type SpanFilter struct {
+	TraceID   string
+	SpanID    string
+	HTTPRoute string
+}
+
+func (f SpanFilter) empty() bool {
+	return f.TraceID == "" && f.SpanID == "" && f.HTTPRoute == ""
+}
+
+// GetSpanProfilingForSource returns the aggregated profile of the buffered samples of a workload
+// which were taken while the span (or any span of the endpoint) selected by the filter was active.
+func GetSpanProfilingForSource(ctx context.Context, store common.ProfileStoreRef, namespace, kindStr, name string, filter SpanFilter) (*GetProfilingOutput, error) {
+	id, err := SourceIDFromStrings(namespace, kindStr, name)
+	if err != nil {
+		return nil, err
+	}
+	if filter.empty() {
+		return nil, errors.New("one of trace id, span id or http route is required")
+	}
+	key := SourceKeyFromSourceID(id)
+	store.EnsureSlot(key)
+	chunks := filterChunkSamples(store.GetProfileData(key), filter)
+	if len(chunks) == 0 {
+		return &GetProfilingOutput{Profile: emptyFlamebearerProfile()}, nil
+	}
+	return &GetProfilingOutput{Profile: buildPyroscopeProfileFromChunks(ctx, chunks)}, nil
+}
+
+// filterChunkSamples removes the samples not matching the filter from the chunks.
+// Chunks left without samples are dropped.
+func filterChunkSamples(chunks [][]byte, filter SpanFilter) [][]byte {
+	out := make([][]byte, 0, len(chunks))
+	for _, chunk := range chunks {
+		req := pprofileotlp.NewExportRequest()
+		if err := req.UnmarshalProto(chunk); err != nil {
+			continue
+		}
+		profiles := req.Profiles()
+		dict := profiles.Dictionary()
+
+		kept := 0
+		rps := profiles.ResourceProfiles()
+		rps.RemoveIf(func(rp pprofile.ResourceProfiles) bool {
+			sps := rp.ScopeProfiles()
+			sps.RemoveIf(func(sp pprofile.ScopeProfiles) bool {
+				sp.Profiles().RemoveIf(func(p pprofile.Profile) bool {
+					p.Samples().RemoveIf(func(s pprofile.Sample) bool {
+						return !sampleMatches(dict, s, filter)
+					})
+					kept += p.Samples().Len()
+					return p.Samples().Len() == 0
+				})
+				return sp.Profiles().Len() == 0
+			})
+			return sps.Len() == 0
+		})
+		if kept == 0 {
+			continue
+		}
+
+		b, err := req.MarshalProto()
+		if err != nil {
+			continue
+		}
+		out = append(out, b)
+	}
+	return out
+}
+
+func sampleMatches(dict pprofile.ProfilesDictionary, s pprofile.Sample, filter SpanFilter) bool {
+	labels := sampleContextLabels(dict, s)
+
+	traceID, spanID := "", ""
+	// index 0 of the link table is the zero link, meaning the sample has no span
+	if idx := int(s.LinkIndex()); idx > 0 && idx < dict.LinkTable().Len() {
+		link := dict.LinkTable().At(idx)
+		if !link.TraceID().IsEmpty() {
+			traceID = link.TraceID().String()
+		}
+		if !link.SpanID().IsEmpty() {
+			spanID = link.SpanID().String()
+		}
+	}
+	if traceID == "" {
+		traceID = firstLabel(labels, traceIDLabelKeys)
+	}
+	if spanID == "" {
+		spanID = firstLabel(labels, spanIDLabelKeys)
+	}
+
+	if filter.TraceID != "" && !strings.EqualFold(traceID, filter.TraceID) {
+		return false
+	}
+	if filter.SpanID != "" && !strings.EqualFold(spanID, filter.SpanID) {
+		return false
+	}
+	if filter.HTTPRoute != "" && firstLabel(labels, httpRouteLabelKeys) != filter.HTTPRoute {
+		return false
+	}
+	return true
+}
+
+// sampleContextLabels returns the sample attributes, with the profiler context label prefix removed.
+func sampleContextLabels(dict pprofile.ProfilesDictionary, s pprofile.Sample) map[string]string {
+	attrTable := dict.AttributeTable()
+	strTable := dict.StringTable()
+	labels := make(map[string]string, s.AttributeIndices().Len())
+	for i := 0; i < s.AttributeIndices().Len(); i++ {
+		idx := int(s.AttributeIndices().At(i))
+		if idx < 0 || idx >= attrTable.Len() {
+			continue
+		}
+		attr := attrTable.At(idx)
+		keyIdx := int(attr.KeyStrindex())
+		if keyIdx < 0 || keyIdx >= strTable.Len() {
+			continue
+		}
+		key := strings.TrimPrefix(strTable.At(keyIdx), profilerContextLabelPrefix)
+		labels[key] = attr.Value().AsString()
+	}
+	return labels
+}
+
+func firstLabel(labels map[string]string, keys []string) string {
+	for _, key := range keys {
+		if v := labels[key]; v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,81 @@
+package profiles
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/pprofile"
+	"go.opentelemetry.io/collector/pdata/pprofile/pprofileotlp"
+)
+
+// spanTestChunk holds three samples: one linked to a span, one labeled with a span and route by
+// the agent, and one taken outside of any span.
+func spanTestChunk(t *testing.T) []byte {
+	t.Helper()
+	profiles := pprofile.NewProfiles()
+	dict := profiles.Dictionary()
+	dict.StringTable().Append("", profilerContextLabelPrefix+"span_id", profilerContextLabelPrefix+"http.route")
+
+	dict.LinkTable().AppendEmpty()
+	link := dict.LinkTable().AppendEmpty()
+	link.SetTraceID(pcommon.TraceID{1, 2, 3})
+	link.SetSpanID(pcommon.SpanID{0xaa, 0xbb})
+
+	dict.AttributeTable().AppendEmpty()
+	spanAttr := dict.AttributeTable().AppendEmpty()
+	spanAttr.SetKeyStrindex(1)
+	spanAttr.Value().SetStr("00000000000000cc")
+	routeAttr := dict.AttributeTable().AppendEmpty()
+	routeAttr.SetKeyStrindex(2)
+	routeAttr.Value().SetStr("/checkout")
+
+	p := profiles.ResourceProfiles().AppendEmpty().ScopeProfiles().AppendEmpty().Profiles().AppendEmpty()
+	linked := p.Samples().AppendEmpty()
+	linked.SetLinkIndex(1)
+	labeled := p.Samples().AppendEmpty()
+	labeled.AttributeIndices().Append(1, 2)
+	p.Samples().AppendEmpty()
+
+	b, err := pprofileotlp.NewExportRequestFromProfiles(profiles).MarshalProto()
+	require.NoError(t, err)
+	return b
+}
+
+func countSamples(t *testing.T, chunks [][]byte) int {
+	t.Helper()
+	n := 0
+	for _, chunk := range chunks {
+		req := pprofileotlp.NewExportRequest()
+		require.NoError(t, req.UnmarshalProto(chunk))
+		n += req.Profiles().SampleCount()
+	}
+	return n
+}
+
+func TestFilterChunkSamples(t *testing.T) {
+	chunks := [][]byte{spanTestChunk(t)}
+
+	tests := []struct {
+		name   string
+		filter SpanFilter
+		want   int
+	}{
+		{name: "span from link", filter: SpanFilter{SpanID: "aabb000000000000"}, want: 1},
+		{name: "trace from link", filter: SpanFilter{TraceID: "01020300000000000000000000000000"}, want: 1},
+		{name: "span from labels", filter: SpanFilter{SpanID: "00000000000000CC"}, want: 1},
+		{name: "route from labels", filter: SpanFilter{HTTPRoute: "/checkout"}, want: 1},
+		{name: "span and route", filter: SpanFilter{SpanID: "aabb000000000000", HTTPRoute: "/checkout"}, want: 0},
+		{name: "no match", filter: SpanFilter{TraceID: "ff"}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterChunkSamples(chunks, tt.filter)
+			if tt.want == 0 {
+				require.Empty(t, got)
+				return
+			}
+			require.Equal(t, tt.want, countSamples(t, got))
+		})
+	}
+}
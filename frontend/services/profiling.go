@@ -21,6 +21,8 @@ type ProfilingRuntimeConfig struct {
 	ReceiverOn      bool
 	StoreLimits     ProfilingStoreLimits
 	CleanupInterval time.Duration // ProfileStore TTL sweep period
+	// Persistence is nil unless profiling.ui.persistence is enabled.
+	Persistence *profiles.PersistenceConfig
 }
 
 func ResolveProfilingFromEffectiveConfig(ctx context.Context, c client.Client) (ProfilingRuntimeConfig, error) {
@@ -55,10 +57,34 @@ func ResolveProfilingFromEffectiveConfig(ctx context.Context, c client.Client) (
 		if ui.SlotTTLSeconds > 0 {
 			out.StoreLimits.SlotTTLSeconds = ui.SlotTTLSeconds
 		}
+		out.Persistence = profilingPersistenceConfig(ui.Persistence)
 	}
 	return out, nil
 }
 
+// profilingPersistenceConfig resolves profiling.ui.persistence, filling in defaults for unset fields.
+func profilingPersistenceConfig(cfg *common.ProfilingPersistenceConfiguration) *profiles.PersistenceConfig {
+	if cfg == nil || cfg.Enabled == nil || !*cfg.Enabled {
+		return nil
+	}
+	intOrDefault := func(v, def int) int {
+		if v > 0 {
+			return v
+		}
+		return def
+	}
+	out := &profiles.PersistenceConfig{
+		Retention:          time.Duration(intOrDefault(cfg.RetentionHours, profiles.DefaultPersistenceRetentionHours)) * time.Hour,
+		DownsampleAfter:    time.Duration(intOrDefault(cfg.DownsampleAfterMinutes, profiles.DefaultPersistenceDownsampleAfterMinutes)) * time.Minute,
+		DownsampleInterval: time.Duration(intOrDefault(cfg.DownsampleIntervalMinutes, profiles.DefaultPersistenceDownsampleIntervalMinutes)) * time.Minute,
+		MaxBytes:           cfg.MaxBytes,
+	}
+	if out.MaxBytes <= 0 {
+		out.MaxBytes = profiles.DefaultPersistenceMaxBytes
+	}
+	return out
+}
+
 // ProfilingEnabledFromOdigosConfig reports whether the UI should accept OTLP profiles for this config snapshot.
 func ProfilingEnabledFromOdigosConfig(cfg *common.OdigosConfiguration) bool {
 	return cfg != nil && cfg.ProfilingEnabled()
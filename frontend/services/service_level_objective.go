@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/frontend/graph/model"
+	"github.com/odigos-io/odigos/frontend/kube"
+	"github.com/odigos-io/odigos/k8sutils/pkg/env"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func GetServiceLevelObjectives(ctx context.Context, filter *model.WorkloadFilter) ([]*model.ServiceLevelObjective, error) {
+	odigosNs := env.GetCurrentNamespace()
+
+	list, err := kube.DefaultClient.OdigosClient.ServiceLevelObjectives(odigosNs).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service level objectives: %v", err)
+	}
+
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].Name < list.Items[j].Name
+	})
+
+	response := make([]*model.ServiceLevelObjective, 0, len(list.Items))
+	for i := range list.Items {
+		slo := &list.Items[i]
+		if !serviceLevelObjectiveMatchesFilter(slo, filter) {
+			continue
+		}
+		response = append(response, convertServiceLevelObjectiveToModel(slo))
+	}
+	return response, nil
+}
+
+func serviceLevelObjectiveMatchesFilter(slo *v1alpha1.ServiceLevelObjective, filter *model.WorkloadFilter) bool {
+	if filter == nil {
+		return true
+	}
+	workload := slo.Spec.Workload
+	if filter.Namespace != nil && *filter.Namespace != workload.Namespace {
+		return false
+	}
+	if filter.Kind != nil && string(*filter.Kind) != string(workload.Kind) {
+		return false
+	}
+	if filter.Name != nil && *filter.Name != workload.Name {
+		return false
+	}
+	return true
+}
+
+func convertServiceLevelObjectiveToModel(slo *v1alpha1.ServiceLevelObjective) *model.ServiceLevelObjective {
+	out := &model.ServiceLevelObjective{
+		Name:                    slo.Name,
+		Namespace:               slo.Spec.Workload.Namespace,
+		Kind:                    model.K8sResourceKind(slo.Spec.Workload.Kind),
+		WorkloadName:            slo.Spec.Workload.Name,
+		Type:                    model.ServiceLevelObjectiveType(slo.Spec.Type),
+		Target:                  slo.Spec.Target,
+		LatencyThresholdMs:      slo.Spec.LatencyThresholdMs,
+		KeepBudgetBurningTraces: slo.Spec.KeepBudgetBurningTraces,
+		Sli:                     slo.Status.SLI,
+		ErrorBudgetRemaining:    slo.Status.ErrorBudgetRemaining,
+		BurnRates:               make([]*model.ServiceLevelObjectiveBurnRate, 0, len(slo.Status.BurnRates)),
+		Conditions:              []*model.Condition{},
+	}
+	if slo.Spec.Operation != nil && slo.Spec.Operation.HttpServer != nil {
+		httpServer := slo.Spec.Operation.HttpServer
+		out.HTTPRoute = StringPtrIfNotEmpty(httpServer.Route)
+		out.HTTPRoutePrefix = StringPtrIfNotEmpty(httpServer.RoutePrefix)
+		out.HTTPMethod = StringPtrIfNotEmpty(httpServer.Method)
+	}
+	out.SliWindow = StringPtrIfNotEmpty(slo.Status.SLIWindow)
+	for _, burnRate := range slo.Status.BurnRates {
+		out.BurnRates = append(out.BurnRates, &model.ServiceLevelObjectiveBurnRate{
+			Window:   burnRate.Window,
+			BurnRate: burnRate.BurnRate,
+		})
+	}
+	if slo.Status.LastEvaluationTime != nil {
+		lastEvaluationTime := slo.Status.LastEvaluationTime.UTC().Format(time.RFC3339)
+		out.LastEvaluationTime = &lastEvaluationTime
+	}
+	out.Conditions = append(out.Conditions, ConvertConditions(slo.Status.Conditions)...)
+	return out
+}
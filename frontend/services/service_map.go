@@ -0,0 +1,111 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/odigos-io/odigos/frontend/graph/model"
+	collectormetrics "github.com/odigos-io/odigos/frontend/services/collector_metrics"
+)
+
+const (
+	// defaultServiceMapWindow is used when getServiceMap is called without a time range.
+	defaultServiceMapWindow = 5 * time.Minute
+
+	defaultEdgeErrorRateWarning     = 0.01
+	defaultEdgeErrorRateCritical    = 0.05
+	defaultEdgeLatencyP95WarningMs  = 500
+	defaultEdgeLatencyP95CriticalMs = 2000
+)
+
+// ServiceMapTimeWindow resolves the time window of the service map edge metrics.
+func ServiceMapTimeWindow(timeRange *model.ServiceMapTimeRange, now time.Time) (time.Time, time.Time, error) {
+	if timeRange == nil {
+		return now.Add(-defaultServiceMapWindow), now, nil
+	}
+
+	from, err := time.Parse(time.RFC3339, timeRange.From)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid time range start %q: %w", timeRange.From, err)
+	}
+
+	to := now
+	if timeRange.To != nil && *timeRange.To != "" {
+		to, err = time.Parse(time.RFC3339, *timeRange.To)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid time range end %q: %w", *timeRange.To, err)
+		}
+	}
+
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("time range start %s must be before its end %s", timeRange.From, to.Format(time.RFC3339))
+	}
+
+	return from, to, nil
+}
+
+// ServiceMapHealthThresholds merges the user provided thresholds with the defaults.
+func ServiceMapHealthThresholds(input *model.ServiceMapHealthThresholdsInput) *model.ServiceMapHealthThresholds {
+	thresholds := &model.ServiceMapHealthThresholds{
+		ErrorRateWarning:     defaultEdgeErrorRateWarning,
+		ErrorRateCritical:    defaultEdgeErrorRateCritical,
+		LatencyP95WarningMs:  defaultEdgeLatencyP95WarningMs,
+		LatencyP95CriticalMs: defaultEdgeLatencyP95CriticalMs,
+	}
+	if input == nil {
+		return thresholds
+	}
+
+	if input.ErrorRateWarning != nil {
+		thresholds.ErrorRateWarning = *input.ErrorRateWarning
+	}
+	if input.ErrorRateCritical != nil {
+		thresholds.ErrorRateCritical = *input.ErrorRateCritical
+	}
+	if input.LatencyP95WarningMs != nil {
+		thresholds.LatencyP95WarningMs = *input.LatencyP95WarningMs
+	}
+	if input.LatencyP95CriticalMs != nil {
+		thresholds.LatencyP95CriticalMs = *input.LatencyP95CriticalMs
+	}
+	return thresholds
+}
+
+// EdgeMetricsToModel calculates the metrics of an edge over the given time window.
+func EdgeMetricsToModel(edge collectormetrics.ServiceGraphEdge, from, to time.Time, thresholds *model.ServiceMapHealthThresholds) *model.ServiceMapEdgeMetrics {
+	stats, ok := edge.StatsInWindow(from, to)
+	if !ok || stats.Requests == 0 {
+		return &model.ServiceMapEdgeMetrics{Health: model.ServiceMapEdgeHealthUnknown}
+	}
+
+	metrics := &model.ServiceMapEdgeMetrics{
+		Requests:    int(stats.Requests),
+		RequestRate: stats.RequestRate,
+		ErrorRate:   stats.ErrorRate,
+	}
+	if stats.HasLatency {
+		p50, p95, p99 := stats.LatencyP50*1000, stats.LatencyP95*1000, stats.LatencyP99*1000
+		metrics.LatencyP50Ms = &p50
+		metrics.LatencyP95Ms = &p95
+		metrics.LatencyP99Ms = &p99
+	}
+	metrics.Health = edgeHealth(metrics, thresholds)
+
+	return metrics
+}
+
+func edgeHealth(metrics *model.ServiceMapEdgeMetrics, thresholds *model.ServiceMapHealthThresholds) model.ServiceMapEdgeHealth {
+	p95 := 0.0
+	if metrics.LatencyP95Ms != nil {
+		p95 = *metrics.LatencyP95Ms
+	}
+
+	switch {
+	case metrics.ErrorRate >= thresholds.ErrorRateCritical || p95 >= thresholds.LatencyP95CriticalMs:
+		return model.ServiceMapEdgeHealthCritical
+	case metrics.ErrorRate >= thresholds.ErrorRateWarning || p95 >= thresholds.LatencyP95WarningMs:
+		return model.ServiceMapEdgeHealthWarning
+	default:
+		return model.ServiceMapEdgeHealthHealthy
+	}
+}
@@ -0,0 +1,201 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/odigos-io/odigos/frontend/graph/model"
+	collectormetrics "github.com/odigos-io/odigos/frontend/services/collector_metrics"
+)
+
+const (
+	// relative change in the request rate or p95 latency that is considered significant.
+	significantRequestRateChange = 0.5
+	significantLatencyP95Change  = 0.5
+	// absolute change in the error rate (fraction of failed requests) that is considered significant.
+	significantErrorRateChange = 0.05
+)
+
+// ServiceMapDiff compares the service graph edges between a baseline and a current time window,
+// and returns the edges which appeared, disappeared or changed significantly.
+func ServiceMapDiff(allEdges ServiceGraphEdges, baselineFrom, baselineTo, currentFrom, currentTo time.Time) *model.ServiceMapDiff {
+	thresholds := ServiceMapHealthThresholds(nil)
+	diffs := make([]*model.ServiceMapEdgeDiff, 0)
+
+	for fromKey, targets := range allEdges {
+		for toKey, edge := range targets {
+			baseline := EdgeMetricsToModel(edge, baselineFrom, baselineTo, thresholds)
+			current := EdgeMetricsToModel(edge, currentFrom, currentTo, thresholds)
+
+			var change model.ServiceMapEdgeChange
+			var details []string
+			switch {
+			case baseline.Requests == 0 && current.Requests == 0:
+				continue
+			case baseline.Requests == 0:
+				change = model.ServiceMapEdgeChangeAdded
+				details = []string{"new edge, " + formatEdgeMetrics(current)}
+			case current.Requests == 0:
+				change = model.ServiceMapEdgeChangeRemoved
+				details = []string{"no requests, was " + formatEdgeMetrics(baseline)}
+			default:
+				details = edgeMetricsChanges(baseline, current)
+				if len(details) == 0 {
+					continue
+				}
+				change = model.ServiceMapEdgeChangeChanged
+			}
+
+			diffs = append(diffs, &model.ServiceMapEdgeDiff{
+				FromNodeID:      fromKey,
+				FromServiceName: BaseServiceName(fromKey),
+				ToNodeID:        toKey,
+				ToServiceName:   BaseServiceName(toKey),
+				IsVirtual:       edge.ToNodeIsVirtual,
+				Change:          change,
+				Details:         details,
+				Baseline:        baseline,
+				Current:         current,
+			})
+		}
+	}
+
+	changeOrder := map[model.ServiceMapEdgeChange]int{
+		model.ServiceMapEdgeChangeAdded:   0,
+		model.ServiceMapEdgeChangeRemoved: 1,
+		model.ServiceMapEdgeChangeChanged: 2,
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		if changeOrder[diffs[i].Change] != changeOrder[diffs[j].Change] {
+			return changeOrder[diffs[i].Change] < changeOrder[diffs[j].Change]
+		}
+		if diffs[i].FromNodeID != diffs[j].FromNodeID {
+			return diffs[i].FromNodeID < diffs[j].FromNodeID
+		}
+		return diffs[i].ToNodeID < diffs[j].ToNodeID
+	})
+
+	return &model.ServiceMapDiff{
+		BaselineFrom: baselineFrom.Format(time.RFC3339),
+		BaselineTo:   baselineTo.Format(time.RFC3339),
+		CurrentFrom:  currentFrom.Format(time.RFC3339),
+		CurrentTo:    currentTo.Format(time.RFC3339),
+		Edges:        diffs,
+	}
+}
+
+// edgeMetricsChanges describes the significant changes between the baseline and current metrics of an edge.
+func edgeMetricsChanges(baseline, current *model.ServiceMapEdgeMetrics) []string {
+	var changes []string
+
+	if relativeChange(baseline.RequestRate, current.RequestRate) >= significantRequestRateChange {
+		changes = append(changes, fmt.Sprintf("request rate %.2f/s -> %.2f/s", baseline.RequestRate, current.RequestRate))
+	}
+	if math.Abs(current.ErrorRate-baseline.ErrorRate) >= significantErrorRateChange {
+		changes = append(changes, fmt.Sprintf("error rate %.1f%% -> %.1f%%", baseline.ErrorRate*100, current.ErrorRate*100))
+	}
+	if baseline.LatencyP95Ms != nil && current.LatencyP95Ms != nil &&
+		relativeChange(*baseline.LatencyP95Ms, *current.LatencyP95Ms) >= significantLatencyP95Change {
+		changes = append(changes, fmt.Sprintf("p95 latency %.0fms -> %.0fms", *baseline.LatencyP95Ms, *current.LatencyP95Ms))
+	}
+
+	return changes
+}
+
+func relativeChange(baseline, current float64) float64 {
+	if baseline == 0 {
+		if current == 0 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	return math.Abs(current-baseline) / baseline
+}
+
+func formatEdgeMetrics(metrics *model.ServiceMapEdgeMetrics) string {
+	parts := []string{
+		fmt.Sprintf("%.2f req/s", metrics.RequestRate),
+		fmt.Sprintf("%.1f%% errors", metrics.ErrorRate*100),
+	}
+	if metrics.LatencyP95Ms != nil {
+		parts = append(parts, fmt.Sprintf("p95 %.0fms", *metrics.LatencyP95Ms))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ServiceMapDiffToText renders the diff for the odigos describe command.
+func ServiceMapDiffToText(diff *model.ServiceMapDiff) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Baseline: %s - %s\n", diff.BaselineFrom, diff.BaselineTo)
+	fmt.Fprintf(&sb, "Current:  %s - %s\n", diff.CurrentFrom, diff.CurrentTo)
+
+	if len(diff.Edges) == 0 {
+		sb.WriteString("\nNo significant changes in the service map\n")
+		return sb.String()
+	}
+
+	var lastChange model.ServiceMapEdgeChange
+	for _, edge := range diff.Edges {
+		if edge.Change != lastChange {
+			fmt.Fprintf(&sb, "\n%s edges:\n", edge.Change)
+			lastChange = edge.Change
+		}
+		target := edge.ToNodeID
+		if edge.IsVirtual {
+			target += " (virtual)"
+		}
+		fmt.Fprintf(&sb, "  %s -> %s: %s\n", edge.FromNodeID, target, strings.Join(edge.Details, "; "))
+	}
+	return sb.String()
+}
+
+// DescribeServiceMapDiff serves the service map diff for the odigos describe command.
+// The windows are given as RFC3339 query params: baselineFrom, baselineTo, from and to.
+// baselineFrom is required, the rest default to now, and "from" defaults to the last 5 minutes.
+func DescribeServiceMapDiff(c *gin.Context, metricsConsumer *collectormetrics.OdigosMetricsConsumer) {
+	if metricsConsumer == nil {
+		c.JSON(500, gin.H{
+			"message": "metrics consumer not initialized",
+		})
+		return
+	}
+
+	baseline := model.ServiceMapTimeRange{From: c.Query("baselineFrom")}
+	if to := c.Query("baselineTo"); to != "" {
+		baseline.To = &to
+	}
+	var current *model.ServiceMapTimeRange
+	if from := c.Query("from"); from != "" {
+		current = &model.ServiceMapTimeRange{From: from}
+		if to := c.Query("to"); to != "" {
+			current.To = &to
+		}
+	}
+
+	now := time.Now()
+	baselineFrom, baselineTo, err := ServiceMapTimeWindow(&baseline, now)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
+	currentFrom, currentTo, err := ServiceMapTimeWindow(current, now)
+	if err != nil {
+		c.JSON(400, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
+
+	diff := ServiceMapDiff(metricsConsumer.GetServiceGraphEdges(), baselineFrom, baselineTo, currentFrom, currentTo)
+	if c.GetHeader("Accept") == "application/json" {
+		c.JSON(200, diff)
+	} else {
+		c.String(200, ServiceMapDiffToText(diff))
+	}
+}
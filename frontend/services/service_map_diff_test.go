@@ -0,0 +1,59 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/odigos-io/odigos/frontend/graph/model"
+	collectormetrics "github.com/odigos-io/odigos/frontend/services/collector_metrics"
+	"github.com/stretchr/testify/require"
+)
+
+// edgeWithRequests builds an edge with a sample per minute, with the given number of requests and failed requests in each minute.
+func edgeWithRequests(start time.Time, requestsPerMinute, failedPerMinute []int64) collectormetrics.ServiceGraphEdge {
+	edge := collectormetrics.ServiceGraphEdge{}
+	var requests, failed int64
+	for i := range requestsPerMinute {
+		requests += requestsPerMinute[i]
+		failed += failedPerMinute[i]
+		edge.Samples = append(edge.Samples, collectormetrics.ServiceGraphEdgeSample{
+			Timestamp:      start.Add(time.Duration(i) * time.Minute),
+			Requests:       requests,
+			FailedRequests: failed,
+		})
+	}
+	return edge
+}
+
+func TestServiceMapDiff(t *testing.T) {
+	start := time.Date(2025, 6, 24, 16, 0, 0, 0, time.UTC)
+	baselineFrom, baselineTo := start, start.Add(2*time.Minute)
+	currentFrom, currentTo := start.Add(2*time.Minute), start.Add(4*time.Minute)
+
+	edges := ServiceGraphEdges{
+		"frontend": {
+			// stable edge, not part of the diff
+			"coupon": edgeWithRequests(start, []int64{0, 60, 60, 60, 60}, []int64{0, 0, 0, 0, 0}),
+			// starts failing after the deploy
+			"currency": edgeWithRequests(start, []int64{0, 60, 60, 60, 60}, []int64{0, 0, 0, 30, 30}),
+			// new dependency
+			"postgres": edgeWithRequests(start, []int64{0, 0, 0, 60, 60}, []int64{0, 0, 0, 0, 0}),
+			// no longer called
+			"inventory": edgeWithRequests(start, []int64{0, 60, 60, 0, 0}, []int64{0, 0, 0, 0, 0}),
+		},
+	}
+
+	diff := ServiceMapDiff(edges, baselineFrom, baselineTo, currentFrom, currentTo)
+	require.Len(t, diff.Edges, 3)
+
+	require.Equal(t, "postgres", diff.Edges[0].ToNodeID)
+	require.Equal(t, model.ServiceMapEdgeChangeAdded, diff.Edges[0].Change)
+
+	require.Equal(t, "inventory", diff.Edges[1].ToNodeID)
+	require.Equal(t, model.ServiceMapEdgeChangeRemoved, diff.Edges[1].Change)
+
+	require.Equal(t, "currency", diff.Edges[2].ToNodeID)
+	require.Equal(t, model.ServiceMapEdgeChangeChanged, diff.Edges[2].Change)
+	require.Equal(t, []string{"error rate 0.0% -> 50.0%"}, diff.Edges[2].Details)
+	require.Equal(t, model.ServiceMapEdgeHealthCritical, diff.Edges[2].Current.Health)
+}
@@ -0,0 +1,307 @@
+package services
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	collectormetrics "github.com/odigos-io/odigos/frontend/services/collector_metrics"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	ServiceMapExportFormatDot       = "dot"
+	ServiceMapExportFormatMermaid   = "mermaid"
+	ServiceMapExportFormatBackstage = "backstage"
+
+	// defaultBackstageOwner is used for the generated entities when no owner is given,
+	// backstage requires an owner for components and apis.
+	defaultBackstageOwner = "unknown"
+)
+
+// serviceDependencyGraph is the service map collapsed to base service names,
+// which is the granularity the exported formats are consumed at.
+type serviceDependencyGraph struct {
+	// sorted service names
+	nodes []string
+	// services which are not instrumented, e.g. databases and external services
+	virtual map[string]bool
+	// client → server → number of requests
+	edges map[string]map[string]int64
+}
+
+func newServiceDependencyGraph(allEdges ServiceGraphEdges) *serviceDependencyGraph {
+	g := &serviceDependencyGraph{
+		virtual: make(map[string]bool),
+		edges:   make(map[string]map[string]int64),
+	}
+
+	nodes := make(map[string]struct{})
+	instrumented := make(map[string]bool)
+	for fromKey, targets := range allEdges {
+		from := BaseServiceName(fromKey)
+		nodes[from] = struct{}{}
+		// a client node is always instrumented, as the edge is inferred from its spans
+		instrumented[from] = true
+		for toKey, edge := range targets {
+			to := BaseServiceName(toKey)
+			nodes[to] = struct{}{}
+			if !edge.ToNodeIsVirtual {
+				instrumented[to] = true
+			}
+			if _, ok := g.edges[from]; !ok {
+				g.edges[from] = make(map[string]int64)
+			}
+			g.edges[from][to] += edge.RequestCount
+		}
+	}
+
+	for node := range nodes {
+		g.nodes = append(g.nodes, node)
+		if !instrumented[node] {
+			g.virtual[node] = true
+		}
+	}
+	sort.Strings(g.nodes)
+
+	return g
+}
+
+func (g *serviceDependencyGraph) targets(from string) []string {
+	targets := make([]string, 0, len(g.edges[from]))
+	for to := range g.edges[from] {
+		targets = append(targets, to)
+	}
+	sort.Strings(targets)
+	return targets
+}
+
+// ExportServiceMap renders the service map in the given format.
+// owner is only used for the backstage format.
+func ExportServiceMap(allEdges ServiceGraphEdges, format string, owner string) (string, error) {
+	g := newServiceDependencyGraph(allEdges)
+	switch format {
+	case ServiceMapExportFormatDot:
+		return g.toDot(), nil
+	case ServiceMapExportFormatMermaid:
+		return g.toMermaid(), nil
+	case ServiceMapExportFormatBackstage:
+		if owner == "" {
+			owner = defaultBackstageOwner
+		}
+		return g.toBackstage(owner)
+	default:
+		return "", fmt.Errorf("unsupported service map export format %q, supported formats: %s, %s, %s",
+			format, ServiceMapExportFormatDot, ServiceMapExportFormatMermaid, ServiceMapExportFormatBackstage)
+	}
+}
+
+func (g *serviceDependencyGraph) toDot() string {
+	var sb strings.Builder
+	sb.WriteString("digraph odigos_service_map {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	for _, node := range g.nodes {
+		if g.virtual[node] {
+			fmt.Fprintf(&sb, "  %q [shape=cylinder, style=dashed];\n", node)
+		} else {
+			fmt.Fprintf(&sb, "  %q [shape=box];\n", node)
+		}
+	}
+	for _, from := range g.nodes {
+		for _, to := range g.targets(from) {
+			fmt.Fprintf(&sb, "  %q -> %q [label=\"%d\"];\n", from, to, g.edges[from][to])
+		}
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+func (g *serviceDependencyGraph) toMermaid() string {
+	// service names can contain characters mermaid doesn't allow in ids, so nodes get generated ids.
+	ids := make(map[string]string, len(g.nodes))
+	var sb strings.Builder
+	sb.WriteString("graph LR\n")
+	for i, node := range g.nodes {
+		ids[node] = fmt.Sprintf("n%d", i)
+		label := strings.ReplaceAll(node, `"`, "#quot;")
+		if g.virtual[node] {
+			fmt.Fprintf(&sb, "  %s[(\"%s\")]\n", ids[node], label)
+		} else {
+			fmt.Fprintf(&sb, "  %s[\"%s\"]\n", ids[node], label)
+		}
+	}
+	for _, from := range g.nodes {
+		for _, to := range g.targets(from) {
+			fmt.Fprintf(&sb, "  %s -->|%d| %s\n", ids[from], g.edges[from][to], ids[to])
+		}
+	}
+	return sb.String()
+}
+
+var invalidBackstageNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// backstageEntityName converts a service name to a valid backstage entity name:
+// up to 63 characters of [A-Za-z0-9._-], starting and ending with an alphanumeric character.
+func backstageEntityName(serviceName string) string {
+	name := invalidBackstageNameChars.ReplaceAllString(serviceName, "-")
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return strings.Trim(name, "._-")
+}
+
+// backstageEntityNames assigns a unique backstage entity name to each of the nodes.
+// Names which are empty after sanitizing, or which are shared by several nodes, are suffixed
+// with a hash of each node's own service name, so they do not depend on the order of the nodes
+// and stay stable between exports.
+func backstageEntityNames(nodes []string) map[string]string {
+	groups := make(map[string][]string, len(nodes))
+	for _, node := range nodes {
+		name := backstageEntityName(node)
+		groups[name] = append(groups[name], node)
+	}
+
+	names := make(map[string]string, len(nodes))
+	used := make(map[string]bool, len(nodes))
+	var hashed []string
+	for name, members := range groups {
+		if name != "" && len(members) == 1 {
+			names[members[0]] = name
+			used[name] = true
+			continue
+		}
+		hashed = append(hashed, members...)
+	}
+
+	// hash suffixes practically never collide, the sort keeps the rare fallback deterministic
+	sort.Strings(hashed)
+	for _, node := range hashed {
+		sanitized := backstageEntityName(node)
+		name := backstageNameWithHash(sanitized, node)
+		for i := 2; used[name]; i++ {
+			name = backstageNameWithHash(sanitized, fmt.Sprintf("%s-%d", node, i))
+		}
+		names[node] = name
+		used[name] = true
+	}
+	return names
+}
+
+func backstageNameWithHash(name string, serviceName string) string {
+	h := fnv.New32a()
+	h.Write([]byte(serviceName))
+	suffix := fmt.Sprintf("%08x", h.Sum32())
+	if name == "" {
+		return "service-" + suffix
+	}
+	if maxLen := 63 - len(suffix) - 1; len(name) > maxLen {
+		name = strings.TrimRight(name[:maxLen], "._-")
+	}
+	return name + "-" + suffix
+}
+
+type backstageEntity struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Metadata   backstageMetadata `json:"metadata"`
+	Spec       backstageSpec     `json:"spec"`
+}
+
+type backstageMetadata struct {
+	Name        string            `json:"name"`
+	Title       string            `json:"title,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type backstageSpec struct {
+	Type         string   `json:"type"`
+	Lifecycle    string   `json:"lifecycle"`
+	Owner        string   `json:"owner"`
+	Definition   string   `json:"definition,omitempty"`
+	DependsOn    []string `json:"dependsOn,omitempty"`
+	ConsumesApis []string `json:"consumesApis,omitempty"`
+}
+
+// toBackstage renders the graph as backstage catalog entities, in a multi document yaml.
+// Instrumented services become Components, and virtual nodes (databases, external services) become APIs,
+// as odigos only observes the interface they expose to the instrumented services.
+func (g *serviceDependencyGraph) toBackstage(owner string) (string, error) {
+	names := backstageEntityNames(g.nodes)
+	docs := make([]string, 0, len(g.nodes))
+	for _, node := range g.nodes {
+		entity := backstageEntity{
+			APIVersion: "backstage.io/v1alpha1",
+			Metadata: backstageMetadata{
+				Name:  names[node],
+				Title: node,
+				Annotations: map[string]string{
+					"odigos.io/service-name": node,
+				},
+			},
+			Spec: backstageSpec{
+				Lifecycle: "production",
+				Owner:     owner,
+			},
+		}
+
+		if g.virtual[node] {
+			entity.Kind = "API"
+			entity.Metadata.Description = "Dependency inferred by Odigos from the traffic of instrumented services"
+			entity.Spec.Type = "external"
+			entity.Spec.Definition = "Not instrumented, inferred by Odigos from client spans"
+		} else {
+			entity.Kind = "Component"
+			entity.Metadata.Description = "Service instrumented by Odigos"
+			entity.Spec.Type = "service"
+			for _, to := range g.targets(node) {
+				if g.virtual[to] {
+					ref := "api:" + names[to]
+					entity.Spec.DependsOn = append(entity.Spec.DependsOn, ref)
+					entity.Spec.ConsumesApis = append(entity.Spec.ConsumesApis, ref)
+				} else {
+					entity.Spec.DependsOn = append(entity.Spec.DependsOn, "component:"+names[to])
+				}
+			}
+		}
+
+		doc, err := yaml.Marshal(entity)
+		if err != nil {
+			return "", err
+		}
+		docs = append(docs, string(doc))
+	}
+	return "---\n" + strings.Join(docs, "---\n"), nil
+}
+
+// ExportServiceMapHandler serves the service map in the format given by the "format" query param
+// (dot, mermaid or backstage). The backstage entities owner can be set with the "owner" query param.
+func ExportServiceMapHandler(c *gin.Context, metricsConsumer *collectormetrics.OdigosMetricsConsumer) {
+	if metricsConsumer == nil {
+		c.JSON(500, gin.H{
+			"message": "metrics consumer not initialized",
+		})
+		return
+	}
+
+	format := c.DefaultQuery("format", ServiceMapExportFormatDot)
+	exported, err := ExportServiceMap(metricsConsumer.GetServiceGraphEdges(), format, c.Query("owner"))
+	if err != nil {
+		c.JSON(400, gin.H{
+			"message": err.Error(),
+		})
+		return
+	}
+
+	contentType := "text/plain; charset=utf-8"
+	switch format {
+	case ServiceMapExportFormatDot:
+		contentType = "text/vnd.graphviz; charset=utf-8"
+	case ServiceMapExportFormatBackstage:
+		contentType = "application/yaml; charset=utf-8"
+	}
+	c.Data(200, contentType, []byte(exported))
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"strings"
+	"testing"
+
+	collectormetrics "github.com/odigos-io/odigos/frontend/services/collector_metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func exportTestEdges() ServiceGraphEdges {
+	return ServiceGraphEdges{
+		"frontend|default": {
+			"coupon|default": collectormetrics.ServiceGraphEdge{RequestCount: 10},
+			"coupon|staging": collectormetrics.ServiceGraphEdge{RequestCount: 5},
+			"postgres":       collectormetrics.ServiceGraphEdge{RequestCount: 7, ToNodeIsVirtual: true},
+		},
+	}
+}
+
+func TestExportServiceMapDot(t *testing.T) {
+	out, err := ExportServiceMap(exportTestEdges(), ServiceMapExportFormatDot, "")
+	require.NoError(t, err)
+	require.Equal(t, `digraph odigos_service_map {
+  rankdir=LR;
+  "coupon" [shape=box];
+  "frontend" [shape=box];
+  "postgres" [shape=cylinder, style=dashed];
+  "frontend" -> "coupon" [label="15"];
+  "frontend" -> "postgres" [label="7"];
+}
+`, out)
+}
+
+func TestExportServiceMapMermaid(t *testing.T) {
+	out, err := ExportServiceMap(exportTestEdges(), ServiceMapExportFormatMermaid, "")
+	require.NoError(t, err)
+	require.Equal(t, `graph LR
+  n0["coupon"]
+  n1["frontend"]
+  n2[("postgres")]
+  n1 -->|15| n0
+  n1 -->|7| n2
+`, out)
+}
+
+func TestExportServiceMapBackstage(t *testing.T) {
+	out, err := ExportServiceMap(exportTestEdges(), ServiceMapExportFormatBackstage, "team-a")
+	require.NoError(t, err)
+
+	docs := strings.Split(strings.TrimPrefix(out, "---\n"), "---\n")
+	require.Len(t, docs, 3)
+	require.Contains(t, docs[0], "kind: Component\n")
+	require.Contains(t, docs[0], "name: coupon\n")
+	require.Contains(t, docs[1], "name: frontend\n")
+	require.Contains(t, docs[1], "owner: team-a\n")
+	require.Contains(t, docs[1], "dependsOn:\n  - component:coupon\n  - api:postgres\n")
+	require.Contains(t, docs[1], "consumesApis:\n  - api:postgres\n")
+	require.Contains(t, docs[2], "kind: API\n")
+}
+
+func TestExportServiceMapUnsupportedFormat(t *testing.T) {
+	_, err := ExportServiceMap(exportTestEdges(), "png", "")
+	require.Error(t, err)
+}
+
+func TestBackstageEntityName(t *testing.T) {
+	require.Equal(t, "my-service", backstageEntityName("my service"))
+	require.Equal(t, "db.example.com-5432", backstageEntityName("db.example.com:5432"))
+	require.Len(t, backstageEntityName(strings.Repeat("a", 100)), 63)
+}
+
+func TestBackstageEntityNames(t *testing.T) {
+	names := backstageEntityNames([]string{"***", "my service", "my-service", "my_service", "???"})
+
+	require.Regexp(t, `^my-service-[0-9a-f]{8}$`, names["my service"])
+	require.Regexp(t, `^my-service-[0-9a-f]{8}$`, names["my-service"])
+	require.Equal(t, "my_service", names["my_service"])
+	require.Regexp(t, `^service-[0-9a-f]{8}$`, names["***"])
+
+	unique := make(map[string]bool)
+	for _, name := range names {
+		require.NotEmpty(t, name)
+		require.LessOrEqual(t, len(name), 63)
+		unique[name] = true
+	}
+	require.Len(t, unique, 5)
+
+	// names are derived from the service names, so they are stable between exports
+	require.Equal(t, names, backstageEntityNames([]string{"???", "my_service", "my-service", "my service", "***"}))
+
+	// adding a node to a collision group does not rename the existing members
+	grown := backstageEntityNames([]string{"***", "my service", "my-service", "my_service", "???", "my/service", "frontend"})
+	for node, name := range names {
+		require.Equal(t, name, grown[node])
+	}
+	require.Equal(t, "frontend", grown["frontend"])
+
+	long := backstageEntityNames([]string{strings.Repeat("a", 100), strings.Repeat("a", 101)})
+	require.Len(t, long[strings.Repeat("a", 101)], 63)
+}
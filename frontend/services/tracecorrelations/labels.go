@@ -13,6 +13,8 @@ import (
 const (
 	inputAttributePrefix  = "input."
 	outputAttributePrefix = "output."
+
+	httpRouteAttribute = "http.route"
 )
 
 var (
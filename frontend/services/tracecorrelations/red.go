@@ -0,0 +1,128 @@
+package tracecorrelations
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	prommodel "github.com/prometheus/common/model"
+
+	"github.com/odigos-io/odigos/k8sutils/pkg/metricsstore"
+)
+
+// Selectors of the per-connection RED metrics of the serviceio connector. The latency histogram
+// may be ingested with a unit suffix, depending on the metrics store OTLP naming.
+const (
+	errorsMetricSelector         = `{__name__=~"traces_service_io_connection_errors_total(_total)?"}`
+	latencyBucketMetricSelector  = `{__name__=~"traces_service_io_connection_duration(_milliseconds)?_bucket"}`
+	latencySumMetricSelector     = `{__name__=~"traces_service_io_connection_duration(_milliseconds)?_sum"}`
+	histogramBucketUpperBoundKey = prommodel.LabelName("le")
+)
+
+// redVectors holds the increase over the time range of the error counter and latency histogram series.
+type redVectors struct {
+	errors        prommodel.Vector
+	latencyBucket prommodel.Vector
+	latencySum    prommodel.Vector
+}
+
+func queryRedMetricsInRange(ctx context.Context, api v1.API, start, end time.Time) (redVectors, error) {
+	var out redVectors
+	rangeStr := metricsstore.PromDuration(end.Sub(start))
+	for _, q := range []struct {
+		selector string
+		dst      *prommodel.Vector
+	}{
+		{errorsMetricSelector, &out.errors},
+		{latencyBucketMetricSelector, &out.latencyBucket},
+		{latencySumMetricSelector, &out.latencySum},
+	} {
+		vec, err := queryInstantVector(ctx, api, fmt.Sprintf(`increase(%s[%s])`, q.selector, rangeStr), end)
+		if err != nil {
+			return redVectors{}, err
+		}
+		*q.dst = vec
+	}
+	return out, nil
+}
+
+// applyRedMetrics adds errors and latency to the connection series found by aggregateSeries.
+// Samples of connections without a connection count in the range are ignored.
+func applyRedMetrics(aggregated map[workloadKey]map[string]*aggregatedSeries, red redVectors) {
+	lookup := func(labels prommodel.Metric) *aggregatedSeries {
+		workload, ok := workloadFromMetric(labels)
+		if !ok {
+			return nil
+		}
+		input := attributeGroupFromMetric(labels, inputAttributePrefix)
+		output := attributeGroupFromMetric(labels, outputAttributePrefix)
+		return aggregated[workload][input.sig+"\x00"+output.sig]
+	}
+
+	for _, sample := range red.errors {
+		if series := lookup(sample.Metric); series != nil && sample.Value > 0 {
+			series.errorCount += int64(sample.Value)
+		}
+	}
+	for _, sample := range red.latencySum {
+		if series := lookup(sample.Metric); series != nil && sample.Value > 0 {
+			series.latencySumMs += float64(sample.Value)
+		}
+	}
+	for _, sample := range red.latencyBucket {
+		series := lookup(sample.Metric)
+		if series == nil || sample.Value <= 0 {
+			continue
+		}
+		upperBound, err := strconv.ParseFloat(string(sample.Metric[histogramBucketUpperBoundKey]), 64)
+		if err != nil {
+			continue
+		}
+		if series.latencyBuckets == nil {
+			series.latencyBuckets = make(map[float64]float64)
+		}
+		// buckets are cumulative, so the counts of the different collector instances can be summed per bound
+		series.latencyBuckets[upperBound] += float64(sample.Value)
+	}
+}
+
+// histogramQuantile estimates the q-quantile from cumulative bucket counts keyed by upper bound,
+// interpolating linearly inside the bucket like the PromQL function of the same name.
+// It returns false when the histogram has no observations.
+func histogramQuantile(q float64, buckets map[float64]float64) (float64, bool) {
+	if len(buckets) == 0 {
+		return 0, false
+	}
+	bounds := make([]float64, 0, len(buckets))
+	for bound := range buckets {
+		bounds = append(bounds, bound)
+	}
+	sort.Float64s(bounds)
+
+	total := buckets[bounds[len(bounds)-1]]
+	if total <= 0 {
+		return 0, false
+	}
+	rank := q * total
+
+	lowerBound, lowerCount := 0.0, 0.0
+	for _, bound := range bounds {
+		count := buckets[bound]
+		if count >= rank {
+			if math.IsInf(bound, 1) {
+				// the quantile is in the overflow bucket, the highest finite bound is the best estimate
+				return lowerBound, true
+			}
+			if count == lowerCount {
+				return bound, true
+			}
+			return lowerBound + (bound-lowerBound)*(rank-lowerCount)/(count-lowerCount), true
+		}
+		lowerBound, lowerCount = bound, count
+	}
+	return lowerBound, true
+}
@@ -0,0 +1,97 @@
+package tracecorrelations
+
+import (
+	"math"
+	"testing"
+
+	prommodel "github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistogramQuantile(t *testing.T) {
+	_, ok := histogramQuantile(0.95, nil)
+	require.False(t, ok)
+
+	buckets := map[float64]float64{10: 50, 100: 90, 1000: 100, math.Inf(1): 100}
+	p50, ok := histogramQuantile(0.5, buckets)
+	require.True(t, ok)
+	require.InDelta(t, 10, p50, 0.001)
+
+	p95, ok := histogramQuantile(0.95, buckets)
+	require.True(t, ok)
+	require.InDelta(t, 550, p95, 0.001)
+
+	// observations above the highest finite bound are reported at that bound
+	p99, ok := histogramQuantile(0.99, map[float64]float64{10: 1, math.Inf(1): 10})
+	require.True(t, ok)
+	require.InDelta(t, 10, p99, 0.001)
+}
+
+func TestApplyRedMetricsAndDominantOutput(t *testing.T) {
+	connectionLabels := func(output, instance string) prommodel.Metric {
+		return prommodel.Metric{
+			"k8s.namespace.name":           "default",
+			"k8s.container.name":           "cart",
+			"k8s.deployment.name":          "cart",
+			"input.http.route":             "/checkout",
+			"output.rpc.service":           prommodel.LabelValue(output),
+			"odigos.collector.instance.id": prommodel.LabelValue(instance),
+		}
+	}
+	withLabel := func(labels prommodel.Metric, name, value string) prommodel.Metric {
+		out := labels.Clone()
+		out[prommodel.LabelName(name)] = prommodel.LabelValue(value)
+		return out
+	}
+
+	aggregated, _ := aggregateSeries(prommodel.Vector{
+		{Metric: connectionLabels("Payments", "a"), Value: 6},
+		{Metric: connectionLabels("Payments", "b"), Value: 4},
+		{Metric: connectionLabels("Inventory", "a"), Value: 10},
+	}, nil, nil)
+
+	applyRedMetrics(aggregated, redVectors{
+		errors: prommodel.Vector{
+			{Metric: connectionLabels("Payments", "a"), Value: 1},
+			{Metric: connectionLabels("Payments", "b"), Value: 1},
+		},
+		latencySum: prommodel.Vector{
+			{Metric: connectionLabels("Payments", "a"), Value: 1800},
+			{Metric: connectionLabels("Payments", "b"), Value: 1200},
+			{Metric: connectionLabels("Inventory", "a"), Value: 100},
+		},
+		latencyBucket: prommodel.Vector{
+			{Metric: withLabel(connectionLabels("Payments", "a"), "le", "100"), Value: 1},
+			{Metric: withLabel(connectionLabels("Payments", "a"), "le", "1000"), Value: 6},
+			{Metric: withLabel(connectionLabels("Payments", "b"), "le", "100"), Value: 1},
+			{Metric: withLabel(connectionLabels("Payments", "b"), "le", "1000"), Value: 4},
+			{Metric: withLabel(connectionLabels("Payments", "b"), "le", "+Inf"), Value: 4},
+			// a series without a connection count in the range is ignored
+			{Metric: withLabel(connectionLabels("Shipping", "a"), "le", "100"), Value: 3},
+		},
+	})
+
+	response := buildResponse(aggregated, nil)
+	require.Len(t, response.Workloads, 1)
+	require.Len(t, response.Workloads[0].Inputs, 1)
+	input := response.Workloads[0].Inputs[0]
+	require.Len(t, input.Outputs, 2)
+
+	require.NotNil(t, input.DominantOutput)
+	payments := input.DominantOutput
+	require.Equal(t, "Payments", payments.Attributes[0].Value)
+	require.Equal(t, 10, payments.ConnectionCount)
+	require.Equal(t, 2, payments.ErrorCount)
+	require.InDelta(t, 0.2, payments.ErrorRate, 0.001)
+	require.InDelta(t, 300, *payments.AverageLatencyMs, 0.001)
+	require.NotNil(t, payments.LatencyP95Ms)
+	require.InDelta(t, 3000.0/3100.0, *payments.LatencyShare, 0.001)
+
+	for _, output := range input.Outputs {
+		if output.Attributes[0].Value == "Inventory" {
+			require.Zero(t, output.ErrorCount)
+			require.Nil(t, output.LatencyP95Ms)
+			require.InDelta(t, 100.0/3100.0, *output.LatencyShare, 0.001)
+		}
+	}
+}
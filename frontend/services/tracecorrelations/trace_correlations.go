@@ -13,6 +13,7 @@ import (
 
 	"github.com/odigos-io/odigos/common/consts"
 	"github.com/odigos-io/odigos/frontend/graph/model"
+	"github.com/odigos-io/odigos/k8sutils/pkg/metricsstore"
 )
 
 const (
@@ -39,11 +40,16 @@ type attributeGroup struct {
 }
 
 type aggregatedSeries struct {
-	workload       workloadKey
-	input          attributeGroup
-	output         attributeGroup
+	workload        workloadKey
+	input           attributeGroup
+	output          attributeGroup
 	connectionCount int64
-	firstDetected  time.Time
+	firstDetected   time.Time
+
+	errorCount   int64
+	latencySumMs float64
+	// latencyBuckets holds the cumulative latency histogram counts by bucket upper bound (ms).
+	latencyBuckets map[float64]float64
 }
 
 // GetTraceCorrelations reads service I/O connection metrics from the trace correlations
@@ -83,7 +89,16 @@ func GetTraceCorrelations(
 		return nil, fmt.Errorf("query trace correlation first-seen timestamps: %w", err)
 	}
 
+	red, err := queryRedMetricsInRange(ctx, api, start, end)
+	if err != nil {
+		if mapped := mapMetricsStoreError(err); errors.Is(mapped, ErrMetricsStoreUnavailable) {
+			return nil, mapped
+		}
+		return nil, fmt.Errorf("query trace correlation errors and latency: %w", err)
+	}
+
 	aggregated, runtimes := aggregateSeries(counts, firstSeen, filter)
+	applyRedMetrics(aggregated, red)
 	return buildResponse(aggregated, runtimes), nil
 }
 
@@ -109,24 +124,10 @@ func resolveTimeRange(timeRange *model.TraceCorrelationsTimeRangeInput) (time.Ti
 
 func queryConnectionCountsInRange(ctx context.Context, api v1.API, start, end time.Time) (prommodel.Vector, error) {
 	duration := end.Sub(start)
-	query := fmt.Sprintf(`increase(%s[%s])`, metricSelector, promDuration(duration))
+	query := fmt.Sprintf(`increase(%s[%s])`, metricSelector, metricsstore.PromDuration(duration))
 	return queryInstantVector(ctx, api, query, end)
 }
 
-func promDuration(duration time.Duration) string {
-	if duration >= time.Hour && duration%time.Hour == 0 {
-		return fmt.Sprintf("%dh", int(duration.Hours()))
-	}
-	if duration >= time.Minute && duration%time.Minute == 0 {
-		return fmt.Sprintf("%dm", int(duration.Minutes()))
-	}
-	seconds := int(duration.Seconds())
-	if seconds < 1 {
-		seconds = 1
-	}
-	return fmt.Sprintf("%ds", seconds)
-}
-
 func queryInstantVector(ctx context.Context, api v1.API, query string, ts time.Time) (prommodel.Vector, error) {
 	val, _, err := api.Query(ctx, query, ts)
 	if err != nil {
@@ -243,16 +244,13 @@ func groupByInput(seriesByKey map[string]*aggregatedSeries) []*model.TraceCorrel
 		if !ok {
 			group = &model.TraceCorrelationsInputGroup{
 				Attributes: toModelAttributes(series.input.attrs),
+				HTTPRoute:  optionalString(series.input.attrs[httpRouteAttribute]),
 				Outputs:    make([]*model.TraceCorrelationsOutputSeries, 0),
 			}
 			inputMap[series.input.sig] = group
 		}
 
-		group.Outputs = append(group.Outputs, &model.TraceCorrelationsOutputSeries{
-			Attributes:      toModelAttributes(series.output.attrs),
-			ConnectionCount: int(series.connectionCount),
-			FirstDetectedAt: formatFirstDetectedAt(series.firstDetected),
-		})
+		group.Outputs = append(group.Outputs, toModelOutputSeries(series))
 	}
 
 	groups := make([]*model.TraceCorrelationsInputGroup, 0, len(inputMap))
@@ -260,6 +258,7 @@ func groupByInput(seriesByKey map[string]*aggregatedSeries) []*model.TraceCorrel
 		sort.Slice(group.Outputs, func(i, j int) bool {
 			return attributeSignatureFromModel(group.Outputs[i].Attributes) < attributeSignatureFromModel(group.Outputs[j].Attributes)
 		})
+		setLatencyShares(group)
 		groups = append(groups, group)
 	}
 
@@ -270,6 +269,59 @@ func groupByInput(seriesByKey map[string]*aggregatedSeries) []*model.TraceCorrel
 	return groups
 }
 
+func toModelOutputSeries(series *aggregatedSeries) *model.TraceCorrelationsOutputSeries {
+	out := &model.TraceCorrelationsOutputSeries{
+		Attributes:      toModelAttributes(series.output.attrs),
+		ConnectionCount: int(series.connectionCount),
+		ErrorCount:      int(series.errorCount),
+		FirstDetectedAt: formatFirstDetectedAt(series.firstDetected),
+	}
+	if series.connectionCount > 0 {
+		out.ErrorRate = float64(series.errorCount) / float64(series.connectionCount)
+		if series.latencySumMs > 0 {
+			average := series.latencySumMs / float64(series.connectionCount)
+			out.AverageLatencyMs = &average
+		}
+	}
+	if p50, ok := histogramQuantile(0.5, series.latencyBuckets); ok {
+		out.LatencyP50Ms = &p50
+	}
+	if p95, ok := histogramQuantile(0.95, series.latencyBuckets); ok {
+		out.LatencyP95Ms = &p95
+	}
+	return out
+}
+
+// setLatencyShares sets the share of the input group's downstream call time spent in each output,
+// and marks the output with the largest share as dominant. Outputs without latency data are skipped.
+func setLatencyShares(group *model.TraceCorrelationsInputGroup) {
+	totalTime := func(output *model.TraceCorrelationsOutputSeries) float64 {
+		if output.AverageLatencyMs == nil {
+			return 0
+		}
+		return *output.AverageLatencyMs * float64(output.ConnectionCount)
+	}
+
+	var sum float64
+	for _, output := range group.Outputs {
+		sum += totalTime(output)
+	}
+	if sum <= 0 {
+		return
+	}
+	for _, output := range group.Outputs {
+		outputTime := totalTime(output)
+		if outputTime <= 0 {
+			continue
+		}
+		share := outputTime / sum
+		output.LatencyShare = &share
+		if group.DominantOutput == nil || share > *group.DominantOutput.LatencyShare {
+			group.DominantOutput = output
+		}
+	}
+}
+
 func toModelAttributes(attrs map[string]string) []*model.NonIdentifyingAttribute {
 	if len(attrs) == 0 {
 		return []*model.NonIdentifyingAttribute{}
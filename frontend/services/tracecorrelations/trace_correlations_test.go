@@ -9,13 +9,6 @@ import (
 	"github.com/odigos-io/odigos/frontend/graph/model"
 )
 
-func TestPromDuration(t *testing.T) {
-	require.Equal(t, "1h", promDuration(time.Hour))
-	require.Equal(t, "15m", promDuration(15*time.Minute))
-	require.Equal(t, "90s", promDuration(90*time.Second))
-	require.Equal(t, "1s", promDuration(500*time.Millisecond))
-}
-
 func TestResolveTimeRange(t *testing.T) {
 	start := time.Date(2026, 6, 13, 10, 0, 0, 0, time.UTC)
 	end := time.Date(2026, 6, 13, 11, 0, 0, 0, time.UTC)
@@ -0,0 +1,123 @@
+'use client';
+
+import React, { useState } from 'react';
+import { useAgentOverhead, type SourceId } from './temp-hooks/useAgentOverhead';
+import { Badge, Banner, Button, Input, Label, Muted, Page, Select, Shell, Subtitle, Table, Td, Th, Title, Toolbar } from '../styled';
+
+const KINDS = ['Deployment', 'StatefulSet', 'DaemonSet', 'CronJob', 'Job', 'DeploymentConfig', 'Rollout'];
+
+const MIB = 1024 * 1024;
+
+const formatCpu = (millicores: number) => `${millicores}m`;
+
+const formatMemory = (bytes: number) => `${Math.round(bytes / MIB)}Mi`;
+
+const DeltaBadge: React.FC<{ before: number; after: number; format: (value: number) => string }> = ({ before, after, format }) => {
+  const delta = after - before;
+  const percent = before > 0 ? ` (${delta >= 0 ? '+' : ''}${((delta / before) * 100).toFixed(1)}%)` : '';
+  return (
+    <Badge $tone={delta > 0 ? 'warning' : 'success'}>
+      {delta >= 0 ? '+' : '-'}
+      {format(Math.abs(delta))}
+      {percent}
+    </Badge>
+  );
+};
+
+export default function AgentOverheadPage() {
+  const [namespaceInput, setNamespaceInput] = useState('');
+  const [kindInput, setKindInput] = useState(KINDS[0]);
+  const [nameInput, setNameInput] = useState('');
+  const [sourceId, setSourceId] = useState<SourceId | null>(null);
+  const { agentOverhead, loading, error } = useAgentOverhead(sourceId);
+
+  return (
+    <Page>
+      <Shell>
+        <Title>Agent Overhead</Title>
+        <Subtitle>
+          The cpu and memory usage of the containers of a source, per pod, averaged over a window before and after Odigos enabled its agents. The runtime columns show the usage of the
+          instrumented process as reported by the agent itself, for agents that report runtime metrics.
+        </Subtitle>
+
+        <Toolbar
+          onSubmit={(e) => {
+            e.preventDefault();
+            setSourceId({ namespace: namespaceInput.trim(), kind: kindInput, name: nameInput.trim() });
+          }}
+        >
+          <Label htmlFor='namespace'>Namespace</Label>
+          <Input id='namespace' value={namespaceInput} placeholder='default' onChange={(e) => setNamespaceInput(e.target.value)} />
+          <Label htmlFor='kind'>Kind</Label>
+          <Select id='kind' value={kindInput} onChange={(e) => setKindInput(e.target.value)}>
+            {KINDS.map((kind) => (
+              <option key={kind} value={kind}>
+                {kind}
+              </option>
+            ))}
+          </Select>
+          <Label htmlFor='name'>Name</Label>
+          <Input id='name' value={nameInput} placeholder='checkout' onChange={(e) => setNameInput(e.target.value)} />
+          <Button type='submit' disabled={!namespaceInput.trim() || !nameInput.trim() || loading}>
+            Show
+          </Button>
+        </Toolbar>
+
+        {error && <Banner $tone='error'>{error.message}</Banner>}
+        {sourceId && !loading && !error && !agentOverhead && (
+          <Banner>
+            The overhead of {sourceId.namespace}/{sourceId.kind}/{sourceId.name} was not measured yet. It is measured once the window after its agents were enabled completes, when agent overhead
+            accounting is enabled.
+          </Banner>
+        )}
+
+        {agentOverhead && (
+          <>
+            <Subtitle>
+              Agents enabled {new Date(agentOverhead.instrumentationTime).toLocaleString()}, averaged over {agentOverhead.window} before and after, measured{' '}
+              {new Date(agentOverhead.measurementTime).toLocaleString()}.
+            </Subtitle>
+            {agentOverhead.message && <Banner $tone='warning'>{agentOverhead.message}</Banner>}
+
+            {agentOverhead.containers.length > 0 && (
+              <Table>
+                <thead>
+                  <tr>
+                    <Th>Container</Th>
+                    <Th>CPU before</Th>
+                    <Th>CPU after</Th>
+                    <Th>CPU overhead</Th>
+                    <Th>Memory before</Th>
+                    <Th>Memory after</Th>
+                    <Th>Memory overhead</Th>
+                    <Th>Runtime CPU</Th>
+                    <Th>Runtime memory</Th>
+                  </tr>
+                </thead>
+                <tbody>
+                  {agentOverhead.containers.map((container) => (
+                    <tr key={container.containerName}>
+                      <Td>{container.containerName}</Td>
+                      <Td>{formatCpu(container.cpuMillicoresBefore)}</Td>
+                      <Td>{formatCpu(container.cpuMillicoresAfter)}</Td>
+                      <Td>
+                        <DeltaBadge before={container.cpuMillicoresBefore} after={container.cpuMillicoresAfter} format={formatCpu} />
+                      </Td>
+                      <Td>{formatMemory(container.memoryBytesBefore)}</Td>
+                      <Td>{formatMemory(container.memoryBytesAfter)}</Td>
+                      <Td>
+                        <DeltaBadge before={container.memoryBytesBefore} after={container.memoryBytesAfter} format={formatMemory} />
+                      </Td>
+                      <Td>{container.runtimeCpuMillicoresAfter != null ? formatCpu(container.runtimeCpuMillicoresAfter) : <Muted>not reported</Muted>}</Td>
+                      <Td>{container.runtimeMemoryBytesAfter != null ? formatMemory(container.runtimeMemoryBytesAfter) : <Muted>not reported</Muted>}</Td>
+                    </tr>
+                  ))}
+                </tbody>
+              </Table>
+            )}
+          </>
+        )}
+      </Shell>
+    </Page>
+  );
+}
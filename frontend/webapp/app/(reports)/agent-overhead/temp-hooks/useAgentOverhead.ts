@@ -0,0 +1,51 @@
+import { useQuery } from '@apollo/client/react';
+import { GET_SOURCE_AGENT_OVERHEAD } from '@/graphql';
+
+// TODO: move this to the ui-kit to work with OdigosApiContext
+
+export type SourceId = {
+  namespace: string;
+  kind: string;
+  name: string;
+};
+
+export type ContainerAgentOverhead = {
+  containerName: string;
+  cpuMillicoresBefore: number;
+  cpuMillicoresAfter: number;
+  memoryBytesBefore: number;
+  memoryBytesAfter: number;
+  runtimeCpuMillicoresAfter?: number | null;
+  runtimeMemoryBytesAfter?: number | null;
+};
+
+export type SourceAgentOverhead = {
+  instrumentationTime: string;
+  window: string;
+  measurementTime: string;
+  containers: ContainerAgentOverhead[];
+  message?: string | null;
+};
+
+type SourceAgentOverheadResponse = {
+  computePlatform: {
+    source: {
+      agentOverhead?: SourceAgentOverhead | null;
+    };
+  };
+};
+
+// the overhead is measured once per source, so it is fetched on demand and not polled
+export const useAgentOverhead = (sourceId: SourceId | null) => {
+  const { data, loading, error } = useQuery<SourceAgentOverheadResponse>(GET_SOURCE_AGENT_OVERHEAD, {
+    variables: { sourceId },
+    skip: !sourceId,
+    fetchPolicy: 'network-only',
+  });
+
+  return {
+    agentOverhead: data?.computePlatform?.source?.agentOverhead ?? null,
+    loading,
+    error,
+  };
+};
@@ -0,0 +1,97 @@
+'use client';
+
+import React, { useState } from 'react';
+import { useCompatibilityReport, type ContainerCompatibility } from './temp-hooks/useCompatibilityReport';
+import { Badge, Banner, Button, Input, Label, Muted, Page, Shell, Subtitle, Table, Td, Th, Title, Toolbar } from '../styled';
+
+const runtimeLabel = (container: ContainerCompatibility) => {
+  if (!container.language) return <Muted>not detected</Muted>;
+  return container.runtimeVersion ? `${container.language} ${container.runtimeVersion}` : container.language;
+};
+
+export default function CompatibilityReportPage() {
+  const [namespaceInput, setNamespaceInput] = useState('');
+  const [namespace, setNamespace] = useState('');
+  const { workloads, inspectionError, loading, error } = useCompatibilityReport(namespace);
+
+  return (
+    <Page>
+      <Shell>
+        <Title>Compatibility Report</Title>
+        <Subtitle>
+          Check which workloads of a namespace Odigos can instrument before enabling it: the detected runtime of each container, the distribution that would be used, and known blockers.
+          Nothing is instrumented by this report.
+        </Subtitle>
+
+        <Toolbar
+          onSubmit={(e) => {
+            e.preventDefault();
+            setNamespace(namespaceInput.trim());
+          }}
+        >
+          <Label htmlFor='namespace'>Namespace</Label>
+          <Input id='namespace' value={namespaceInput} placeholder='default' onChange={(e) => setNamespaceInput(e.target.value)} />
+          <Button type='submit' disabled={!namespaceInput.trim() || loading}>
+            {loading ? 'Inspecting…' : 'Check'}
+          </Button>
+        </Toolbar>
+
+        {error && <Banner $tone='error'>{error.message}</Banner>}
+        {inspectionError && <Banner $tone='warning'>Runtime inspection failed on some nodes, their workloads are reported without runtime details: {inspectionError}</Banner>}
+        {namespace && !loading && !error && workloads.length === 0 && <Banner>No workloads found in namespace {namespace}.</Banner>}
+
+        {workloads.length > 0 && (
+          <Table>
+            <thead>
+              <tr>
+                <Th>Workload</Th>
+                <Th>Container</Th>
+                <Th>Runtime</Th>
+                <Th>Distribution</Th>
+                <Th>Status</Th>
+                <Th>Blockers</Th>
+              </tr>
+            </thead>
+            <tbody>
+              {workloads.map((workload) =>
+                workload.containers.map((container, idx) => (
+                  <tr key={`${workload.kind}/${workload.name}/${container.containerName}`}>
+                    <Td>
+                      {idx === 0 && (
+                        <>
+                          {workload.kind}/{workload.name} {workload.instrumented && <Badge $tone='neutral'>instrumented</Badge>}
+                        </>
+                      )}
+                    </Td>
+                    <Td>{container.containerName}</Td>
+                    <Td>{runtimeLabel(container)}</Td>
+                    <Td>{container.otelDistroName || <Muted>none</Muted>}</Td>
+                    <Td>
+                      <Badge $tone={container.supported ? 'success' : 'error'}>{container.supported ? 'supported' : container.reason || 'not supported'}</Badge>
+                      {container.message && (
+                        <div>
+                          <Muted>{container.message}</Muted>
+                        </div>
+                      )}
+                    </Td>
+                    <Td>
+                      {container.blockers.length === 0 ? (
+                        <Muted>none</Muted>
+                      ) : (
+                        container.blockers.map((blocker) => (
+                          <div key={blocker.type}>
+                            <Badge $tone='warning'>{blocker.type}</Badge> {blocker.message}
+                          </div>
+                        ))
+                      )}
+                    </Td>
+                  </tr>
+                )),
+              )}
+            </tbody>
+          </Table>
+        )}
+      </Shell>
+    </Page>
+  );
+}
@@ -0,0 +1,52 @@
+import { useQuery } from '@apollo/client/react';
+import { GET_COMPATIBILITY_REPORT } from '@/graphql';
+
+// TODO: move this to the ui-kit to work with OdigosApiContext
+
+export type CompatibilityBlocker = {
+  type: string;
+  message: string;
+};
+
+export type ContainerCompatibility = {
+  containerName: string;
+  language?: string | null;
+  runtimeVersion?: string | null;
+  otelDistroName?: string | null;
+  supported: boolean;
+  reason?: string | null;
+  message?: string | null;
+  blockers: CompatibilityBlocker[];
+};
+
+export type WorkloadCompatibility = {
+  kind: string;
+  name: string;
+  instrumented: boolean;
+  containers: ContainerCompatibility[];
+};
+
+type CompatibilityReportResponse = {
+  compatibilityReport: {
+    namespace: string;
+    inspectionError?: string | null;
+    workloads: WorkloadCompatibility[];
+  };
+};
+
+// the report inspects the running processes of the namespace on every node, so it is only fetched on demand
+export const useCompatibilityReport = (namespace: string) => {
+  const { data, loading, error, refetch } = useQuery<CompatibilityReportResponse>(GET_COMPATIBILITY_REPORT, {
+    variables: { namespace },
+    skip: !namespace,
+    fetchPolicy: 'network-only',
+  });
+
+  return {
+    workloads: data?.compatibilityReport?.workloads ?? [],
+    inspectionError: data?.compatibilityReport?.inspectionError ?? null,
+    loading,
+    error,
+    refetch,
+  };
+};
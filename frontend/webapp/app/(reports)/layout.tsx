@@ -0,0 +1,24 @@
+'use client';
+
+import React, { type PropsWithChildren } from 'react';
+import OdigosApiAdapter from '@/lib/odigos-api-adapter';
+import { ToastList } from '@odigos/ui-kit/containers';
+import { ErrorBoundary } from '@odigos/ui-kit/components';
+
+/**
+ * Report views (compatibility report, service map diff, service level objectives, agent overhead, span profiling)
+ * are not part of the ui-kit yet. Like trace correlations, they keep a standalone layout and
+ * call `useQuery` directly through the Apollo client provided by the adapter.
+ */
+function ReportsLayout({ children }: PropsWithChildren) {
+  return (
+    <ErrorBoundary>
+      <OdigosApiAdapter>
+        {children}
+        <ToastList />
+      </OdigosApiAdapter>
+    </ErrorBoundary>
+  );
+}
+
+export default ReportsLayout;
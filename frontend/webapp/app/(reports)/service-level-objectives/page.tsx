@@ -0,0 +1,143 @@
+'use client';
+
+import React, { useState } from 'react';
+import { useServiceLevelObjectives, type ServiceLevelObjective, type ServiceLevelObjectiveCondition } from './temp-hooks/useServiceLevelObjectives';
+import { Badge, Banner, Button, Input, Label, Muted, Page, Shell, Subtitle, Table, Td, Th, Title, Toolbar } from '../styled';
+
+const operationLabel = (slo: ServiceLevelObjective) => {
+  const route = slo.httpRoute || (slo.httpRoutePrefix ? `${slo.httpRoutePrefix}*` : '');
+  if (!route && !slo.httpMethod) return <Muted>all server calls</Muted>;
+  return [slo.httpMethod, route].filter(Boolean).join(' ');
+};
+
+const objectiveLabel = (slo: ServiceLevelObjective) =>
+  slo.type === 'Latency' ? `${slo.target}% under ${slo.latencyThresholdMs}ms` : `${slo.target}% without errors`;
+
+const formatPercent = (value?: number | null) => (value === null || value === undefined ? <Muted>—</Muted> : `${value.toFixed(2)}%`);
+
+const findCondition = (slo: ServiceLevelObjective, type: string) => slo.conditions.find((condition) => condition.type === type);
+
+// the burn conditions are true while the budget burns too fast, so their status can't be shown as is
+const burnTone = (condition?: ServiceLevelObjectiveCondition) => {
+  switch (condition?.reason) {
+    case 'BurnRateExceeded':
+      return 'error';
+    case 'WithinBudget':
+      return 'success';
+    default:
+      return 'neutral';
+  }
+};
+
+const BurnBadge: React.FC<{ label: string; condition?: ServiceLevelObjectiveCondition }> = ({ label, condition }) => (
+  <div title={condition?.message || undefined}>
+    <Badge $tone={burnTone(condition)}>
+      {label}: {condition?.reason === 'BurnRateExceeded' ? 'burning' : condition?.reason === 'WithinBudget' ? 'ok' : 'unknown'}
+    </Badge>
+  </div>
+);
+
+export default function ServiceLevelObjectivesPage() {
+  const [namespaceInput, setNamespaceInput] = useState('');
+  const [namespace, setNamespace] = useState('');
+  const { serviceLevelObjectives, loading, error } = useServiceLevelObjectives(namespace);
+
+  return (
+    <Page>
+      <Shell>
+        <Title>Service Level Objectives</Title>
+        <Subtitle>
+          The SLI, remaining error budget and burn rates of each ServiceLevelObjective resource, computed by Odigos from the span metrics in the Odigos metrics store. Hover a burn alert for its
+          details.
+        </Subtitle>
+
+        <Toolbar
+          onSubmit={(e) => {
+            e.preventDefault();
+            setNamespace(namespaceInput.trim());
+          }}
+        >
+          <Label htmlFor='namespace'>Namespace</Label>
+          <Input id='namespace' value={namespaceInput} placeholder='all namespaces' onChange={(e) => setNamespaceInput(e.target.value)} />
+          <Button type='submit' disabled={loading}>
+            Filter
+          </Button>
+        </Toolbar>
+
+        {error && <Banner $tone='error'>{error.message}</Banner>}
+        {!loading && !error && serviceLevelObjectives.length === 0 && <Banner>No service level objectives found{namespace ? ` for sources in namespace ${namespace}` : ''}.</Banner>}
+
+        {serviceLevelObjectives.length > 0 && (
+          <Table>
+            <thead>
+              <tr>
+                <Th>Objective</Th>
+                <Th>Source</Th>
+                <Th>Operation</Th>
+                <Th>Target</Th>
+                <Th>SLI</Th>
+                <Th>Error budget left</Th>
+                <Th>Burn rates</Th>
+                <Th>Alerts</Th>
+              </tr>
+            </thead>
+            <tbody>
+              {serviceLevelObjectives.map((slo) => {
+                const evaluated = findCondition(slo, 'Evaluated');
+                const notEvaluated = evaluated?.status !== 'success';
+
+                return (
+                  <tr key={slo.name}>
+                    <Td>
+                      {slo.name}
+                      {slo.lastEvaluationTime && (
+                        <div>
+                          <Muted>updated {new Date(slo.lastEvaluationTime).toLocaleString()}</Muted>
+                        </div>
+                      )}
+                    </Td>
+                    <Td>
+                      {slo.namespace}/{slo.kind}/{slo.workloadName}
+                    </Td>
+                    <Td>{operationLabel(slo)}</Td>
+                    <Td>{objectiveLabel(slo)}</Td>
+                    {notEvaluated ? (
+                      <Td colSpan={3}>
+                        <Badge $tone='warning'>{evaluated?.reason || 'not evaluated yet'}</Badge> {evaluated?.message && <Muted>{evaluated.message}</Muted>}
+                      </Td>
+                    ) : (
+                      <>
+                        <Td>
+                          {formatPercent(slo.sli)}
+                          {slo.sliWindow && <Muted> over {slo.sliWindow}</Muted>}
+                        </Td>
+                        <Td>
+                          <Badge $tone={(slo.errorBudgetRemaining ?? 0) > 0 ? 'success' : 'error'}>{formatPercent(slo.errorBudgetRemaining)}</Badge>
+                        </Td>
+                        <Td>
+                          {slo.burnRates.length === 0 ? (
+                            <Muted>no recent calls</Muted>
+                          ) : (
+                            slo.burnRates.map((burnRate) => (
+                              <div key={burnRate.window}>
+                                {burnRate.window}: {burnRate.burnRate.toFixed(2)}
+                              </div>
+                            ))
+                          )}
+                        </Td>
+                      </>
+                    )}
+                    <Td>
+                      <BurnBadge label='fast' condition={findCondition(slo, 'FastBurn')} />
+                      <BurnBadge label='slow' condition={findCondition(slo, 'SlowBurn')} />
+                    </Td>
+                  </tr>
+                );
+              })}
+            </tbody>
+          </Table>
+        )}
+      </Shell>
+    </Page>
+  );
+}
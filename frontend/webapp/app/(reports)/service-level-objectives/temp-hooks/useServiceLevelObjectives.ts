@@ -0,0 +1,58 @@
+import { useQuery } from '@apollo/client/react';
+import { GET_SERVICE_LEVEL_OBJECTIVES } from '@/graphql';
+
+// TODO: move this to the ui-kit to work with OdigosApiContext
+
+export type ServiceLevelObjectiveBurnRate = {
+  window: string;
+  burnRate: number;
+};
+
+export type ServiceLevelObjectiveCondition = {
+  status: string;
+  type: string;
+  reason?: string | null;
+  message?: string | null;
+};
+
+export type ServiceLevelObjective = {
+  name: string;
+  namespace: string;
+  kind: string;
+  workloadName: string;
+  type: 'Latency' | 'Availability';
+  target: number;
+  latencyThresholdMs?: number | null;
+  httpRoute?: string | null;
+  httpRoutePrefix?: string | null;
+  httpMethod?: string | null;
+  keepBudgetBurningTraces: boolean;
+  sli?: number | null;
+  sliWindow?: string | null;
+  errorBudgetRemaining?: number | null;
+  burnRates: ServiceLevelObjectiveBurnRate[];
+  lastEvaluationTime?: string | null;
+  conditions: ServiceLevelObjectiveCondition[];
+};
+
+type ServiceLevelObjectivesResponse = {
+  serviceLevelObjectives: ServiceLevelObjective[];
+};
+
+// the status is refreshed by the autoscaler every few minutes, so polling more often shows nothing new
+const POLL_INTERVAL_MS = 60 * 1000;
+
+export const useServiceLevelObjectives = (namespace: string) => {
+  const { data, loading, error, refetch } = useQuery<ServiceLevelObjectivesResponse>(GET_SERVICE_LEVEL_OBJECTIVES, {
+    variables: { filter: namespace ? { namespace } : null },
+    fetchPolicy: 'cache-and-network',
+    pollInterval: POLL_INTERVAL_MS,
+  });
+
+  return {
+    serviceLevelObjectives: data?.serviceLevelObjectives ?? [],
+    loading,
+    error,
+    refetch,
+  };
+};
@@ -0,0 +1,94 @@
+'use client';
+
+import React, { useMemo, useState } from 'react';
+import { buildBaselineTimeRange, SERVICE_MAP_DIFF_BASELINE_PRESETS, useServiceMapDiff, type ServiceMapEdgeChange, type ServiceMapEdgeMetrics } from './temp-hooks/useServiceMapDiff';
+import { Badge, Banner, Button, Input, Label, Muted, Page, Shell, Subtitle, Table, Td, Th, Title, Toolbar } from '../styled';
+
+const CHANGE_TONE: Record<ServiceMapEdgeChange, 'success' | 'error' | 'warning'> = {
+  Added: 'success',
+  Removed: 'error',
+  Changed: 'warning',
+};
+
+const formatMetrics = (metrics: ServiceMapEdgeMetrics) => {
+  const latency = metrics.latencyP95Ms != null ? `, p95 ${Math.round(metrics.latencyP95Ms)}ms` : '';
+  return `${metrics.requestRate.toFixed(2)} req/s, ${(metrics.errorRate * 100).toFixed(1)}% errors${latency}`;
+};
+
+const formatTime = (value: string) => new Date(value).toLocaleString();
+
+export default function ServiceMapDiffPage() {
+  const [baselineEnd, setBaselineEnd] = useState<Date | null>(null);
+  const [customValue, setCustomValue] = useState('');
+  const baseline = useMemo(() => (baselineEnd ? buildBaselineTimeRange(baselineEnd) : null), [baselineEnd]);
+  const { diff, loading, error } = useServiceMapDiff(baseline);
+
+  return (
+    <Page>
+      <Shell>
+        <Title>Service Map Diff</Title>
+        <Subtitle>Compare the service map edges of the last 5 minutes with a baseline window, e.g. right before a deploy, to find calls which were added, removed, or whose rate, errors or latency changed.</Subtitle>
+
+        <Toolbar
+          onSubmit={(e) => {
+            e.preventDefault();
+            const date = customValue ? new Date(customValue) : null;
+            if (date && !Number.isNaN(date.getTime())) setBaselineEnd(date);
+          }}
+        >
+          <Label>Baseline</Label>
+          {SERVICE_MAP_DIFF_BASELINE_PRESETS.map((preset) => (
+            <Button key={preset.id} type='button' disabled={loading} onClick={() => setBaselineEnd(new Date(Date.now() - preset.agoMs))}>
+              {preset.label}
+            </Button>
+          ))}
+          <Input type='datetime-local' value={customValue} onChange={(e) => setCustomValue(e.target.value)} />
+          <Button type='submit' disabled={!customValue || loading}>
+            Compare
+          </Button>
+        </Toolbar>
+
+        {error && <Banner $tone='error'>{error.message}</Banner>}
+        {diff && (
+          <Banner>
+            Baseline {formatTime(diff.baselineFrom)} – {formatTime(diff.baselineTo)}, compared with {formatTime(diff.currentFrom)} – {formatTime(diff.currentTo)}
+          </Banner>
+        )}
+        {diff && diff.edges.length === 0 && <Banner>No significant changes between the two windows.</Banner>}
+
+        {diff && diff.edges.length > 0 && (
+          <Table>
+            <thead>
+              <tr>
+                <Th>Edge</Th>
+                <Th>Change</Th>
+                <Th>Baseline</Th>
+                <Th>Current</Th>
+                <Th>Details</Th>
+              </tr>
+            </thead>
+            <tbody>
+              {diff.edges.map((edge) => (
+                <tr key={`${edge.fromNodeId}->${edge.toNodeId}`}>
+                  <Td>
+                    {edge.fromServiceName} → {edge.toServiceName} {edge.isVirtual && <Muted>(external)</Muted>}
+                  </Td>
+                  <Td>
+                    <Badge $tone={CHANGE_TONE[edge.change]}>{edge.change}</Badge>
+                  </Td>
+                  <Td>{edge.change === 'Added' ? <Muted>—</Muted> : formatMetrics(edge.baseline)}</Td>
+                  <Td>{edge.change === 'Removed' ? <Muted>—</Muted> : formatMetrics(edge.current)}</Td>
+                  <Td>
+                    {edge.details.map((detail) => (
+                      <div key={detail}>{detail}</div>
+                    ))}
+                  </Td>
+                </tr>
+              ))}
+            </tbody>
+          </Table>
+        )}
+      </Shell>
+    </Page>
+  );
+}
@@ -0,0 +1,73 @@
+import { useQuery } from '@apollo/client/react';
+import { GET_SERVICE_MAP_DIFF } from '@/graphql';
+
+// TODO: move this to the ui-kit to work with OdigosApiContext
+
+export type ServiceMapEdgeMetrics = {
+  requestRate: number;
+  errorRate: number;
+  latencyP95Ms?: number | null;
+  health: string;
+};
+
+export type ServiceMapEdgeChange = 'Added' | 'Removed' | 'Changed';
+
+export type ServiceMapEdgeDiff = {
+  fromNodeId: string;
+  fromServiceName: string;
+  toNodeId: string;
+  toServiceName: string;
+  isVirtual: boolean;
+  change: ServiceMapEdgeChange;
+  details: string[];
+  baseline: ServiceMapEdgeMetrics;
+  current: ServiceMapEdgeMetrics;
+};
+
+export type ServiceMapTimeRange = {
+  from: string;
+  to?: string | null;
+};
+
+type ServiceMapDiffResponse = {
+  serviceMapDiff: {
+    baselineFrom: string;
+    baselineTo: string;
+    currentFrom: string;
+    currentTo: string;
+    edges: ServiceMapEdgeDiff[];
+  };
+};
+
+// the baseline window has the same length as the current window, which defaults to the last 5 minutes
+export const SERVICE_MAP_DIFF_WINDOW_MS = 5 * 60 * 1000;
+
+export const SERVICE_MAP_DIFF_BASELINE_PRESETS: { id: string; label: string; agoMs: number }[] = [
+  { id: '15m', label: '15m ago', agoMs: 15 * 60 * 1000 },
+  { id: '1h', label: '1h ago', agoMs: 60 * 60 * 1000 },
+  { id: '6h', label: '6h ago', agoMs: 6 * 60 * 60 * 1000 },
+  { id: '24h', label: '24h ago', agoMs: 24 * 60 * 60 * 1000 },
+];
+
+// buildBaselineTimeRange returns the window which ends at baselineEnd, e.g. right before a deploy
+export function buildBaselineTimeRange(baselineEnd: Date): ServiceMapTimeRange {
+  return {
+    from: new Date(baselineEnd.getTime() - SERVICE_MAP_DIFF_WINDOW_MS).toISOString(),
+    to: baselineEnd.toISOString(),
+  };
+}
+
+export const useServiceMapDiff = (baseline: ServiceMapTimeRange | null) => {
+  const { data, loading, error, refetch } = useQuery<ServiceMapDiffResponse>(GET_SERVICE_MAP_DIFF, {
+    variables: { baseline, current: null },
+    skip: !baseline,
+    fetchPolicy: 'network-only',
+  });
+
+  return {
+    diff: data?.serviceMapDiff ?? null,
+    loading,
+    error,
+    refetch,
+  };
+};
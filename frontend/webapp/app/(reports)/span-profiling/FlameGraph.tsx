@@ -0,0 +1,90 @@
+'use client';
+
+import React, { useMemo } from 'react';
+import styled from 'styled-components';
+import type { Flamebearer } from './temp-hooks/useSpanProfiling';
+
+const ROW_HEIGHT = 20;
+
+// nodes narrower than this are not drawn, like the pyroscope flame graph does
+const MIN_WIDTH_PERCENT = 0.1;
+
+type FlameNode = {
+  key: string;
+  name: string;
+  level: number;
+  left: number;
+  total: number;
+  self: number;
+};
+
+const Graph = styled.div<{ $levels: number }>`
+  position: relative;
+  height: ${({ $levels }) => $levels * ROW_HEIGHT}px;
+  overflow: hidden;
+  border-radius: 10px;
+  background: rgba(15, 23, 42, 0.72);
+`;
+
+const Node = styled.div<{ $hue: number }>`
+  position: absolute;
+  height: ${ROW_HEIGHT - 1}px;
+  padding: 0 4px;
+  box-sizing: border-box;
+  overflow: hidden;
+  white-space: nowrap;
+  text-overflow: ellipsis;
+  font-size: 11px;
+  line-height: ${ROW_HEIGHT - 1}px;
+  color: #0f172a;
+  background: ${({ $hue }) => `hsl(${$hue}, 70%, 62%)`};
+  border-right: 1px solid rgba(15, 23, 42, 0.6);
+`;
+
+// decodeFlamebearer turns the delta encoded levels into positioned nodes
+function decodeFlamebearer(flamebearer: Flamebearer): FlameNode[] {
+  const nodes: FlameNode[] = [];
+  flamebearer.levels.forEach((values, level) => {
+    let prev = 0;
+    for (let i = 0; i + 3 < values.length; i += 4) {
+      const left = prev + values[i];
+      const total = values[i + 1];
+      prev = left + total;
+      nodes.push({ key: `${level}-${i}`, name: flamebearer.names[values[i + 3]] ?? '', level, left, total, self: values[i + 2] });
+    }
+  });
+  return nodes;
+}
+
+// hueOf keeps the color of a function stable between graphs
+function hueOf(name: string) {
+  let hash = 0;
+  for (let i = 0; i < name.length; i++) {
+    hash = (hash * 31 + name.charCodeAt(i)) | 0;
+  }
+  return Math.abs(hash) % 60;
+}
+
+export const FlameGraph: React.FC<{ flamebearer: Flamebearer }> = ({ flamebearer }) => {
+  const nodes = useMemo(() => decodeFlamebearer(flamebearer), [flamebearer]);
+  const numTicks = flamebearer.numTicks || 1;
+
+  return (
+    <Graph $levels={flamebearer.levels.length}>
+      {nodes.map((node) => {
+        const width = (node.total / numTicks) * 100;
+        if (width < MIN_WIDTH_PERCENT) return null;
+        return (
+          <Node
+            key={node.key}
+            $hue={hueOf(node.name)}
+            title={`${node.name}\ntotal: ${node.total} (${width.toFixed(2)}%), self: ${node.self}`}
+            style={{ top: node.level * ROW_HEIGHT, left: `${(node.left / numTicks) * 100}%`, width: `${width}%` }}
+          >
+            {node.name}
+          </Node>
+        );
+      })}
+    </Graph>
+  );
+};
@@ -0,0 +1,105 @@
+'use client';
+
+import React, { useEffect, useState } from 'react';
+import { FlameGraph } from './FlameGraph';
+import { useSpanProfiling, type ProfileSpanFilter, type SourceId } from './temp-hooks/useSpanProfiling';
+import { Banner, Button, Input, Label, Page, Select, Shell, Subtitle, Title, Toolbar } from '../styled';
+
+const KINDS = ['Deployment', 'StatefulSet', 'DaemonSet', 'CronJob', 'Job', 'DeploymentConfig', 'Rollout'];
+
+const buildFilter = (traceId: string, spanId: string, httpRoute: string): ProfileSpanFilter | null => {
+  const filter: ProfileSpanFilter = {};
+  if (traceId.trim()) filter.traceId = traceId.trim();
+  if (spanId.trim()) filter.spanId = spanId.trim();
+  if (httpRoute.trim()) filter.httpRoute = httpRoute.trim();
+  return Object.keys(filter).length > 0 ? filter : null;
+};
+
+export default function SpanProfilingPage() {
+  const [namespaceInput, setNamespaceInput] = useState('');
+  const [kindInput, setKindInput] = useState(KINDS[0]);
+  const [nameInput, setNameInput] = useState('');
+  const [traceIdInput, setTraceIdInput] = useState('');
+  const [spanIdInput, setSpanIdInput] = useState('');
+  const [httpRouteInput, setHttpRouteInput] = useState('');
+  const [sourceId, setSourceId] = useState<SourceId | null>(null);
+  const [filter, setFilter] = useState<ProfileSpanFilter | null>(null);
+  const { flamebearer, loading, error } = useSpanProfiling(sourceId, filter);
+
+  // the trace correlations view links here with the source and the endpoint in the query string
+  useEffect(() => {
+    const params = new URLSearchParams(window.location.search);
+    const namespace = params.get('namespace') ?? '';
+    const kind = params.get('kind') ?? KINDS[0];
+    const name = params.get('name') ?? '';
+    const traceId = params.get('traceId') ?? '';
+    const spanId = params.get('spanId') ?? '';
+    const httpRoute = params.get('httpRoute') ?? '';
+    setNamespaceInput(namespace);
+    setKindInput(kind);
+    setNameInput(name);
+    setTraceIdInput(traceId);
+    setSpanIdInput(spanId);
+    setHttpRouteInput(httpRoute);
+
+    const linkedFilter = buildFilter(traceId, spanId, httpRoute);
+    if (namespace && name && linkedFilter) {
+      setSourceId({ namespace, kind, name });
+      setFilter(linkedFilter);
+    }
+  }, []);
+
+  const formFilter = buildFilter(traceIdInput, spanIdInput, httpRouteInput);
+
+  return (
+    <Page>
+      <Shell>
+        <Title>Span Profiling</Title>
+        <Subtitle>
+          The flame graph of the profile samples a source took while one trace, one span or any span of one endpoint (http.route) was active. Samples are linked to spans when the agent
+          publishes its active span to the profiler, and only the samples buffered since profiling was enabled for the source are included.
+        </Subtitle>
+
+        <Toolbar
+          onSubmit={(e) => {
+            e.preventDefault();
+            setSourceId({ namespace: namespaceInput.trim(), kind: kindInput, name: nameInput.trim() });
+            setFilter(formFilter);
+          }}
+        >
+          <Label htmlFor='namespace'>Namespace</Label>
+          <Input id='namespace' value={namespaceInput} placeholder='default' onChange={(e) => setNamespaceInput(e.target.value)} />
+          <Label htmlFor='kind'>Kind</Label>
+          <Select id='kind' value={kindInput} onChange={(e) => setKindInput(e.target.value)}>
+            {KINDS.map((kind) => (
+              <option key={kind} value={kind}>
+                {kind}
+              </option>
+            ))}
+          </Select>
+          <Label htmlFor='name'>Name</Label>
+          <Input id='name' value={nameInput} placeholder='checkout' onChange={(e) => setNameInput(e.target.value)} />
+          <Label htmlFor='traceId'>Trace id</Label>
+          <Input id='traceId' value={traceIdInput} onChange={(e) => setTraceIdInput(e.target.value)} />
+          <Label htmlFor='spanId'>Span id</Label>
+          <Input id='spanId' value={spanIdInput} onChange={(e) => setSpanIdInput(e.target.value)} />
+          <Label htmlFor='httpRoute'>http.route</Label>
+          <Input id='httpRoute' value={httpRouteInput} placeholder='/api/orders/{id}' onChange={(e) => setHttpRouteInput(e.target.value)} />
+          <Button type='submit' disabled={!namespaceInput.trim() || !nameInput.trim() || !formFilter || loading}>
+            Show
+          </Button>
+        </Toolbar>
+
+        {error && <Banner $tone='error'>{error.message}</Banner>}
+        {sourceId && filter && !loading && !error && flamebearer && flamebearer.numTicks === 0 && (
+          <Banner>
+            No buffered sample of {sourceId.namespace}/{sourceId.kind}/{sourceId.name} was taken during the selected span. Make sure profiling is enabled for the source, and that its agent
+            publishes the active span to the profiler.
+          </Banner>
+        )}
+
+        {flamebearer && flamebearer.numTicks > 0 && <FlameGraph flamebearer={flamebearer} />}
+      </Shell>
+    </Page>
+  );
+}
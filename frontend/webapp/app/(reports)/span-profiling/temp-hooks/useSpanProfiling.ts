@@ -0,0 +1,55 @@
+import { useMemo } from 'react';
+import { useQuery } from '@apollo/client/react';
+import { GET_SOURCE_SPAN_PROFILING } from '@/graphql';
+
+// TODO: move this to the ui-kit to work with OdigosApiContext
+
+export type SourceId = {
+  namespace: string;
+  kind: string;
+  name: string;
+};
+
+// at least one of the fields is set
+export type ProfileSpanFilter = {
+  traceId?: string;
+  spanId?: string;
+  httpRoute?: string;
+};
+
+// the flamebearer levels hold 4 values per node: x offset (delta encoded), total, self and the index in names
+export type Flamebearer = {
+  names: string[];
+  levels: number[][];
+  numTicks: number;
+  maxSelf: number;
+};
+
+type FlamebearerProfile = {
+  flamebearer: Flamebearer;
+};
+
+type SourceSpanProfilingResponse = {
+  sourceSpanProfiling: {
+    profileJson: string;
+  };
+};
+
+// the samples are read from the buffered profiles of the source, so they are fetched on demand and not polled
+export const useSpanProfiling = (sourceId: SourceId | null, filter: ProfileSpanFilter | null) => {
+  const { data, loading, error, refetch } = useQuery<SourceSpanProfilingResponse>(GET_SOURCE_SPAN_PROFILING, {
+    variables: { ...sourceId, filter },
+    skip: !sourceId || !filter,
+    fetchPolicy: 'network-only',
+  });
+
+  const profileJson = data?.sourceSpanProfiling?.profileJson;
+  const flamebearer = useMemo(() => (profileJson ? (JSON.parse(profileJson) as FlamebearerProfile).flamebearer : null), [profileJson]);
+
+  return {
+    flamebearer,
+    loading,
+    error,
+    refetch,
+  };
+};
@@ -0,0 +1,122 @@
+import styled from 'styled-components';
+
+export const Page = styled.div`
+  min-height: 100vh;
+  background: linear-gradient(180deg, #0b1020 0%, #111827 45%, #0f172a 100%);
+  color: #e2e8f0;
+  font-family: 'SF Pro Display', 'Segoe UI', system-ui, sans-serif;
+`;
+
+export const Shell = styled.div`
+  max-width: 1400px;
+  margin: 0 auto;
+  padding: 32px 24px 64px;
+`;
+
+export const Title = styled.h1`
+  margin: 0;
+  font-size: 2rem;
+  font-weight: 700;
+  color: #f8fafc;
+`;
+
+export const Subtitle = styled.p`
+  margin: 10px 0 24px;
+  max-width: 720px;
+  color: #94a3b8;
+  line-height: 1.6;
+`;
+
+export const Toolbar = styled.form`
+  display: flex;
+  flex-wrap: wrap;
+  align-items: center;
+  gap: 12px;
+  margin-bottom: 20px;
+`;
+
+export const Label = styled.label`
+  font-size: 12px;
+  letter-spacing: 0.12em;
+  text-transform: uppercase;
+  color: #94a3b8;
+`;
+
+export const Input = styled.input`
+  padding: 8px 12px;
+  border-radius: 10px;
+  border: 1px solid rgba(148, 163, 184, 0.24);
+  background: rgba(15, 23, 42, 0.72);
+  color: #e2e8f0;
+  font-size: 14px;
+`;
+
+export const Select = styled.select`
+  padding: 8px 12px;
+  border-radius: 10px;
+  border: 1px solid rgba(148, 163, 184, 0.24);
+  background: rgba(15, 23, 42, 0.72);
+  color: #e2e8f0;
+  font-size: 14px;
+`;
+
+export const Button = styled.button`
+  padding: 8px 16px;
+  border-radius: 10px;
+  border: 1px solid rgba(34, 211, 238, 0.4);
+  background: rgba(14, 165, 233, 0.16);
+  color: #e0f2fe;
+  font-size: 14px;
+  cursor: pointer;
+
+  &:disabled {
+    opacity: 0.5;
+    cursor: default;
+  }
+`;
+
+export const Banner = styled.div<{ $tone?: 'error' | 'warning' | 'info' }>`
+  margin-bottom: 20px;
+  padding: 12px 16px;
+  border-radius: 14px;
+  font-size: 14px;
+  line-height: 1.5;
+  border: 1px solid ${({ $tone }) => ($tone === 'error' ? 'rgba(248, 113, 113, 0.35)' : $tone === 'warning' ? 'rgba(251, 191, 36, 0.35)' : 'rgba(34, 211, 238, 0.22)')};
+  background: ${({ $tone }) => ($tone === 'error' ? 'rgba(239, 68, 68, 0.1)' : $tone === 'warning' ? 'rgba(251, 191, 36, 0.08)' : 'rgba(14, 165, 233, 0.08)')};
+`;
+
+export const Table = styled.table`
+  width: 100%;
+  border-collapse: collapse;
+  font-size: 14px;
+`;
+
+export const Th = styled.th`
+  text-align: left;
+  padding: 10px 12px;
+  font-size: 12px;
+  letter-spacing: 0.08em;
+  text-transform: uppercase;
+  color: #94a3b8;
+  border-bottom: 1px solid rgba(148, 163, 184, 0.2);
+`;
+
+export const Td = styled.td`
+  padding: 10px 12px;
+  vertical-align: top;
+  border-bottom: 1px solid rgba(148, 163, 184, 0.1);
+`;
+
+export const Badge = styled.span<{ $tone: 'success' | 'error' | 'warning' | 'neutral' }>`
+  display: inline-block;
+  padding: 2px 8px;
+  border-radius: 999px;
+  font-size: 12px;
+  font-weight: 600;
+  color: ${({ $tone }) => ($tone === 'success' ? '#6ee7b7' : $tone === 'error' ? '#fca5a5' : $tone === 'warning' ? '#fcd34d' : '#cbd5e1')};
+  background: ${({ $tone }) => ($tone === 'success' ? 'rgba(16, 185, 129, 0.12)' : $tone === 'error' ? 'rgba(239, 68, 68, 0.12)' : $tone === 'warning' ? 'rgba(251, 191, 36, 0.12)' : 'rgba(148, 163, 184, 0.12)')};
+`;
+
+export const Muted = styled.span`
+  color: #64748b;
+`;
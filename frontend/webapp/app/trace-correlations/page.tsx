@@ -2,6 +2,7 @@
 
 import React, { useCallback, useEffect, useLayoutEffect, useMemo, useRef, useState } from 'react';
 import styled, { keyframes } from 'styled-components';
+import { ROUTES } from '@/utils';
 import { TraceCorrelationsSettingsPanel } from './SettingsPanel';
 import { useEffectiveConfig } from './temp-hooks/useEffectiveConfig';
 import { useTraceCorrelationsSettings } from './temp-hooks/useTraceCorrelationsSettings';
@@ -849,6 +850,17 @@ const InboundPanel = styled.div`
   gap: 10px;
 `;
 
+const ProfileLink = styled.a`
+  justify-self: start;
+  font-size: 13px;
+  color: #67e8f9;
+  text-decoration: none;
+
+  &:hover {
+    text-decoration: underline;
+  }
+`;
+
 const OutboundPanel = styled.div`
   padding: 14px 16px;
   border-radius: 14px;
@@ -1336,6 +1348,12 @@ function getHttpMethodColor(method: string) {
   }
 }
 
+// buildSpanProfilingHref links to the flame graph of the samples the workload took while serving the endpoint
+function buildSpanProfilingHref(workload: TraceCorrelationsWorkload, httpRoute: string) {
+  const params = new URLSearchParams({ namespace: workload.namespace, kind: workload.kind, name: workload.name, httpRoute });
+  return `${ROUTES.SPAN_PROFILING}?${params.toString()}`;
+}
+
 function attributesToMap(attributes: { key: string; value: string }[]) {
   return Object.fromEntries(attributes.map((attr) => [attr.key, attr.value]));
 }
@@ -2878,6 +2896,7 @@ function ConnectionRow({
 }
 
 function InputGroupView({
+  workload,
   group,
   showFullData,
   baselineMs,
@@ -2885,6 +2904,7 @@ function InputGroupView({
   onValidate,
   onToggleAlert,
 }: {
+  workload: TraceCorrelationsWorkload;
   group: TraceCorrelationsInputGroup;
   showFullData: boolean;
   baselineMs: number | null;
@@ -2926,6 +2946,7 @@ function InputGroupView({
           <InboundPanel>
             <ScopeHeader attributes={group.attributes} direction='inbound' showFullData={showFullData} />
             <AttributeChips attributes={group.attributes} showFullData={showFullData} />
+            {group.httpRoute ? <ProfileLink href={buildSpanProfilingHref(workload, group.httpRoute)}>Open the flame graph of {group.httpRoute}</ProfileLink> : null}
           </InboundPanel>
 
           <FlowDivider>
@@ -3136,6 +3157,7 @@ function WorkloadCardView({
               {workload.inputs.map((input) => (
                 <InputGroupView
                   key={formatAttributes(input.attributes)}
+                  workload={workload}
                   group={input}
                   showFullData={showFullData}
                   baselineMs={baselineMs}
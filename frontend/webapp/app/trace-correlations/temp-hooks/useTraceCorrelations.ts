@@ -12,12 +12,20 @@ export type TraceCorrelationsAttribute = {
 export type TraceCorrelationsOutputSeries = {
   attributes: TraceCorrelationsAttribute[];
   connectionCount: number;
+  errorCount: number;
+  errorRate: number;
+  latencyP50Ms?: number | null;
+  latencyP95Ms?: number | null;
+  averageLatencyMs?: number | null;
+  latencyShare?: number | null;
   firstDetectedAt: string;
 };
 
 export type TraceCorrelationsInputGroup = {
   attributes: TraceCorrelationsAttribute[];
+  httpRoute?: string | null;
   outputs: TraceCorrelationsOutputSeries[];
+  dominantOutput?: Pick<TraceCorrelationsOutputSeries, 'attributes' | 'latencyShare'> | null;
 };
 
 export type TraceCorrelationsWorkload = {
@@ -64,6 +64,10 @@ export const CREATE_INSTRUMENTATION_RULE = gql`
         }
       }
       networkMetrics
+      profiling {
+        profileTypes
+        samplingFrequencyHz
+      }
     }
   }
 `;
@@ -132,6 +136,10 @@ export const UPDATE_INSTRUMENTATION_RULE = gql`
         }
       }
       networkMetrics
+      profiling {
+        profileTypes
+        samplingFrequencyHz
+      }
     }
   }
 `;
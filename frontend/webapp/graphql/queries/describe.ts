@@ -364,3 +364,44 @@ export const DESCRIBE_SOURCE = gql`
     }
   }
 `;
+
+export const GET_INJECTION_PREVIEW = gql`
+  query GetInjectionPreview($namespace: String!, $kind: String!, $name: String!) {
+    injectionPreview(namespace: $namespace, kind: $kind, name: $name) {
+      injected
+      patch {
+        op
+        path
+        value
+      }
+      reasons
+    }
+  }
+`;
+
+export const GET_COMPATIBILITY_REPORT = gql`
+  query GetCompatibilityReport($namespace: String!) {
+    compatibilityReport(namespace: $namespace) {
+      namespace
+      inspectionError
+      workloads {
+        kind
+        name
+        instrumented
+        containers {
+          containerName
+          language
+          runtimeVersion
+          otelDistroName
+          supported
+          reason
+          message
+          blockers {
+            type
+            message
+          }
+        }
+      }
+    }
+  }
+`;
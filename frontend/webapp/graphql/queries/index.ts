@@ -10,6 +10,7 @@ export * from './metrics';
 export * from './pipeline-collectors';
 export * from './peer-sources';
 export * from './profiling';
+export * from './service-level-objectives';
 export * from './service-map';
 export * from './trace-correlations';
 export * from './source';
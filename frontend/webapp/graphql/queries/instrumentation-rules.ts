@@ -91,6 +91,10 @@ export const GET_INSTRUMENTATION_RULES = gql`
           }
         }
         networkMetrics
+        profiling {
+          profileTypes
+          samplingFrequencyHz
+        }
       }
     }
   }
@@ -25,3 +25,11 @@ export const GET_SOURCE_PROFILING = gql`
     }
   }
 `;
+
+export const GET_SOURCE_SPAN_PROFILING = gql`
+  query GetSourceSpanProfiling($namespace: String!, $kind: String!, $name: String!, $filter: ProfileSpanFilterInput!) {
+    sourceSpanProfiling(namespace: $namespace, kind: $kind, name: $name, filter: $filter) {
+      profileJson
+    }
+  }
+`;
@@ -0,0 +1,33 @@
+import { gql } from '@apollo/client';
+
+export const GET_SERVICE_LEVEL_OBJECTIVES = gql`
+  query GetServiceLevelObjectives($filter: WorkloadFilter) {
+    serviceLevelObjectives(filter: $filter) {
+      name
+      namespace
+      kind
+      workloadName
+      type
+      target
+      latencyThresholdMs
+      httpRoute
+      httpRoutePrefix
+      httpMethod
+      keepBudgetBurningTraces
+      sli
+      sliWindow
+      errorBudgetRemaining
+      burnRates {
+        window
+        burnRate
+      }
+      lastEvaluationTime
+      conditions {
+        status
+        type
+        reason
+        message
+      }
+    }
+  }
+`;
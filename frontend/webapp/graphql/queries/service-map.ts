@@ -16,6 +16,47 @@ export const GET_SERVICE_MAP = gql`
             key
             value
           }
+          metrics {
+            requests
+            requestRate
+            errorRate
+            latencyP50Ms
+            latencyP95Ms
+            latencyP99Ms
+            health
+          }
+        }
+      }
+    }
+  }
+`;
+
+export const GET_SERVICE_MAP_DIFF = gql`
+  query GetServiceMapDiff($baseline: ServiceMapTimeRange!, $current: ServiceMapTimeRange) {
+    serviceMapDiff(baseline: $baseline, current: $current) {
+      baselineFrom
+      baselineTo
+      currentFrom
+      currentTo
+      edges {
+        fromNodeId
+        fromServiceName
+        toNodeId
+        toServiceName
+        isVirtual
+        change
+        details
+        baseline {
+          requestRate
+          errorRate
+          latencyP95Ms
+          health
+        }
+        current {
+          requestRate
+          errorRate
+          latencyP95Ms
+          health
         }
       }
     }
@@ -32,6 +32,30 @@ export const GET_SOURCE = gql`
   }
 `;
 
+export const GET_SOURCE_AGENT_OVERHEAD = gql`
+  query GetSourceAgentOverhead($sourceId: K8sSourceId!) {
+    computePlatform {
+      source(sourceId: $sourceId) {
+        agentOverhead {
+          instrumentationTime
+          window
+          measurementTime
+          containers {
+            containerName
+            cpuMillicoresBefore
+            cpuMillicoresAfter
+            memoryBytesBefore
+            memoryBytesAfter
+            runtimeCpuMillicoresAfter
+            runtimeMemoryBytesAfter
+          }
+          message
+        }
+      }
+    }
+  }
+`;
+
 export const GET_SOURCE_LIBRARIES = gql`
   query GetSourceLibraries($namespace: String!, $kind: String!, $name: String!) {
     instrumentationInstanceComponents(namespace: $namespace, kind: $kind, name: $name) {
@@ -16,14 +16,28 @@ export const GET_TRACE_CORRELATIONS = gql`
             key
             value
           }
+          httpRoute
           outputs {
             attributes {
               key
               value
             }
             connectionCount
+            errorCount
+            errorRate
+            latencyP50Ms
+            latencyP95Ms
+            averageLatencyMs
+            latencyShare
             firstDetectedAt
           }
+          dominantOutput {
+            attributes {
+              key
+              value
+            }
+            latencyShare
+          }
         }
       }
     }
@@ -14,6 +14,10 @@
  *
  * Mounts at the layout level once. Pages stay one-liners:
  *   `export default function Page() { return <Overview metrics={…} />; }`
+ *
+ * Views the kit has no operation for yet (trace correlations, and the report views under
+ * `app/(reports)`: compatibility report, service map diff, ...) mount this adapter in their
+ * layout and run their queries with `useQuery` against the Apollo client it provides.
  */
 
 import React, { type FC, type PropsWithChildren, useCallback, useEffect, useMemo, useState } from 'react';
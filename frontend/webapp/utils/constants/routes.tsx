@@ -7,6 +7,11 @@ export const ROUTES = {
   SETTINGS: '/settings',
   SAMPLING: '/sampling',
   TRACE_CORRELATIONS: '/trace-correlations',
+  COMPATIBILITY_REPORT: '/compatibility-report',
+  SERVICE_MAP_DIFF: '/service-map-diff',
+  SERVICE_LEVEL_OBJECTIVES: '/service-level-objectives',
+  AGENT_OVERHEAD: '/agent-overhead',
+  SPAN_PROFILING: '/span-profiling',
 
   // legacy routes
   CHOOSE_STREAM: '/choose-stream',
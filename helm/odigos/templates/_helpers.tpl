@@ -253,3 +253,20 @@ imagePullSecrets:
 {{- define "traceCorrelations.serviceIO.enabled" -}}
 {{- and .Values.traceCorrelations .Values.traceCorrelations.serviceIO .Values.traceCorrelations.serviceIO.enabled -}}
 {{- end }}
+
+{{/*
+Returns the odigos agents image of an agent version channel.
+Expects a dict with Values, Release and Channel (the channel values, with a tag or an explicit image).
+*/}}
+{{- define "odigos.agentVersionChannelImage" -}}
+{{- if .Channel.image -}}
+  {{- .Channel.image -}}
+{{- else if not .Channel.tag -}}
+  {{- fail "Error: each instrumentor.agentVersionChannels entry must set a tag or an image." -}}
+{{- else if include "odigos.secretExists" . -}}
+  {{- $extendedSuffix := ternary "-extended" "" (eq (toString .Values.odiglet.extended) "true") -}}
+  {{- template "utils.imageName" (dict "Values" .Values "Release" .Release "Component" "enterprise-agents" "Tag" (printf "%s%s" .Channel.tag $extendedSuffix)) -}}
+{{- else -}}
+  {{- template "utils.imageName" (dict "Values" .Values "Release" .Release "Component" "agents" "Tag" .Channel.tag) -}}
+{{- end -}}
+{{- end -}}
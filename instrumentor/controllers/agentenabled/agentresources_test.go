@@ -0,0 +1,118 @@
+package agentenabled
+
+import (
+	"testing"
+
+	odigosv1alpha1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/distros/distro"
+	"github.com/odigos-io/odigos/instrumentor/controllers/agentenabled/podswebhook"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestAgentResourcesDelta(t *testing.T) {
+	javaDistro := &distro.OtelDistro{
+		Name:     "java-community",
+		Language: common.JavaProgrammingLanguage,
+		RuntimeAgent: &distro.RuntimeAgent{
+			ResourceOverhead: &distro.ResourceOverhead{Memory: "150Mi", CPU: "100m"},
+		},
+	}
+	useMeasured := true
+	measured := &odigosv1alpha1.ContainerAgentOverhead{
+		ContainerName:       "app",
+		CPUMillicoresBefore: 200,
+		CPUMillicoresAfter:  180,
+		MemoryBytesBefore:   100 * 1024 * 1024,
+		MemoryBytesAfter:    300 * 1024 * 1024,
+	}
+
+	// the distro estimate is used by default
+	delta := podswebhook.AgentResourcesDelta(&common.AgentResourcesAdjustmentConfiguration{}, javaDistro, measured)
+	assert.True(t, resource.MustParse("100m").Equal(delta[corev1.ResourceCPU]))
+	assert.True(t, resource.MustParse("150Mi").Equal(delta[corev1.ResourceMemory]))
+
+	// the language delta replaces the distro estimate
+	config := &common.AgentResourcesAdjustmentConfiguration{
+		Languages: map[common.ProgrammingLanguage]common.AgentResourcesDelta{
+			common.JavaProgrammingLanguage: {MemoryMiB: 256},
+		},
+	}
+	delta = podswebhook.AgentResourcesDelta(config, javaDistro, measured)
+	assert.True(t, resource.MustParse("100m").Equal(delta[corev1.ResourceCPU]))
+	assert.True(t, resource.MustParse("256Mi").Equal(delta[corev1.ResourceMemory]))
+
+	// a positive measured overhead replaces both, a negative one is ignored
+	config.UseMeasuredOverhead = &useMeasured
+	delta = podswebhook.AgentResourcesDelta(config, javaDistro, measured)
+	assert.True(t, resource.MustParse("100m").Equal(delta[corev1.ResourceCPU]))
+	assert.True(t, resource.MustParse("200Mi").Equal(delta[corev1.ResourceMemory]))
+}
+
+func TestAgentResourcesDelta_NoRuntimeAgent(t *testing.T) {
+	goDistro := &distro.OtelDistro{
+		Name:     "golang-community",
+		Language: common.GoProgrammingLanguage,
+	}
+	useMeasured := true
+	config := &common.AgentResourcesAdjustmentConfiguration{
+		UseMeasuredOverhead: &useMeasured,
+		Languages: map[common.ProgrammingLanguage]common.AgentResourcesDelta{
+			common.GoProgrammingLanguage: {CPUm: 50, MemoryMiB: 64},
+		},
+	}
+	measured := &odigosv1alpha1.ContainerAgentOverhead{
+		ContainerName:       "app",
+		CPUMillicoresBefore: 100,
+		CPUMillicoresAfter:  150,
+		MemoryBytesBefore:   100 * 1024 * 1024,
+		MemoryBytesAfter:    150 * 1024 * 1024,
+	}
+
+	// the agent runs outside of the container, so its resources are not raised
+	delta := podswebhook.AgentResourcesDelta(config, goDistro, measured)
+	assert.Empty(t, delta)
+}
+
+func TestAddAgentResourcesToContainer(t *testing.T) {
+	container := &corev1.Container{
+		Name: "app",
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("250m"),
+				corev1.ResourceMemory: resource.MustParse("256Mi"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("512Mi"),
+			},
+		},
+	}
+
+	podswebhook.AddAgentResourcesToContainer(container, corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("100m"),
+		corev1.ResourceMemory: resource.MustParse("150Mi"),
+	})
+
+	assert.True(t, resource.MustParse("350m").Equal(container.Resources.Requests[corev1.ResourceCPU]))
+	assert.True(t, resource.MustParse("406Mi").Equal(container.Resources.Requests[corev1.ResourceMemory]))
+	assert.True(t, resource.MustParse("662Mi").Equal(container.Resources.Limits[corev1.ResourceMemory]))
+	// a resource without a limit stays unbounded
+	_, found := container.Resources.Limits[corev1.ResourceCPU]
+	assert.False(t, found)
+}
+
+func TestMeasuredAgentOverhead(t *testing.T) {
+	ic := &odigosv1alpha1.InstrumentationConfig{}
+	assert.Nil(t, measuredAgentOverhead(ic, "app"))
+
+	ic.Status.AgentOverhead = &odigosv1alpha1.AgentOverhead{
+		Containers: []odigosv1alpha1.ContainerAgentOverhead{
+			{ContainerName: "sidecar", MemoryBytesAfter: 1},
+			{ContainerName: "app", MemoryBytesAfter: 2},
+		},
+	}
+	assert.Equal(t, int64(2), measuredAgentOverhead(ic, "app").MemoryBytesAfter)
+	assert.Nil(t, measuredAgentOverhead(ic, "other"))
+}
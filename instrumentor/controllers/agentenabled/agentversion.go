@@ -0,0 +1,55 @@
+package agentenabled
+
+import (
+	"os"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/consts"
+	"github.com/odigos-io/odigos/distros/distro"
+)
+
+// resolveAgentVersionChannel returns the agent version channel to serve the agent files of a container from,
+// based on the first rule (rules are sorted by namespace and name) which selects a channel.
+// Empty means the stable channel, which is also used when the selected channel is not configured.
+func resolveAgentVersionChannel(rules []odigosv1.InstrumentationRule, d *distro.OtelDistro, effectiveConfig *common.OdigosConfiguration) common.AgentVersionChannel {
+	// eBPF agents are loaded by odiglet, and user-defined distributions bring their own agent files,
+	// so only the agents mounted from the odigos agents directory can be served from a channel.
+	if d.IsEbpf || d.RuntimeAgent == nil || len(d.RuntimeAgent.DirectoryNames) == 0 || d.RuntimeAgent.AgentFiles != nil {
+		return ""
+	}
+
+	for _, rule := range rules {
+		if rule.Spec.AgentVersion == nil {
+			continue
+		}
+		channel := rule.Spec.AgentVersion.Channel
+		if _, configured := effectiveConfig.AgentVersionChannels[channel]; !configured {
+			return ""
+		}
+		return channel
+	}
+	return ""
+}
+
+// calculateAgentVersions reports the effective agents version of each container with an enabled agent.
+func calculateAgentVersions(containers []odigosv1.ContainerAgentConfig, effectiveConfig *common.OdigosConfiguration) []odigosv1.ContainerAgentVersion {
+	var agentVersions []odigosv1.ContainerAgentVersion
+	for _, containerConfig := range containers {
+		if !containerConfig.AgentEnabled {
+			continue
+		}
+
+		agentVersion := odigosv1.ContainerAgentVersion{
+			ContainerName: containerConfig.ContainerName,
+			Channel:       common.StableAgentVersionChannel,
+			Version:       os.Getenv(consts.OdigosVersionEnvVarName),
+		}
+		if containerConfig.AgentVersionChannel != "" {
+			agentVersion.Channel = containerConfig.AgentVersionChannel
+			agentVersion.Version = effectiveConfig.AgentVersionChannels[containerConfig.AgentVersionChannel].Version
+		}
+		agentVersions = append(agentVersions, agentVersion)
+	}
+	return agentVersions
+}
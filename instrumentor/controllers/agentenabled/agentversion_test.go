@@ -0,0 +1,76 @@
+package agentenabled
+
+import (
+	"testing"
+
+	odigosv1alpha1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/api/instrumentationrules"
+	"github.com/odigos-io/odigos/distros/distro"
+	"github.com/stretchr/testify/assert"
+)
+
+func agentVersionRule(channel common.AgentVersionChannel) odigosv1alpha1.InstrumentationRule {
+	return odigosv1alpha1.InstrumentationRule{
+		Spec: odigosv1alpha1.InstrumentationRuleSpec{
+			AgentVersion: &instrumentationrules.AgentVersion{Channel: channel},
+		},
+	}
+}
+
+func TestResolveAgentVersionChannel(t *testing.T) {
+	config := &common.OdigosConfiguration{
+		AgentVersionChannels: map[common.AgentVersionChannel]common.AgentVersionChannelConfiguration{
+			common.PreviousAgentVersionChannel: {Version: "v1.10.0", Image: "registry.odigos.io/odigos-agents:v1.10.0"},
+		},
+	}
+	runtimeAgentDistro := &distro.OtelDistro{
+		Name:         "java-community",
+		RuntimeAgent: &distro.RuntimeAgent{DirectoryNames: []string{"{{ODIGOS_AGENTS_DIR}}/java"}},
+	}
+
+	// no rule selects a channel
+	assert.Empty(t, resolveAgentVersionChannel(nil, runtimeAgentDistro, config))
+
+	// the first rule selecting a channel is used
+	rules := []odigosv1alpha1.InstrumentationRule{
+		{},
+		agentVersionRule(common.PreviousAgentVersionChannel),
+		agentVersionRule(common.CanaryAgentVersionChannel),
+	}
+	assert.Equal(t, common.PreviousAgentVersionChannel, resolveAgentVersionChannel(rules, runtimeAgentDistro, config))
+
+	// a channel which is not configured falls back to stable
+	rules = []odigosv1alpha1.InstrumentationRule{agentVersionRule(common.CanaryAgentVersionChannel)}
+	assert.Empty(t, resolveAgentVersionChannel(rules, runtimeAgentDistro, config))
+
+	// eBPF agents are always the installed version
+	ebpfDistro := &distro.OtelDistro{
+		Name:         "golang-community",
+		IsEbpf:       true,
+		RuntimeAgent: &distro.RuntimeAgent{DirectoryNames: []string{"{{ODIGOS_AGENTS_DIR}}/golang"}},
+	}
+	rules = []odigosv1alpha1.InstrumentationRule{agentVersionRule(common.PreviousAgentVersionChannel)}
+	assert.Empty(t, resolveAgentVersionChannel(rules, ebpfDistro, config))
+}
+
+func TestCalculateAgentVersions(t *testing.T) {
+	t.Setenv("ODIGOS_VERSION", "v1.11.0")
+	config := &common.OdigosConfiguration{
+		AgentVersionChannels: map[common.AgentVersionChannel]common.AgentVersionChannelConfiguration{
+			common.PreviousAgentVersionChannel: {Version: "v1.10.0", Image: "registry.odigos.io/odigos-agents:v1.10.0"},
+		},
+	}
+	containers := []odigosv1alpha1.ContainerAgentConfig{
+		{ContainerName: "app", AgentEnabled: true, AgentVersionChannel: common.PreviousAgentVersionChannel},
+		{ContainerName: "sidecar", AgentEnabled: true},
+		{ContainerName: "ignored", AgentEnabled: false},
+	}
+
+	agentVersions := calculateAgentVersions(containers, config)
+
+	assert.Equal(t, []odigosv1alpha1.ContainerAgentVersion{
+		{ContainerName: "app", Channel: common.PreviousAgentVersionChannel, Version: "v1.10.0"},
+		{ContainerName: "sidecar", Channel: common.StableAgentVersionChannel, Version: "v1.11.0"},
+	}, agentVersions)
+}
@@ -0,0 +1,329 @@
+package agentenabled
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	commonlogger "github.com/odigos-io/odigos/common/logger"
+	"github.com/odigos-io/odigos/distros"
+	"github.com/odigos-io/odigos/distros/distro"
+	"github.com/odigos-io/odigos/instrumentor/controllers/agentenabled/distroresolver"
+	"github.com/odigos-io/odigos/k8sutils/pkg/compatibility"
+	k8sutils "github.com/odigos-io/odigos/k8sutils/pkg/utils"
+	"github.com/odigos-io/odigos/k8sutils/pkg/workload"
+)
+
+// CompatibilityReportHandler serves the pre-flight compatibility report of the workloads in a namespace.
+// For each container it reports the detected language and runtime version, the distro odigos would use,
+// whether an agent would be enabled, and known blockers, without instrumenting anything.
+// Callers reach it through the api server service proxy, and check their own access to the pods of the namespace before calling it.
+type CompatibilityReportHandler struct {
+	Client client.Client
+	// APIReader reads workloads without the manager cache, which strips the pod templates of workloads.
+	APIReader       client.Reader
+	DistrosProvider *distros.Provider
+	// InspectNamespace returns the runtime details detected by odiglet for the running workloads of a namespace.
+	// It is used for workloads which are not instrumented, and thus have no runtime details in an instrumentation config.
+	InspectNamespace func(ctx context.Context, namespace string) ([]compatibility.InspectedWorkload, error)
+}
+
+var _ http.Handler = &CompatibilityReportHandler{}
+
+func (h *CompatibilityReportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := commonlogger.FromContext(r.Context())
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	namespace := r.URL.Query().Get("namespace")
+	if namespace == "" {
+		http.Error(w, "namespace is required", http.StatusBadRequest)
+		return
+	}
+
+	report, err := h.Report(r.Context(), namespace)
+	if err != nil {
+		logger.Error(err, "failed to compute compatibility report", "namespace", namespace)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		logger.Error(err, "failed to write compatibility report response")
+	}
+}
+
+// Report computes the compatibility report of the deployments, daemonsets, statefulsets, cronjobs,
+// deployment configs and argo rollouts of a namespace.
+// Runtime details are taken from the instrumentation config of instrumented workloads,
+// and inspected by odiglet on demand for the rest.
+func (h *CompatibilityReportHandler) Report(ctx context.Context, namespace string) (*compatibility.NamespaceReport, error) {
+	effectiveConfig, err := k8sutils.GetCurrentOdigosConfiguration(ctx, h.Client)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMissingOdigosConfiguration, err)
+	}
+	irls, err := getRelevantInstrumentationRules(ctx, h.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	workloads, err := h.listWorkloads(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var icList odigosv1.InstrumentationConfigList
+	if err := h.Client.List(ctx, &icList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	icByName := make(map[string]*odigosv1.InstrumentationConfig, len(icList.Items))
+	for i := range icList.Items {
+		icByName[icList.Items[i].Name] = &icList.Items[i]
+	}
+
+	report := &compatibility.NamespaceReport{
+		Namespace: namespace,
+		Workloads: make([]compatibility.WorkloadReport, 0, len(workloads)),
+	}
+
+	inspected, err := h.InspectNamespace(ctx, namespace)
+	if err != nil {
+		// some of the nodes might still return results, the rest of the containers are reported without runtime details
+		report.InspectionError = err.Error()
+	}
+	inspectedByWorkload := make(map[k8sconsts.PodWorkload][]odigosv1.RuntimeDetailsByContainer, len(inspected))
+	for _, w := range inspected {
+		inspectedByWorkload[k8sconsts.PodWorkload{Namespace: namespace, Kind: w.Kind, Name: w.Name}] = w.RuntimeDetails
+	}
+
+	for _, w := range workloads {
+		pw := k8sconsts.PodWorkload{
+			Namespace: namespace,
+			Kind:      w.kind,
+			Name:      w.obj.GetName(),
+		}
+		template, err := podTemplateOfWorkload(w.obj)
+		if err != nil {
+			// e.g. argo rollouts which reference another workload, whose pods are reported with that workload
+			commonlogger.FromContext(ctx).Info("skipping workload without a pod template in compatibility report", "workload", pw, "reason", err.Error())
+			continue
+		}
+
+		ic := icByName[workload.CalculateWorkloadRuntimeObjectName(pw.Name, pw.Kind)]
+		runtimeDetails := map[string]*odigosv1.RuntimeDetailsByContainer{}
+		if ic != nil {
+			runtimeDetails = ic.RuntimeDetailsByContainer()
+		}
+		inspectedDetails := inspectedByWorkload[pw]
+		for i := range inspectedDetails {
+			if runtimeDetails[inspectedDetails[i].ContainerName] == nil {
+				runtimeDetails[inspectedDetails[i].ContainerName] = &inspectedDetails[i]
+			}
+		}
+
+		workloadReport := compatibility.WorkloadReport{
+			Kind:         pw.Kind,
+			Name:         pw.Name,
+			Instrumented: ic != nil,
+			Containers:   make([]compatibility.ContainerReport, 0, len(template.Spec.Containers)),
+		}
+		for i := range template.Spec.Containers {
+			container := &template.Spec.Containers[i]
+			var containerOverride *odigosv1.ContainerOverride
+			if ic != nil {
+				containerOverride = ic.GetOverridesForContainer(container.Name)
+			}
+			workloadReport.Containers = append(workloadReport.Containers,
+				h.containerReport(pw, container, runtimeDetails[container.Name], containerOverride, irls, &effectiveConfig))
+		}
+		report.Workloads = append(report.Workloads, workloadReport)
+	}
+
+	return report, nil
+}
+
+func (h *CompatibilityReportHandler) containerReport(pw k8sconsts.PodWorkload, container *corev1.Container,
+	runtimeDetails *odigosv1.RuntimeDetailsByContainer, containerOverride *odigosv1.ContainerOverride,
+	irls *[]odigosv1.InstrumentationRule, effectiveConfig *common.OdigosConfiguration,
+) compatibility.ContainerReport {
+	containerReport := compatibility.ContainerReport{
+		ContainerName: container.Name,
+	}
+	containerLanguage := common.UnknownProgrammingLanguage
+	if runtimeDetails != nil {
+		if runtimeDetails.Language != "" {
+			containerLanguage = runtimeDetails.Language
+		}
+		containerReport.Language = containerLanguage
+		containerReport.RuntimeVersion = runtimeDetails.RuntimeVersion
+	}
+
+	rulesForContainer := instrumentationRulesForContainer(irls, pw, containerLanguage)
+	distroPerLanguage := distroresolver.CalculateDefaultDistroPerLanguage(h.DistrosProvider.GetDefaultDistroNames(), &rulesForContainer, h.DistrosProvider.Getter)
+	d, disabledInfo := distroresolver.ResolveDistroForContainer(effectiveConfig, runtimeDetails, distroPerLanguage, h.DistrosProvider.Getter, containerOverride, container.Name)
+	if disabledInfo != nil {
+		containerReport.Reason = string(disabledInfo.AgentEnabledReason)
+		containerReport.Message = disabledInfo.AgentEnabledMessage
+	} else {
+		allowConcurrentAgents := resolveAllowConcurrentAgents(effectiveConfig, containerOverride)
+		agentConfig := calculateContainerAgentConfig(container.Name, d, effectiveConfig, runtimeDetails, false, "", allowConcurrentAgents)
+		containerReport.OtelDistroName = d.Name
+		containerReport.Supported = agentConfig.AgentEnabled
+		containerReport.Reason = string(agentConfig.AgentEnabledReason)
+		containerReport.Message = agentConfig.AgentEnabledMessage
+	}
+
+	resourcesAdjusted := common.AgentResourcesAdjustmentActive(effectiveConfig.AgentResourcesAdjustment)
+	containerReport.Blockers = containerBlockers(container, runtimeDetails, d, resourcesAdjusted)
+	return containerReport
+}
+
+// containerBlockers lists the known conditions of a container which can break the agent or the application once instrumented.
+// d is the distro odigos would use for the container, and is nil when there is none.
+// resourcesAdjusted is true when the pods webhook adds the agent overhead to the container resources, so headroom is not a concern.
+func containerBlockers(container *corev1.Container, runtimeDetails *odigosv1.RuntimeDetailsByContainer, d *distro.OtelDistro,
+	resourcesAdjusted bool,
+) []compatibility.Blocker {
+	var blockers []compatibility.Blocker
+
+	// eBPF agents run in odiglet, and are not affected by the container filesystem
+	agentRunsInContainer := d == nil || d.RuntimeAgent != nil
+	if agentRunsInContainer && container.SecurityContext != nil && container.SecurityContext.ReadOnlyRootFilesystem != nil && *container.SecurityContext.ReadOnlyRootFilesystem {
+		blockers = append(blockers, compatibility.Blocker{
+			Type:    compatibility.BlockerTypeReadOnlyRootFilesystem,
+			Message: "container root filesystem is read-only, agents which write to the container filesystem may fail",
+		})
+	}
+
+	if runtimeDetails != nil && len(runtimeDetails.OtherAgents) > 0 {
+		blockers = append(blockers, compatibility.Blocker{
+			Type:    compatibility.BlockerTypeOtherAgent,
+			Message: fmt.Sprintf("other instrumentation agent(s) [%s] detected running in the container", otherAgentNames(runtimeDetails.OtherAgents)),
+		})
+	}
+
+	if runtimeDetails != nil && runtimeDetails.SecureExecutionMode != nil && *runtimeDetails.SecureExecutionMode {
+		blockers = append(blockers, compatibility.Blocker{
+			Type:    compatibility.BlockerTypeSecureExecutionMode,
+			Message: "process runs in secure-execution mode, LD_PRELOAD is ignored and the odigos loader cannot be used",
+		})
+	}
+
+	if resourcesAdjusted {
+		return blockers
+	}
+	return append(blockers, resourceHeadroomBlockers(container, d)...)
+}
+
+// resourceHeadroomBlockers compares the room between the requests and limits of a container
+// with the typical resource overhead of the agent of distro d.
+func resourceHeadroomBlockers(container *corev1.Container, d *distro.OtelDistro) []compatibility.Blocker {
+	if d == nil || d.RuntimeAgent == nil || d.RuntimeAgent.ResourceOverhead == nil {
+		return nil
+	}
+	overheads := []struct {
+		name  corev1.ResourceName
+		value string
+	}{
+		{name: corev1.ResourceMemory, value: d.RuntimeAgent.ResourceOverhead.Memory},
+		{name: corev1.ResourceCPU, value: d.RuntimeAgent.ResourceOverhead.CPU},
+	}
+
+	var blockers []compatibility.Blocker
+	for _, o := range overheads {
+		if o.value == "" {
+			continue
+		}
+		overhead, err := resource.ParseQuantity(o.value)
+		if err != nil {
+			continue
+		}
+		limit, found := container.Resources.Limits[o.name]
+		if !found || limit.IsZero() {
+			continue
+		}
+		request, found := container.Resources.Requests[o.name]
+		if !found {
+			// kubernetes defaults the request to the limit
+			request = limit
+		}
+		headroom := limit.DeepCopy()
+		headroom.Sub(request)
+		if headroom.Cmp(overhead) < 0 {
+			blockers = append(blockers, compatibility.Blocker{
+				Type: compatibility.BlockerTypeResourceHeadroom,
+				Message: fmt.Sprintf("%s limit %s leaves %s above the request %s, the %s agent typically adds %s",
+					o.name, limit.String(), headroom.String(), request.String(), d.Name, overhead.String()),
+			})
+		}
+	}
+	return blockers
+}
+
+// reportedWorkloadKinds are the kinds of workloads listed in the compatibility report of a namespace, in report order.
+var reportedWorkloadKinds = []k8sconsts.WorkloadKind{
+	k8sconsts.WorkloadKindDeployment,
+	k8sconsts.WorkloadKindDaemonSet,
+	k8sconsts.WorkloadKindStatefulSet,
+	k8sconsts.WorkloadKindCronJob,
+	k8sconsts.WorkloadKindDeploymentConfig,
+	k8sconsts.WorkloadKindArgoRollout,
+}
+
+// optionalWorkloadKinds are reported only in clusters which serve their api (openshift, argo rollouts installed).
+var optionalWorkloadKinds = map[k8sconsts.WorkloadKind]bool{
+	k8sconsts.WorkloadKindDeploymentConfig: true,
+	k8sconsts.WorkloadKindArgoRollout:      true,
+}
+
+type namespaceWorkload struct {
+	kind k8sconsts.WorkloadKind
+	obj  client.Object
+}
+
+// listWorkloads lists the workloads of a namespace with their pod templates, sorted by kind and name.
+func (h *CompatibilityReportHandler) listWorkloads(ctx context.Context, namespace string) ([]namespaceWorkload, error) {
+	var workloads []namespaceWorkload
+	for _, kind := range reportedWorkloadKinds {
+		list := workload.ClientListObjectFromWorkloadKind(kind)
+		if err := h.APIReader.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			if optionalWorkloadKinds[kind] && (meta.IsNoMatchError(err) || apierrors.IsForbidden(err)) {
+				continue
+			}
+			return nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		kindWorkloads := make([]namespaceWorkload, 0, len(items))
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
+			kindWorkloads = append(kindWorkloads, namespaceWorkload{kind: kind, obj: obj})
+		}
+		slices.SortFunc(kindWorkloads, func(a, b namespaceWorkload) int {
+			return cmp.Compare(a.obj.GetName(), b.obj.GetName())
+		})
+		workloads = append(workloads, kindWorkloads...)
+	}
+	return workloads, nil
+}
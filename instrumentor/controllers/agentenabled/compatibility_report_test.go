@@ -0,0 +1,173 @@
+package agentenabled
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	argorolloutsv1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	odigosv1alpha1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/consts"
+	"github.com/odigos-io/odigos/distros"
+	"github.com/odigos-io/odigos/instrumentor/internal/testutil"
+	"github.com/odigos-io/odigos/k8sutils/pkg/compatibility"
+	"github.com/odigos-io/odigos/k8sutils/pkg/env"
+	agentInjectionEnabled "github.com/odigos-io/odigos/status/instrumentationconfig/generated"
+)
+
+func newCompatibilityReportHandler(t *testing.T, s *syncTestSetup, inspected []compatibility.InspectedWorkload, inspectErr error, objs ...client.Object) *CompatibilityReportHandler {
+	getter, err := distros.NewCommunityGetter()
+	require.NoError(t, err)
+	provider, err := distros.NewProvider(distros.NewCommunityDefaulter(), getter)
+	require.NoError(t, err)
+
+	effectiveConfig := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      consts.OdigosEffectiveConfigName,
+			Namespace: env.GetCurrentNamespace(),
+		},
+		Data: map[string]string{
+			consts.OdigosConfigurationFileName: "agentEnvVarsInjectionMethod: pod-manifest\n",
+		},
+	}
+	fakeClient := s.newFakeClient(append(objs, effectiveConfig)...)
+	return &CompatibilityReportHandler{
+		Client:          fakeClient,
+		APIReader:       fakeClient,
+		DistrosProvider: provider,
+		InspectNamespace: func(ctx context.Context, namespace string) ([]compatibility.InspectedWorkload, error) {
+			return inspected, inspectErr
+		},
+	}
+}
+
+func TestCompatibilityReport_InspectedWorkloadWithBlockers(t *testing.T) {
+	s := newSyncTestSetup()
+	readOnly := true
+	secureExecution := true
+	deployment := testutil.NewMockTestDeployment(s.ns, "test-deployment")
+	deployment.Spec.Template.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{
+		ReadOnlyRootFilesystem: &readOnly,
+	}
+	deployment.Spec.Template.Spec.Containers[0].Resources = corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+		Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("512Mi")},
+	}
+	inspected := []compatibility.InspectedWorkload{
+		{
+			Kind: k8sconsts.WorkloadKindDeployment,
+			Name: deployment.Name,
+			RuntimeDetails: []odigosv1alpha1.RuntimeDetailsByContainer{
+				{
+					ContainerName:       "test",
+					Language:            common.JavaProgrammingLanguage,
+					RuntimeVersion:      "17.0.2",
+					SecureExecutionMode: &secureExecution,
+				},
+			},
+		},
+	}
+	h := newCompatibilityReportHandler(t, s, inspected, nil, deployment)
+
+	report, err := h.Report(s.ctx, s.ns.Name)
+
+	require.NoError(t, err)
+	require.Len(t, report.Workloads, 1)
+	workloadReport := report.Workloads[0]
+	assert.Equal(t, k8sconsts.WorkloadKindDeployment, workloadReport.Kind)
+	assert.False(t, workloadReport.Instrumented)
+	require.Len(t, workloadReport.Containers, 1)
+	containerReport := workloadReport.Containers[0]
+	assert.Equal(t, common.JavaProgrammingLanguage, containerReport.Language)
+	assert.Equal(t, "17.0.2", containerReport.RuntimeVersion)
+	assert.Equal(t, "java-community", containerReport.OtelDistroName)
+	assert.True(t, containerReport.Supported)
+
+	blockerTypes := make([]compatibility.BlockerType, 0, len(containerReport.Blockers))
+	for _, blocker := range containerReport.Blockers {
+		blockerTypes = append(blockerTypes, blocker.Type)
+	}
+	assert.Equal(t, []compatibility.BlockerType{
+		compatibility.BlockerTypeReadOnlyRootFilesystem,
+		compatibility.BlockerTypeSecureExecutionMode,
+		compatibility.BlockerTypeResourceHeadroom,
+	}, blockerTypes)
+}
+
+func TestCompatibilityReport_InstrumentedWorkloadWithOtherAgent(t *testing.T) {
+	s := newSyncTestSetup()
+	deployment := testutil.NewMockTestDeployment(s.ns, "test-deployment")
+	ic := testutil.NewMockInstrumentationConfig(deployment)
+	ic.Spec.ContainersOverrides = []odigosv1alpha1.ContainerOverride{{ContainerName: "test"}}
+	ic.Status.RuntimeDetailsByContainer = []odigosv1alpha1.RuntimeDetailsByContainer{
+		{
+			ContainerName:  "test",
+			Language:       common.PythonProgrammingLanguage,
+			RuntimeVersion: "3.11.4",
+			OtherAgents:    []odigosv1alpha1.OtherAgent{{Name: "New Relic Agent"}},
+		},
+	}
+	h := newCompatibilityReportHandler(t, s, nil, nil, deployment, ic)
+
+	report, err := h.Report(s.ctx, s.ns.Name)
+
+	require.NoError(t, err)
+	require.Len(t, report.Workloads, 1)
+	assert.True(t, report.Workloads[0].Instrumented)
+	containerReport := report.Workloads[0].Containers[0]
+	assert.Equal(t, common.PythonProgrammingLanguage, containerReport.Language)
+	assert.False(t, containerReport.Supported)
+	assert.Equal(t, string(agentInjectionEnabled.AgentEnabledReasonOtherAgentDetected), containerReport.Reason)
+	require.Len(t, containerReport.Blockers, 1)
+	assert.Equal(t, compatibility.BlockerTypeOtherAgent, containerReport.Blockers[0].Type)
+}
+
+func TestCompatibilityReport_NoRuntimeDetails(t *testing.T) {
+	s := newSyncTestSetup()
+	deployment := testutil.NewMockTestDeployment(s.ns, "test-deployment")
+	h := newCompatibilityReportHandler(t, s, nil, errors.New("node-1: connection refused"), deployment)
+
+	report, err := h.Report(s.ctx, s.ns.Name)
+
+	require.NoError(t, err)
+	assert.Equal(t, "node-1: connection refused", report.InspectionError)
+	require.Len(t, report.Workloads, 1)
+	containerReport := report.Workloads[0].Containers[0]
+	assert.Empty(t, containerReport.Language)
+	assert.False(t, containerReport.Supported)
+	assert.Equal(t, string(agentInjectionEnabled.AgentEnabledReasonRuntimeDetailsUnavailable), containerReport.Reason)
+	assert.Empty(t, containerReport.Blockers)
+}
+
+func TestCompatibilityReport_ArgoRollout(t *testing.T) {
+	s := newSyncTestSetup()
+	deployment := testutil.NewMockTestDeployment(s.ns, "test-deployment")
+	rollout := &argorolloutsv1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-rollout", Namespace: s.ns.Name},
+		Spec:       argorolloutsv1alpha1.RolloutSpec{Template: deployment.Spec.Template},
+	}
+	// pods of a rollout which references a deployment are reported with the deployment
+	refRollout := &argorolloutsv1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-ref-rollout", Namespace: s.ns.Name},
+		Spec:       argorolloutsv1alpha1.RolloutSpec{WorkloadRef: &argorolloutsv1alpha1.ObjectRef{Kind: "Deployment", Name: deployment.Name}},
+	}
+	h := newCompatibilityReportHandler(t, s, nil, nil, deployment, rollout, refRollout)
+
+	report, err := h.Report(s.ctx, s.ns.Name)
+
+	require.NoError(t, err)
+	require.Len(t, report.Workloads, 2)
+	assert.Equal(t, k8sconsts.WorkloadKindDeployment, report.Workloads[0].Kind)
+	assert.Equal(t, k8sconsts.WorkloadKindArgoRollout, report.Workloads[1].Kind)
+	assert.Equal(t, "test-rollout", report.Workloads[1].Name)
+	assert.NotEmpty(t, report.Workloads[1].Containers)
+}
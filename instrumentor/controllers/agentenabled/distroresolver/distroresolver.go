@@ -11,6 +11,17 @@ import (
 	agentInjectionEnabled "github.com/odigos-io/odigos/status/instrumentationconfig/generated"
 )
 
+// ebpfInfrastructureLanguages are the infrastructure languages (databases, brokers, caches) which are instrumented
+// with the eBPF distro when no distro of their own is configured, so their network calls are traced without injecting an agent.
+// mysql, nginx, redis and postgres are not included, and are instrumented only with a distro configured for them.
+var ebpfInfrastructureLanguages = map[common.ProgrammingLanguage]struct{}{
+	common.MongoDBProgrammingLanguage:       {},
+	common.KafkaProgrammingLanguage:         {},
+	common.RabbitMQProgrammingLanguage:      {},
+	common.ElasticsearchProgrammingLanguage: {},
+	common.MemcachedProgrammingLanguage:     {},
+}
+
 func resolveDistroByOverride(overwriteDistroName string, distroGetter *distros.Getter, containerLanguage common.ProgrammingLanguage) (*distro.OtelDistro, *odigosv1.AgentDisabledInfo) {
 	distro := distroGetter.GetDistroByName(overwriteDistroName)
 	if distro == nil { // not expected to happen, here for safety net
@@ -40,6 +51,9 @@ func resolveDistroByOverride(overwriteDistroName string, distroGetter *distros.G
 func resolveDistroByLanguage(containerLanguage common.ProgrammingLanguage, distroPerLanguage map[common.ProgrammingLanguage]string, distroGetter *distros.Getter, runtimeVersion string) (*distro.OtelDistro, *odigosv1.AgentDisabledInfo) {
 	defaultDistroName, ok := distroPerLanguage[containerLanguage]
 	if !ok {
+		if _, ok := ebpfInfrastructureLanguages[containerLanguage]; ok {
+			return resolveInfrastructureDistro(containerLanguage, distroGetter)
+		}
 		if containerLanguage == common.UnknownProgrammingLanguage {
 			return nil, &odigosv1.AgentDisabledInfo{
 				AgentEnabledReason:  odigosv1.AgentEnabledReason(agentInjectionEnabled.AgentEnabledReasonNoAvailableAgent),
@@ -68,6 +82,19 @@ func resolveDistroByLanguage(containerLanguage common.ProgrammingLanguage, distr
 	return distro, nil
 }
 
+// resolveInfrastructureDistro resolves the distro for an infrastructure process which has no distro of its own.
+// no agent is injected into such processes, and the eBPF distro is used to instrument their network calls when available.
+func resolveInfrastructureDistro(containerLanguage common.ProgrammingLanguage, distroGetter *distros.Getter) (*distro.OtelDistro, *odigosv1.AgentDisabledInfo) {
+	d := distroGetter.GetDistroByName(distro.EbpfInstrumentationDistroName)
+	if d == nil {
+		return nil, &odigosv1.AgentDisabledInfo{
+			AgentEnabledReason:  odigosv1.AgentEnabledReason(agentInjectionEnabled.AgentEnabledReasonNoAvailableAgent),
+			AgentEnabledMessage: fmt.Sprintf("%s is an infrastructure process which is not instrumented with an agent, and eBPF instrumentation is not available in this odigos tier", containerLanguage),
+		}
+	}
+	return d, nil
+}
+
 func CalculateDefaultDistroPerLanguage(defaultDistros map[common.ProgrammingLanguage]string,
 	instrumentationRules *[]odigosv1.InstrumentationRule, dg *distros.Getter,
 ) map[common.ProgrammingLanguage]string {
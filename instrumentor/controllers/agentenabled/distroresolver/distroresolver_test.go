@@ -124,3 +124,50 @@ func TestResolveDistroForContainer_nonWildcardEnforcesRuntimeSemver(t *testing.T
 	require.NotNil(t, info)
 	require.Equal(t, odigosv1.AgentEnabledReason(agentInjectionEnabled.AgentEnabledReasonUnsupportedRuntimeVersion), info.AgentEnabledReason)
 }
+
+func TestResolveDistroForContainer_infrastructureUsesEbpfDistro(t *testing.T) {
+	g := mustNewCommunityGetter(t)
+	config := &common.OdigosConfiguration{}
+	dpl := map[common.ProgrammingLanguage]string{
+		common.JavaProgrammingLanguage: "java-community",
+	}
+
+	// infrastructure processes are not injected with an agent, and are instrumented with eBPF
+	for _, lang := range []common.ProgrammingLanguage{common.KafkaProgrammingLanguage, common.MongoDBProgrammingLanguage, common.RabbitMQProgrammingLanguage} {
+		rt := &odigosv1.RuntimeDetailsByContainer{Language: lang, RuntimeVersion: "3.7.0"}
+		d, info := ResolveDistroForContainer(config, rt, dpl, g, nil, "c1")
+		require.Nil(t, info, string(lang))
+		require.NotNil(t, d, string(lang))
+		require.Equal(t, obiDistroName, d.Name, string(lang))
+		require.True(t, d.IsEbpf, string(lang))
+	}
+
+	// a distro configured for the infrastructure language takes precedence
+	dpl[common.KafkaProgrammingLanguage] = "java-community"
+	d, info := ResolveDistroForContainer(config, &odigosv1.RuntimeDetailsByContainer{Language: common.KafkaProgrammingLanguage}, dpl, g, nil, "c1")
+	require.Nil(t, info)
+	require.Equal(t, "java-community", d.Name)
+}
+
+func TestResolveDistroForContainer_existingInfrastructureWithoutDistroIsNotInstrumented(t *testing.T) {
+	g := mustNewCommunityGetter(t)
+
+	// mysql, nginx, redis and postgres are not moved to the eBPF distro by default
+	for _, lang := range []common.ProgrammingLanguage{common.MySQLProgrammingLanguage, common.NginxProgrammingLanguage, common.RedisProgrammingLanguage, common.PostgresProgrammingLanguage} {
+		rt := &odigosv1.RuntimeDetailsByContainer{Language: lang}
+		d, info := ResolveDistroForContainer(&common.OdigosConfiguration{}, rt, map[common.ProgrammingLanguage]string{}, g, nil, "c1")
+		require.Nil(t, d, string(lang))
+		require.NotNil(t, info, string(lang))
+		require.Equal(t, odigosv1.AgentEnabledReason(agentInjectionEnabled.AgentEnabledReasonNoAvailableAgent), info.AgentEnabledReason, string(lang))
+	}
+}
+
+func TestResolveDistroForContainer_applicationWithoutDistroIsNotInstrumented(t *testing.T) {
+	g := mustNewCommunityGetter(t)
+	rt := &odigosv1.RuntimeDetailsByContainer{Language: common.ErlangProgrammingLanguage}
+
+	d, info := ResolveDistroForContainer(&common.OdigosConfiguration{}, rt, map[common.ProgrammingLanguage]string{}, g, nil, "c1")
+	require.Nil(t, d)
+	require.NotNil(t, info)
+	require.Equal(t, odigosv1.AgentEnabledReason(agentInjectionEnabled.AgentEnabledReasonNoAvailableAgent), info.AgentEnabledReason)
+}
@@ -0,0 +1,78 @@
+package dynamicconfig
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/api/actions"
+	"github.com/odigos-io/odigos/common/api/instrumentationrules"
+	"github.com/odigos-io/odigos/distros/distro"
+	"github.com/odigos-io/odigos/k8sutils/pkg/scope"
+)
+
+// the request header which carries the w3c baggage.
+// agents which can not record baggage entries themselves collect it, so the gateway can parse the entries from it.
+const baggageHeaderKey = "baggage"
+
+func DistroSupportsTracesBaggageAttributes(d *distro.OtelDistro) bool {
+	return d.Traces != nil && d.Traces.BaggageAttributes != nil && d.Traces.BaggageAttributes.Supported
+}
+
+func DistroSupportsMetricsBaggageAttributes(d *distro.OtelDistro) bool {
+	return d.AgentMetrics != nil && d.AgentMetrics.BaggageAttributes != nil && d.AgentMetrics.BaggageAttributes.Supported
+}
+
+func DistroSupportsLogsBaggageAttributes(d *distro.OtelDistro) bool {
+	return d.Logs != nil && d.Logs.BaggageAttributes != nil && d.Logs.BaggageAttributes.Supported
+}
+
+// CalculateBaggageAttributesConfig merges the baggage keys of the BaggageAttributes Actions
+// which match the container and operate on the signal.
+// Returns nil when no matching action is found.
+func CalculateBaggageAttributesConfig(agentLevelActions *[]odigosv1.Action, language common.ProgrammingLanguage, pw k8sconsts.PodWorkload, signal common.ObservabilitySignal) *actions.BaggageAttributesConfig {
+	var baggageKeys []string
+	for _, action := range *agentLevelActions {
+		if action.Spec.BaggageAttributes == nil || !slices.Contains(action.Spec.Signals, signal) {
+			continue
+		}
+		if !scope.SourceScopeMatchesContainer(action.Spec.BaggageAttributes.Scopes, pw, language) {
+			continue
+		}
+		for _, key := range action.Spec.BaggageAttributes.BaggageKeys {
+			if key != "" && !slices.Contains(baggageKeys, key) {
+				baggageKeys = append(baggageKeys, key)
+			}
+		}
+	}
+
+	if len(baggageKeys) == 0 {
+		return nil
+	}
+	slices.Sort(baggageKeys)
+	return &actions.BaggageAttributesConfig{BaggageKeys: baggageKeys}
+}
+
+// collectsBaggageHeader reports whether the baggage request header is in the headers collected by the agent.
+func collectsBaggageHeader(headersCollection *instrumentationrules.HttpHeadersCollection) bool {
+	if headersCollection == nil {
+		return false
+	}
+	return slices.ContainsFunc(headersCollection.HeaderKeys, func(key string) bool {
+		return strings.EqualFold(key, baggageHeaderKey)
+	})
+}
+
+// withBaggageHeader adds the baggage request header to the headers collected by the agent.
+func withBaggageHeader(headersCollection *instrumentationrules.HttpHeadersCollection) *instrumentationrules.HttpHeadersCollection {
+	if headersCollection == nil {
+		return &instrumentationrules.HttpHeadersCollection{HeaderKeys: []string{baggageHeaderKey}}
+	}
+	if collectsBaggageHeader(headersCollection) {
+		return headersCollection
+	}
+	headersCollection.HeaderKeys = append(headersCollection.HeaderKeys, baggageHeaderKey)
+	return headersCollection
+}
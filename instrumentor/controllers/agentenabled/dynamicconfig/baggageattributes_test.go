@@ -0,0 +1,77 @@
+package dynamicconfig
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	apiactions "github.com/odigos-io/odigos/api/odigos/v1alpha1/actions"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/api/actions"
+	"github.com/odigos-io/odigos/common/api/instrumentationrules"
+	"github.com/stretchr/testify/require"
+)
+
+func baggageAttributesAction(signals []common.ObservabilitySignal, scopes *k8sconsts.SourcesScopes, keys ...string) odigosv1.Action {
+	return odigosv1.Action{
+		Spec: odigosv1.ActionSpec{
+			Signals: signals,
+			BaggageAttributes: &apiactions.BaggageAttributesConfig{
+				Scopes:                  scopes,
+				BaggageAttributesConfig: actions.BaggageAttributesConfig{BaggageKeys: keys},
+			},
+		},
+	}
+}
+
+func TestCalculateBaggageAttributesConfig_noActions(t *testing.T) {
+	actionsList := []odigosv1.Action{}
+	pw := k8sconsts.PodWorkload{Name: "app", Namespace: "default", Kind: k8sconsts.WorkloadKindDeployment}
+
+	got := CalculateBaggageAttributesConfig(&actionsList, common.JavaProgrammingLanguage, pw, common.TracesObservabilitySignal)
+
+	require.Nil(t, got)
+}
+
+func TestCalculateBaggageAttributesConfig_mergesMatchingActions(t *testing.T) {
+	pw := k8sconsts.PodWorkload{Name: "app", Namespace: "default", Kind: k8sconsts.WorkloadKindDeployment}
+	traces := []common.ObservabilitySignal{common.TracesObservabilitySignal}
+	actionsList := []odigosv1.Action{
+		baggageAttributesAction(traces, nil, "tenant_id", "experiment"),
+		baggageAttributesAction(traces, &k8sconsts.SourcesScopes{Namespaces: []string{pw.Namespace}}, "tenant_id", "region"),
+		baggageAttributesAction(traces, &k8sconsts.SourcesScopes{Namespaces: []string{"other-ns"}}, "user_id"),
+	}
+
+	got := CalculateBaggageAttributesConfig(&actionsList, common.JavaProgrammingLanguage, pw, common.TracesObservabilitySignal)
+
+	require.NotNil(t, got)
+	require.Equal(t, []string{"experiment", "region", "tenant_id"}, got.BaggageKeys)
+}
+
+func TestCalculateBaggageAttributesConfig_signalMismatch(t *testing.T) {
+	pw := k8sconsts.PodWorkload{Name: "app", Namespace: "default", Kind: k8sconsts.WorkloadKindDeployment}
+	actionsList := []odigosv1.Action{
+		baggageAttributesAction([]common.ObservabilitySignal{common.LogsObservabilitySignal}, nil, "tenant_id"),
+	}
+
+	require.Nil(t, CalculateBaggageAttributesConfig(&actionsList, common.JavaProgrammingLanguage, pw, common.TracesObservabilitySignal))
+	require.NotNil(t, CalculateBaggageAttributesConfig(&actionsList, common.JavaProgrammingLanguage, pw, common.LogsObservabilitySignal))
+}
+
+func TestWithBaggageHeader(t *testing.T) {
+	require.Equal(t, []string{"baggage"}, withBaggageHeader(nil).HeaderKeys)
+
+	headersCollection := &instrumentationrules.HttpHeadersCollection{HeaderKeys: []string{"x-request-id"}}
+	require.Equal(t, []string{"x-request-id", "baggage"}, withBaggageHeader(headersCollection).HeaderKeys)
+	// the header is not added twice
+	require.Equal(t, []string{"x-request-id", "baggage"}, withBaggageHeader(headersCollection).HeaderKeys)
+
+	headersCollection = &instrumentationrules.HttpHeadersCollection{HeaderKeys: []string{"Baggage"}}
+	require.Equal(t, []string{"Baggage"}, withBaggageHeader(headersCollection).HeaderKeys)
+}
+
+func TestCollectsBaggageHeader(t *testing.T) {
+	require.False(t, collectsBaggageHeader(nil))
+	require.False(t, collectsBaggageHeader(&instrumentationrules.HttpHeadersCollection{HeaderKeys: []string{"x-request-id"}}))
+	require.True(t, collectsBaggageHeader(&instrumentationrules.HttpHeadersCollection{HeaderKeys: []string{"x-request-id", "Baggage"}}))
+}
@@ -7,6 +7,7 @@ import (
 	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
 	"github.com/odigos-io/odigos/common"
 	commonapi "github.com/odigos-io/odigos/common/api"
+	"github.com/odigos-io/odigos/common/api/actions"
 	"github.com/odigos-io/odigos/common/api/agentsignalconfig"
 	"github.com/odigos-io/odigos/common/api/instrumentationrules"
 	commonapisampling "github.com/odigos-io/odigos/common/api/sampling"
@@ -154,6 +155,27 @@ func calculateTracesConfig(
 	// Headers Collection - Agent only (not applicable to collector)
 	agentConfig.HeadersCollection = traces.CalculateHeaderCollectionConfig(d, irls)
 
+	// Baggage Attributes
+	// agents which can not record baggage entries collect the baggage header instead,
+	// and the entries are parsed from it in the gateway collector.
+	if baggageAttributesConfig := CalculateBaggageAttributesConfig(agentLevelActions, runtimeDetails.Language, pw, common.TracesObservabilitySignal); baggageAttributesConfig != nil {
+		if DistroSupportsTracesBaggageAttributes(d) {
+			agentConfig.BaggageAttributes = baggageAttributesConfig
+		} else {
+			if collectorConfig == nil {
+				collectorConfig = &commonapi.ContainerCollectorConfig{}
+			}
+			collectorConfig.BaggageAttributes = &actions.BaggageAttributesCollectorConfig{
+				BaggageAttributesConfig: *baggageAttributesConfig,
+				// keep the header on spans when the user collects it with a headers collection rule.
+				RemoveBaggageHeader: !collectsBaggageHeader(agentConfig.HeadersCollection),
+			}
+			if traces.DistroSupportsTracesHeadersCollection(d) {
+				agentConfig.HeadersCollection = withBaggageHeader(agentConfig.HeadersCollection)
+			}
+		}
+	}
+
 	// Span Renamer
 	// TODO: add support to do it in the collector
 	agentConfig.SpanRenamer = traces.CalculateSpanRenamerConfig(d, agentLevelActions, runtimeDetails.Language)
@@ -177,6 +199,9 @@ func calculateMetricsConfig(
 	effectiveConfig *common.OdigosConfiguration,
 	d *distro.OtelDistro,
 	irls *[]odigosv1.InstrumentationRule,
+	agentLevelActions *[]odigosv1.Action,
+	language common.ProgrammingLanguage,
+	pw k8sconsts.PodWorkload,
 ) (*agentsignalconfig.AgentMetricsConfig, *odigosv1.AgentDisabledInfo) {
 	metricsConfig := &agentsignalconfig.AgentMetricsConfig{}
 
@@ -197,6 +222,11 @@ func calculateMetricsConfig(
 	// Network flow / TCP stats metrics, enabled per-workload via InstrumentationRules.
 	metricsConfig.NetworkMetrics = metrics.CalculateNetworkMetricsConfig(irls)
 
+	// Baggage Attributes - Agent only, the collector can not relate metrics to the baggage of requests.
+	if DistroSupportsMetricsBaggageAttributes(d) {
+		metricsConfig.BaggageAttributes = CalculateBaggageAttributesConfig(agentLevelActions, language, pw, common.MetricsObservabilitySignal)
+	}
+
 	return metricsConfig, nil
 }
 
@@ -236,7 +266,7 @@ func CalculateDynamicContainerConfig(
 
 	var metricsConfig *agentsignalconfig.AgentMetricsConfig
 	if enabledSignals.MetricsEnabled {
-		agentMetricsConfig, err := calculateMetricsConfig(effectiveConfig, d, irls)
+		agentMetricsConfig, err := calculateMetricsConfig(effectiveConfig, d, irls, agentLevelActions, runtimeDetails.Language, pw)
 		if err != nil {
 			return nil, err
 		}
@@ -254,6 +284,16 @@ func CalculateDynamicContainerConfig(
 		logsConfig.EbpfLogCapture = ebpfLogCaptureConfig
 	}
 
+	// Baggage Attributes - Agent only, the collector can not relate log records to the baggage of requests.
+	if logsEnabled && DistroSupportsLogsBaggageAttributes(d) {
+		if baggageAttributesConfig := CalculateBaggageAttributesConfig(agentLevelActions, runtimeDetails.Language, pw, common.LogsObservabilitySignal); baggageAttributesConfig != nil {
+			if logsConfig == nil {
+				logsConfig = &agentsignalconfig.AgentLogsConfig{}
+			}
+			logsConfig.BaggageAttributes = baggageAttributesConfig
+		}
+	}
+
 	odigosAgentDiagnostics := CalculateAgentDiagnostics(irls, d)
 
 	return &DynamicContainerConfigs{
@@ -0,0 +1,54 @@
+package profiles
+
+import (
+	"slices"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/api/instrumentationrules"
+)
+
+// CalculateProfilingConfig returns the continuous profiling config for a container based on its
+// InstrumentationRules. Enablement is presence-based: if any matching rule sets profiling, the container
+// is profiled. Profile types are the union over all rules, and the sampling frequency is the highest one requested.
+// A nil result means no profiling rule applies, or profiling is disabled for the cluster.
+func CalculateProfilingConfig(irls *[]odigosv1.InstrumentationRule, effectiveConfig *common.OdigosConfiguration) *instrumentationrules.Profiling {
+	if irls == nil || !effectiveConfig.ProfilingEnabled() {
+		return nil
+	}
+
+	var result *instrumentationrules.Profiling
+	for _, irl := range *irls {
+		result = mergeProfiling(result, irl.Spec.Profiling)
+	}
+	return result
+}
+
+func mergeProfiling(existing, incoming *instrumentationrules.Profiling) *instrumentationrules.Profiling {
+	if incoming == nil {
+		return existing
+	}
+	if existing == nil {
+		return incoming.DeepCopy()
+	}
+
+	merged := &instrumentationrules.Profiling{}
+	// an empty list means the default (cpu only), so it is made explicit before the union
+	for _, p := range []*instrumentationrules.Profiling{existing, incoming} {
+		types := p.ProfileTypes
+		if len(types) == 0 {
+			types = []instrumentationrules.ProfileType{instrumentationrules.ProfileTypeCpu}
+		}
+		for _, t := range types {
+			if !slices.Contains(merged.ProfileTypes, t) {
+				merged.ProfileTypes = append(merged.ProfileTypes, t)
+			}
+		}
+	}
+
+	merged.SamplingFrequencyHz = existing.SamplingFrequencyHz
+	if incoming.SamplingFrequencyHz != nil && (merged.SamplingFrequencyHz == nil || *incoming.SamplingFrequencyHz > *merged.SamplingFrequencyHz) {
+		merged.SamplingFrequencyHz = incoming.SamplingFrequencyHz
+	}
+	return merged
+}
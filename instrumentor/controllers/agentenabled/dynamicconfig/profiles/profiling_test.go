@@ -0,0 +1,51 @@
+package profiles
+
+import (
+	"testing"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/api/instrumentationrules"
+	"github.com/stretchr/testify/require"
+)
+
+func profilingEnabledConfig() *common.OdigosConfiguration {
+	enabled := true
+	return &common.OdigosConfiguration{Profiling: &common.ProfilingConfiguration{Enabled: &enabled}}
+}
+
+func profilingRule(p *instrumentationrules.Profiling) odigosv1.InstrumentationRule {
+	return odigosv1.InstrumentationRule{Spec: odigosv1.InstrumentationRuleSpec{Profiling: p}}
+}
+
+func TestCalculateProfilingConfig_noRules(t *testing.T) {
+	rules := []odigosv1.InstrumentationRule{profilingRule(nil)}
+
+	require.Nil(t, CalculateProfilingConfig(&rules, profilingEnabledConfig()))
+}
+
+func TestCalculateProfilingConfig_profilingDisabled(t *testing.T) {
+	rules := []odigosv1.InstrumentationRule{profilingRule(&instrumentationrules.Profiling{})}
+
+	require.Nil(t, CalculateProfilingConfig(&rules, &common.OdigosConfiguration{}))
+}
+
+func TestCalculateProfilingConfig_merge(t *testing.T) {
+	low, high := 19, 99
+	rules := []odigosv1.InstrumentationRule{
+		profilingRule(&instrumentationrules.Profiling{SamplingFrequencyHz: &high}),
+		profilingRule(nil),
+		profilingRule(&instrumentationrules.Profiling{
+			ProfileTypes:        []instrumentationrules.ProfileType{instrumentationrules.ProfileTypeOffCpu, instrumentationrules.ProfileTypeCpu},
+			SamplingFrequencyHz: &low,
+		}),
+	}
+
+	got := CalculateProfilingConfig(&rules, profilingEnabledConfig())
+
+	require.NotNil(t, got)
+	require.Equal(t, []instrumentationrules.ProfileType{instrumentationrules.ProfileTypeCpu, instrumentationrules.ProfileTypeOffCpu}, got.ProfileTypes)
+	require.Equal(t, high, *got.SamplingFrequencyHz)
+	// the rules themselves are not modified by the merge
+	require.Empty(t, rules[0].Spec.Profiling.ProfileTypes)
+}
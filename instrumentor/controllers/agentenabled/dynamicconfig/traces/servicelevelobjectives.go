@@ -0,0 +1,60 @@
+package traces
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+)
+
+// ServiceLevelObjectivesSamplingRule converts the service level objectives which keep their budget burning traces
+// into a sampling rule with one highly relevant operation per objective, scoped to the objective workload.
+// A call burns budget when it ends with an error (availability) or takes longer than the threshold (latency).
+// Returns nil if no objective asks to keep such traces.
+func ServiceLevelObjectivesSamplingRule(slos []odigosv1.ServiceLevelObjective) *odigosv1.Sampling {
+	var relevantOps []odigosv1.HighlyRelevantOperation
+	for _, slo := range slos {
+		if !slo.Spec.KeepBudgetBurningTraces {
+			continue
+		}
+
+		relevantOp := odigosv1.HighlyRelevantOperation{
+			Name: fmt.Sprintf("service level objective %s", slo.Name),
+			SourceScopes: &k8sconsts.SourcesScopes{
+				Sources: []k8sconsts.PodWorkload{slo.Spec.Workload},
+			},
+			Operation: slo.Spec.Operation,
+		}
+		switch slo.Spec.Type {
+		case odigosv1.ServiceLevelObjectiveTypeAvailability:
+			relevantOp.Error = true
+		case odigosv1.ServiceLevelObjectiveTypeLatency:
+			if slo.Spec.LatencyThresholdMs == nil {
+				// without a threshold there are no slow calls to keep
+				continue
+			}
+			threshold := *slo.Spec.LatencyThresholdMs
+			relevantOp.DurationAtLeastMs = &threshold
+		default:
+			continue
+		}
+		relevantOps = append(relevantOps, relevantOp)
+	}
+
+	if len(relevantOps) == 0 {
+		return nil
+	}
+
+	// the rule ids are derived from the content, but keep the order stable to avoid needless updates
+	slices.SortFunc(relevantOps, func(a, b odigosv1.HighlyRelevantOperation) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return &odigosv1.Sampling{
+		Spec: odigosv1.SamplingSpec{
+			Name:                     "service level objectives",
+			HighlyRelevantOperations: relevantOps,
+		},
+	}
+}
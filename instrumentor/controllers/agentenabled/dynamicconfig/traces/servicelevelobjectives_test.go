@@ -0,0 +1,85 @@
+package traces
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	commonapisampling "github.com/odigos-io/odigos/common/api/sampling"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestServiceLevelObjectivesSamplingRule(t *testing.T) {
+	t.Parallel()
+
+	workload := k8sconsts.PodWorkload{Namespace: "default", Kind: k8sconsts.WorkloadKindDeployment, Name: "checkout"}
+	operation := &commonapisampling.TailSamplingOperationMatcher{
+		HttpServer: &commonapisampling.TailSamplingHttpServerOperationMatcher{Route: "/pay"},
+	}
+	threshold := 300
+	slos := []odigosv1.ServiceLevelObjective{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "pay-latency"},
+			Spec: odigosv1.ServiceLevelObjectiveSpec{
+				Workload:                workload,
+				Operation:               operation,
+				Type:                    odigosv1.ServiceLevelObjectiveTypeLatency,
+				Target:                  99,
+				LatencyThresholdMs:      &threshold,
+				KeepBudgetBurningTraces: true,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "availability"},
+			Spec: odigosv1.ServiceLevelObjectiveSpec{
+				Workload:                workload,
+				Type:                    odigosv1.ServiceLevelObjectiveTypeAvailability,
+				Target:                  99.9,
+				KeepBudgetBurningTraces: true,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "not-kept"},
+			Spec: odigosv1.ServiceLevelObjectiveSpec{
+				Workload: workload,
+				Type:     odigosv1.ServiceLevelObjectiveTypeAvailability,
+				Target:   99.9,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "no-threshold"},
+			Spec: odigosv1.ServiceLevelObjectiveSpec{
+				Workload:                workload,
+				Type:                    odigosv1.ServiceLevelObjectiveTypeLatency,
+				Target:                  99,
+				KeepBudgetBurningTraces: true,
+			},
+		},
+	}
+
+	rule := ServiceLevelObjectivesSamplingRule(slos)
+	require.NotNil(t, rule)
+	require.Len(t, rule.Spec.HighlyRelevantOperations, 2)
+
+	availability := rule.Spec.HighlyRelevantOperations[0]
+	require.Equal(t, "service level objective availability", availability.Name)
+	require.True(t, availability.Error)
+	require.Nil(t, availability.DurationAtLeastMs)
+	require.Nil(t, availability.Operation)
+	require.Equal(t, []k8sconsts.PodWorkload{workload}, availability.SourceScopes.Sources)
+
+	latency := rule.Spec.HighlyRelevantOperations[1]
+	require.False(t, latency.Error)
+	require.Equal(t, 300, *latency.DurationAtLeastMs)
+	require.Equal(t, operation, latency.Operation)
+}
+
+func TestServiceLevelObjectivesSamplingRule_noneKept(t *testing.T) {
+	t.Parallel()
+
+	require.Nil(t, ServiceLevelObjectivesSamplingRule(nil))
+	require.Nil(t, ServiceLevelObjectivesSamplingRule([]odigosv1.ServiceLevelObjective{
+		{Spec: odigosv1.ServiceLevelObjectiveSpec{Type: odigosv1.ServiceLevelObjectiveTypeAvailability, Target: 99}},
+	}))
+}
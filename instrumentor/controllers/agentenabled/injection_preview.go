@@ -0,0 +1,189 @@
+package agentenabled
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	argorolloutsv1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	openshiftappsv1 "github.com/openshift/api/apps/v1"
+	"gomodules.xyz/jsonpatch/v2"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	commonlogger "github.com/odigos-io/odigos/common/logger"
+	"github.com/odigos-io/odigos/k8sutils/pkg/injectionpreview"
+	"github.com/odigos-io/odigos/k8sutils/pkg/workload"
+)
+
+// InjectionPreviewHandler serves a dry-run of the pods webhook for a workload.
+// It runs the webhook mutation against the current pod template of the workload and returns the patch
+// the webhook would apply to its pods, or the reasons the pods would not be mutated, without creating a pod.
+// Callers reach it through the api server service proxy, and check their own access to the workload before calling it.
+type InjectionPreviewHandler struct {
+	Webhook *PodsWebhook
+}
+
+var _ http.Handler = &InjectionPreviewHandler{}
+
+func (h *InjectionPreviewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := commonlogger.FromContext(r.Context())
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	pw := k8sconsts.PodWorkload{
+		Namespace: query.Get("namespace"),
+		Kind:      workload.WorkloadKindFromString(query.Get("kind")),
+		Name:      query.Get("name"),
+	}
+	if pw.Namespace == "" || pw.Name == "" || pw.Kind == "" {
+		http.Error(w, "namespace, kind and name are required", http.StatusBadRequest)
+		return
+	}
+	preview, err := h.Webhook.Preview(r.Context(), pw)
+	if err != nil {
+		if errors.Is(err, workload.ErrKindNotSupported) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if apierrors.IsNotFound(err) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		logger.Error(err, "failed to preview odigos injection", "workload", pw)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(preview); err != nil {
+		logger.Error(err, "failed to write injection preview response")
+	}
+}
+
+// Preview runs the webhook mutation against a pod created from the current pod template of the workload.
+// Outcomes in which the webhook skips the pod are reported as reasons, not as errors.
+func (p *PodsWebhook) Preview(ctx context.Context, pw k8sconsts.PodWorkload) (*injectionpreview.InjectionPreview, error) {
+	obj := workload.ClientObjectFromWorkloadKind(pw.Kind)
+	if obj == nil || pw.Kind == k8sconsts.WorkloadKindNamespace {
+		return nil, fmt.Errorf("%w: %s", workload.ErrKindNotSupported, pw.Kind)
+	}
+	if err := p.APIReader.Get(ctx, client.ObjectKey{Namespace: pw.Namespace, Name: pw.Name}, obj); err != nil {
+		return nil, err
+	}
+	template, err := podTemplateOfWorkload(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: *template.ObjectMeta.DeepCopy(),
+		Spec:       *template.Spec.DeepCopy(),
+	}
+	pod.Namespace = pw.Namespace
+	if pod.Labels == nil {
+		pod.Labels = map[string]string{}
+	}
+
+	preview := &injectionpreview.InjectionPreview{}
+
+	var ic odigosv1.InstrumentationConfig
+	err = p.getInstrumentationConfig(ctx, &pw, &ic)
+	if err == nil {
+		preview.Reasons = containersSkipReasons(&ic)
+	}
+
+	mutated := pod.DeepCopy()
+	if err == nil {
+		err = p.injectOdigosToWorkloadPod(ctx, mutated, &pw, &ic)
+	}
+	switch {
+	case errors.Is(err, ErrIgnorePod), errors.Is(err, ErrNotOdigablePod), errors.Is(err, ErrInjectionDisabled):
+		// the webhook admits the pod without mutation, the error describes why
+		preview.Reasons = append([]string{err.Error()}, preview.Reasons...)
+		return preview, nil
+	case err != nil:
+		// the webhook admits the pod without mutation when it fails internally as well
+		preview.Reasons = append([]string{fmt.Sprintf("injection failed: %s", err)}, preview.Reasons...)
+		return preview, nil
+	}
+
+	originalRaw, err := json.Marshal(pod)
+	if err != nil {
+		return nil, err
+	}
+	mutatedRaw, err := json.Marshal(mutated)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := jsonpatch.CreatePatch(originalRaw, mutatedRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	preview.Injected = true
+	for _, op := range patch {
+		preview.Patch = append(preview.Patch, injectionpreview.PatchOperation{
+			Operation: op.Operation,
+			Path:      op.Path,
+			Value:     op.Value,
+		})
+	}
+	return preview, nil
+}
+
+// containersSkipReasons describes the containers of the workload which the webhook does not inject an agent to.
+func containersSkipReasons(ic *odigosv1.InstrumentationConfig) []string {
+	var reasons []string
+	for _, containerConfig := range ic.Spec.Containers {
+		if containerConfig.AgentEnabled {
+			continue
+		}
+		reason := fmt.Sprintf("container %s: agent is not enabled (%s)", containerConfig.ContainerName, containerConfig.AgentEnabledReason)
+		if containerConfig.AgentEnabledMessage != "" {
+			reason = fmt.Sprintf("%s: %s", reason, containerConfig.AgentEnabledMessage)
+		}
+		reasons = append(reasons, reason)
+	}
+	return reasons
+}
+
+func podTemplateOfWorkload(obj client.Object) (*corev1.PodTemplateSpec, error) {
+	switch t := obj.(type) {
+	case *appsv1.Deployment:
+		return &t.Spec.Template, nil
+	case *appsv1.DaemonSet:
+		return &t.Spec.Template, nil
+	case *appsv1.StatefulSet:
+		return &t.Spec.Template, nil
+	case *batchv1.CronJob:
+		return &t.Spec.JobTemplate.Spec.Template, nil
+	case *batchv1.Job:
+		return &t.Spec.Template, nil
+	case *corev1.Pod:
+		return &corev1.PodTemplateSpec{ObjectMeta: t.ObjectMeta, Spec: t.Spec}, nil
+	case *openshiftappsv1.DeploymentConfig:
+		if t.Spec.Template == nil {
+			return nil, errors.New("deployment config has no pod template")
+		}
+		return t.Spec.Template, nil
+	case *argorolloutsv1alpha1.Rollout:
+		if t.Spec.WorkloadRef != nil {
+			return nil, errors.New("argo rollouts which reference another workload are not supported")
+		}
+		return &t.Spec.Template, nil
+	default:
+		return nil, fmt.Errorf("%w: %T", workload.ErrKindNotSupported, obj)
+	}
+}
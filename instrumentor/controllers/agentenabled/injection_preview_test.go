@@ -0,0 +1,63 @@
+package agentenabled
+
+import (
+	"testing"
+
+	odigosv1alpha1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/instrumentor/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestPreview_WorkloadNotFound(t *testing.T) {
+	s := newSyncTestSetup()
+	deployment := testutil.NewMockTestDeployment(s.ns, "test-deployment")
+	fakeClient := s.newFakeClient()
+	webhook := &PodsWebhook{Client: fakeClient, APIReader: fakeClient}
+
+	_, err := webhook.Preview(s.ctx, testutil.PodWorkloadFromDeployment(deployment))
+
+	assert.True(t, apierrors.IsNotFound(err))
+}
+
+func TestPreview_NotOdigosWorkload(t *testing.T) {
+	s := newSyncTestSetup()
+	deployment := testutil.NewMockTestDeployment(s.ns, "test-deployment")
+	fakeClient := s.newFakeClient(deployment)
+	webhook := &PodsWebhook{Client: fakeClient, APIReader: fakeClient}
+
+	preview, err := webhook.Preview(s.ctx, testutil.PodWorkloadFromDeployment(deployment))
+
+	require.NoError(t, err)
+	assert.False(t, preview.Injected)
+	assert.Empty(t, preview.Patch)
+	assert.Equal(t, []string{ErrNotOdigablePod.Error()}, preview.Reasons)
+}
+
+func TestPreview_InjectionDisabled(t *testing.T) {
+	s := newSyncTestSetup()
+	deployment := testutil.NewMockTestDeployment(s.ns, "test-deployment")
+	ic := testutil.NewMockInstrumentationConfig(deployment)
+	ic.Spec.AgentInjectionEnabled = false
+	ic.Spec.Containers = []odigosv1alpha1.ContainerAgentConfig{
+		{
+			ContainerName:       "test",
+			AgentEnabled:        false,
+			AgentEnabledReason:  odigosv1alpha1.AgentEnabledReasonUnsupportedProgrammingLanguage,
+			AgentEnabledMessage: "no agent for this language",
+		},
+	}
+	fakeClient := s.newFakeClient(deployment, ic)
+	webhook := &PodsWebhook{Client: fakeClient, APIReader: fakeClient}
+
+	preview, err := webhook.Preview(s.ctx, testutil.PodWorkloadFromDeployment(deployment))
+
+	require.NoError(t, err)
+	assert.False(t, preview.Injected)
+	assert.Empty(t, preview.Patch)
+	assert.Equal(t, []string{
+		ErrInjectionDisabled.Error(),
+		"container test: agent is not enabled (UnsupportedProgrammingLanguage): no agent for this language",
+	}, preview.Reasons)
+}
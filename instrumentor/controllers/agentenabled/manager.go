@@ -2,15 +2,22 @@ package agentenabled
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	"github.com/odigos-io/odigos/api/k8sconsts"
 	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
 	commonlogger "github.com/odigos-io/odigos/common/logger"
 	"github.com/odigos-io/odigos/distros"
 	"github.com/odigos-io/odigos/instrumentor/controllers/agentenabled/rollout"
 	instrumentorpredicate "github.com/odigos-io/odigos/instrumentor/controllers/utils/predicates"
+	"github.com/odigos-io/odigos/k8sutils/pkg/env"
 	odigospredicate "github.com/odigos-io/odigos/k8sutils/pkg/predicate"
 )
 
@@ -84,6 +91,42 @@ func SetupWithManager(mgr ctrl.Manager, dp *distros.Provider) error {
 		return err
 	}
 
+	// the user-defined distributions ConfigMap is watched through its own cache,
+	// so the shared ConfigMap cache holds only the effective config
+	distrosConfigMapCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		Scheme: mgr.GetScheme(),
+		Mapper: mgr.GetRESTMapper(),
+		ByObject: map[client.Object]cache.ByObject{
+			&corev1.ConfigMap{}: {
+				Namespaces: map[string]cache.Config{
+					env.GetCurrentNamespace(): {
+						FieldSelector: fields.OneTermEqualSelector("metadata.name", k8sconsts.OdigosUserDefinedDistrosConfigMapName),
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if err := mgr.Add(distrosConfigMapCache); err != nil {
+		return err
+	}
+
+	err = builder.
+		ControllerManagedBy(mgr).
+		Named("agentenabled-userdefineddistros").
+		WatchesRawSource(source.Kind(distrosConfigMapCache, &corev1.ConfigMap{}, &handler.TypedEnqueueRequestForObject[*corev1.ConfigMap]{})).
+		Complete(&UserDefinedDistrosReconciler{
+			Client:                    mgr.GetClient(),
+			ConfigMapReader:           distrosConfigMapCache,
+			DistrosProvider:           dp,
+			RolloutConcurrencyLimiter: rolloutConcurrencyLimiter,
+		})
+	if err != nil {
+		return err
+	}
+
 	err = builder.
 		ControllerManagedBy(mgr).
 		Named("agentenabled-actions").
@@ -112,5 +155,20 @@ func SetupWithManager(mgr ctrl.Manager, dp *distros.Provider) error {
 		return err
 	}
 
+	err = builder.
+		ControllerManagedBy(mgr).
+		Named("agentenabled-servicelevelobjectives").
+		For(&odigosv1.ServiceLevelObjective{}).
+		// status updates do not change the derived sampling rules
+		WithEventFilter(&predicate.GenerationChangedPredicate{}).
+		Complete(&ServiceLevelObjectiveController{
+			Client:                    mgr.GetClient(),
+			DistrosProvider:           dp,
+			RolloutConcurrencyLimiter: rolloutConcurrencyLimiter,
+		})
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 
@@ -40,6 +42,12 @@ type PodsWebhook struct {
 	// decoder is used to decode the admission request's raw object into a structured corev1.Pod.
 	Decoder     admission.Decoder
 	WaspMutator func(*corev1.Pod, common.OdigosConfiguration) error
+	// ImageVolumeSupported is true when the cluster supports the kubernetes ImageVolume feature,
+	// otherwise the k8s-image-volume mount method falls back to k8s-init-container.
+	ImageVolumeSupported bool
+	// APIReader reads workloads without the manager cache, which strips the pod templates of workloads.
+	// It is used by the injection preview, which needs the full pod template.
+	APIReader client.Reader
 }
 
 var _ admission.Handler = &PodsWebhook{}
@@ -110,20 +118,27 @@ func (p *PodsWebhook) Handle(ctx context.Context, req admission.Request) admissi
 func (p *PodsWebhook) injectOdigos(ctx context.Context, pod *corev1.Pod, req admission.Request) error {
 	logger := commonlogger.FromContext(ctx)
 
-	odigosNamespace := env.GetCurrentNamespace()
-
 	pw, err := p.podWorkload(pod, req)
 	if err != nil {
 		// TODO: if the webhook is enabled for all pods, this is not necessarily an error
 		logger.Error(err, "failed to get pod workload details. Skipping Injection of ODIGOS agent")
-		return ErrIgnorePod
+		return fmt.Errorf("%w: %v", ErrIgnorePod, err)
 	} else if pw == nil {
 		return ErrNotOdigablePod
 	}
 
 	var ic odigosv1.InstrumentationConfig
+	err = p.getInstrumentationConfig(ctx, pw, &ic)
+	if err != nil {
+		return err
+	}
+
+	return p.injectOdigosToWorkloadPod(ctx, pod, pw, &ic)
+}
+
+func (p *PodsWebhook) getInstrumentationConfig(ctx context.Context, pw *k8sconsts.PodWorkload, ic *odigosv1.InstrumentationConfig) error {
 	icName := workload.CalculateWorkloadRuntimeObjectName(pw.Name, pw.Kind)
-	err = p.Get(ctx, client.ObjectKey{Namespace: pw.Namespace, Name: icName}, &ic)
+	err := p.Get(ctx, client.ObjectKey{Namespace: pw.Namespace, Name: icName}, ic)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			// instrumentationConfig does not exist, this pod does not belong to any odigos workloads
@@ -131,6 +146,13 @@ func (p *PodsWebhook) injectOdigos(ctx context.Context, pod *corev1.Pod, req adm
 		}
 		return fmt.Errorf("%w: %v", ErrMissingInstrumentationConfig, err)
 	}
+	return nil
+}
+
+// injectOdigosToWorkloadPod mutates a pod of an odigos workload according to its instrumentation config.
+// it is shared by the admission webhook and the injection preview.
+func (p *PodsWebhook) injectOdigosToWorkloadPod(ctx context.Context, pod *corev1.Pod, pw *k8sconsts.PodWorkload, ic *odigosv1.InstrumentationConfig) error {
+	odigosNamespace := env.GetCurrentNamespace()
 
 	if !ic.Spec.AgentInjectionEnabled {
 		// instrumentation config exists, but no agent should be injected by webhook
@@ -146,7 +168,10 @@ func (p *PodsWebhook) injectOdigos(ctx context.Context, pod *corev1.Pod, req adm
 		// we are reading the effective config which should already have the mount method resolved or defaulted
 		return ErrMountMethodNotSet
 	}
-	mountMethod := *odigosConfiguration.MountMethod
+	imageVolumeEnabled := podswebhook.ImageVolumeEnabled(odigosConfiguration.ImageVolumeEnabled, p.ImageVolumeSupported)
+	mountMethod := podswebhook.ResolveMountMethod(*odigosConfiguration.MountMethod, imageVolumeEnabled)
+	// the rest of the injection sees the mount method actually used for this pod
+	odigosConfiguration.MountMethod = &mountMethod
 
 	mountIsVirtualDevice := (mountMethod == common.K8sVirtualDeviceMountMethod)
 	if mountIsVirtualDevice && odigosConfiguration.CheckDeviceHealthBeforeInjection != nil && *odigosConfiguration.CheckDeviceHealthBeforeInjection {
@@ -163,8 +188,8 @@ func (p *PodsWebhook) injectOdigos(ctx context.Context, pod *corev1.Pod, req adm
 	}
 
 	karpenterDisabled := odigosConfiguration.KarpenterEnabled == nil || !*odigosConfiguration.KarpenterEnabled
-	mountIsHostPath := odigosConfiguration.MountMethod != nil && *odigosConfiguration.MountMethod == common.K8sHostPathMountMethod
-	mountIsCsiDriver := odigosConfiguration.MountMethod != nil && *odigosConfiguration.MountMethod == common.K8sCsiDriverMountMethod
+	mountIsHostPath := mountMethod == common.K8sHostPathMountMethod
+	mountIsCsiDriver := mountMethod == common.K8sCsiDriverMountMethod
 
 	// Add odiglet-installed node affinity to the pod for non-Karpenter installations,
 	// for mount methods that require odiglet to be present on the node.
@@ -178,7 +203,8 @@ func (p *PodsWebhook) injectOdigos(ctx context.Context, pod *corev1.Pod, req adm
 	volumeMounted := false
 	waspSupported := false
 
-	dirsToCopy := make(map[string]struct{})
+	// the agents of each agent version channel are copied, or mounted, from the agents image of the channel
+	dirsToCopy := make(map[common.AgentVersionChannel]map[string]struct{})
 	for i := range pod.Spec.Containers {
 		podContainerSpec := &pod.Spec.Containers[i]
 		containerConfig := ic.Spec.GetContainerAgentConfig(podContainerSpec.Name)
@@ -197,18 +223,35 @@ func (p *PodsWebhook) injectOdigos(ctx context.Context, pod *corev1.Pod, req adm
 			return ErrUnknownDistroName
 		}
 
+		channel := agentVersionChannelForContainer(containerConfig, odigosConfiguration)
 		containerVolumeMounted, containerDirsToCopy, err := p.injectOdigosToContainer(
-			containerConfig, podContainerSpec, &ic, *pw, serviceName, odigosConfiguration, distroMetadata, pod.OwnerReferences)
+			containerConfig, podContainerSpec, ic, *pw, serviceName, odigosConfiguration, distroMetadata, pod.OwnerReferences, channel)
 		if err != nil {
 			return err
 		}
 
+		if distroMetadata.RuntimeAgent != nil && distroMetadata.RuntimeAgent.AgentFiles != nil {
+			// user-defined distributions bring their own agent files, which are not part of the odigos agents volume
+			podswebhook.MountUserDefinedAgentFiles(pod, podContainerSpec, distroMetadata, agentsInitContainerResources(odigosConfiguration))
+		}
+
+		if common.AgentResourcesAdjustmentActive(odigosConfiguration.AgentResourcesAdjustment) {
+			delta := podswebhook.AgentResourcesDelta(odigosConfiguration.AgentResourcesAdjustment, distroMetadata,
+				measuredAgentOverhead(ic, podContainerSpec.Name))
+			podswebhook.AddAgentResourcesToContainer(podContainerSpec, delta)
+		}
+
 		if distroMetadata.RuntimeAgent != nil && distroMetadata.RuntimeAgent.WaspSupported {
 			waspSupported = true
 		}
 
 		volumeMounted = volumeMounted || containerVolumeMounted
-		dirsToCopy = mergeMaps(dirsToCopy, containerDirsToCopy)
+		if containerVolumeMounted {
+			if dirsToCopy[channel] == nil {
+				dirsToCopy[channel] = make(map[string]struct{})
+			}
+			dirsToCopy[channel] = mergeMaps(dirsToCopy[channel], containerDirsToCopy)
+		}
 	}
 
 	if mountMethod == common.K8sHostPathMountMethod && volumeMounted {
@@ -216,12 +259,14 @@ func (p *PodsWebhook) injectOdigos(ctx context.Context, pod *corev1.Pod, req adm
 		podswebhook.MountPodVolumeToHostPath(pod)
 	}
 
-	if odigosConfiguration.MountMethod != nil && *odigosConfiguration.MountMethod == common.K8sInitContainerMountMethod && volumeMounted {
+	if mountMethod == common.K8sInitContainerMountMethod && volumeMounted {
 		// only mount the volume if at least one container has a volume to mount
 		podswebhook.MountPodVolumeToEmptyDir(pod)
-		if len(dirsToCopy) > 0 {
-			// Create the init container that will copy the directories to the empty dir based on dirsToCopy
-			createInitContainer(pod, dirsToCopy, odigosConfiguration)
+		for _, channel := range slices.Sorted(maps.Keys(dirsToCopy)) {
+			if len(dirsToCopy[channel]) > 0 {
+				// Create the init container that will copy the directories of the channel to the empty dir based on dirsToCopy
+				createInitContainer(pod, dirsToCopy[channel], odigosConfiguration, channel)
+			}
 		}
 	}
 
@@ -230,6 +275,13 @@ func (p *PodsWebhook) injectOdigos(ctx context.Context, pod *corev1.Pod, req adm
 		podswebhook.MountPodVolumeToCSI(pod)
 	}
 
+	if mountMethod == common.K8sImageVolumeMountMethod && volumeMounted {
+		// the agents image which the init container copies from is mounted directly
+		for _, channel := range slices.Sorted(maps.Keys(dirsToCopy)) {
+			podswebhook.MountPodVolumeToImage(pod, getAgentsImage(odigosConfiguration, channel), channel)
+		}
+	}
+
 	if odigosConfiguration.WaspEnabled != nil && *odigosConfiguration.WaspEnabled && waspSupported && p.WaspMutator != nil {
 		err = p.WaspMutator(pod, odigosConfiguration)
 		if err != nil {
@@ -238,13 +290,13 @@ func (p *PodsWebhook) injectOdigos(ctx context.Context, pod *corev1.Pod, req adm
 	}
 
 	// Inject ODIGOS environment variables and instrumentation device into all containers
-	injectErr := p.injectOdigosInstrumentation(ctx, pod, &ic, pw, &odigosConfiguration)
+	injectErr := p.injectOdigosInstrumentation(ctx, pod, ic, pw, &odigosConfiguration)
 	if injectErr != nil {
 		return fmt.Errorf("%w: %v", ErrEnvVarInjection, injectErr)
 	}
 
 	if odigosConfiguration.UserInstrumentationEnvs != nil {
-		podswebhook.InjectUserEnvForLang(&odigosConfiguration, pod, &ic)
+		podswebhook.InjectUserEnvForLang(&odigosConfiguration, pod, ic)
 	}
 
 	// store the agents deployment value so we can later associate each pod with the instrumentation version.
@@ -318,12 +370,16 @@ func (p *PodsWebhook) injectOdigosInstrumentation(ctx context.Context, pod *core
 
 func (p *PodsWebhook) injectOdigosToContainer(containerConfig *odigosv1.ContainerAgentConfig, podContainerSpec *corev1.Container,
 	ic *odigosv1.InstrumentationConfig, pw k8sconsts.PodWorkload, serviceName string, config common.OdigosConfiguration,
-	distroMetadata *distro.OtelDistro, ownerReferences []metav1.OwnerReference) (bool, map[string]struct{}, error) {
+	distroMetadata *distro.OtelDistro, ownerReferences []metav1.OwnerReference, channel common.AgentVersionChannel) (bool, map[string]struct{}, error) {
 	var err error
 
 	// check for existing env vars so we don't introduce them again
 	existingEnvNames := podswebhook.GetEnvVarNamesSet(podContainerSpec)
 
+	// the sdk settings of the container override are injected first, so they are not replaced by the distro defaults
+	existingEnvNames = podswebhook.InjectSdkEnvVars(existingEnvNames, podContainerSpec, containerConfig.Sdk, distroMetadata)
+	existingEnvNames = podswebhook.InjectBaggageAttributesEnvVars(existingEnvNames, podContainerSpec, containerConfig, distroMetadata)
+
 	// inject various kinds of distro environment variables
 	existingEnvNames, err = podswebhook.InjectStaticEnvVarsToPodContainer(existingEnvNames, podContainerSpec, distroMetadata.EnvironmentVariables.StaticVariables, containerConfig.DistroParams)
 	if err != nil {
@@ -430,13 +486,19 @@ func (p *PodsWebhook) injectOdigosToContainer(containerConfig *odigosv1.Containe
 	volumeMounted := false
 	containerDirsToCopy := make(map[string]struct{})
 	if distroMetadata.RuntimeAgent != nil {
-		if *config.MountMethod == common.K8sHostPathMountMethod ||
+		userDefinedAgentFiles := distroMetadata.RuntimeAgent.AgentFiles != nil
+		if !userDefinedAgentFiles && (*config.MountMethod == common.K8sHostPathMountMethod ||
 			*config.MountMethod == common.K8sInitContainerMountMethod ||
-			*config.MountMethod == common.K8sCsiDriverMountMethod {
-			// mount directory for host-path, init container, or CSI driver
+			*config.MountMethod == common.K8sCsiDriverMountMethod ||
+			*config.MountMethod == common.K8sImageVolumeMountMethod) {
+			// mount directory for host-path, init container, CSI driver or image volume
+			mountDirectory := podswebhook.MountDirectory
+			if *config.MountMethod == common.K8sImageVolumeMountMethod {
+				mountDirectory = podswebhook.MountDirectoryFromImageVolume
+			}
 			for _, agentDirectoryName := range distroMetadata.RuntimeAgent.DirectoryNames {
 				containerDirsToCopy[agentDirectoryName] = struct{}{}
-				podswebhook.MountDirectory(podContainerSpec, agentDirectoryName)
+				mountDirectory(podContainerSpec, agentDirectoryName, channel)
 				volumeMounted = true
 			}
 
@@ -445,16 +507,20 @@ func (p *PodsWebhook) injectOdigosToContainer(containerConfig *odigosv1.Containe
 				(*config.AgentEnvVarsInjectionMethod == common.LoaderFallbackToPodManifestInjectionMethod ||
 					*config.AgentEnvVarsInjectionMethod == common.LoaderEnvInjectionMethod) {
 				containerDirsToCopy[filepath.Join(distro.AgentPlaceholderDirectory, consts.OdigosLoaderDirName)] = struct{}{}
-				podswebhook.MountDirectory(podContainerSpec, filepath.Join(k8sconsts.OdigosAgentsDirectory, consts.OdigosLoaderDirName))
+				mountDirectory(podContainerSpec, filepath.Join(k8sconsts.OdigosAgentsDirectory, consts.OdigosLoaderDirName), channel)
 				volumeMounted = true
 			}
 		}
 
 		if distroMetadata.RuntimeAgent.K8sAttrsViaEnvVars {
-			podswebhook.InjectOtelResourceAndServiceNameEnvVars(existingEnvNames, podContainerSpec, distroMetadata.Name, pw, serviceName, ownerReferences)
+			var additionalResourceAttributes map[string]string
+			if containerConfig.Sdk != nil {
+				additionalResourceAttributes = containerConfig.Sdk.ResourceAttributes
+			}
+			podswebhook.InjectOtelResourceAndServiceNameEnvVars(existingEnvNames, podContainerSpec, distroMetadata.Name, pw, serviceName, ownerReferences, additionalResourceAttributes)
 		}
 		if distroMetadata.RuntimeAgent.Device != nil && *config.MountMethod == common.K8sVirtualDeviceMountMethod {
-			deviceName := *distroMetadata.RuntimeAgent.Device
+			deviceName := podswebhook.AgentVersionChannelDevice(*distroMetadata.RuntimeAgent.Device, channel)
 			podswebhook.InjectDeviceToContainer(podContainerSpec, deviceName)
 		}
 	}
@@ -486,10 +552,17 @@ func getRuntimeInfoForContainerName(ic *odigosv1.InstrumentationConfig, containe
 	return nil
 }
 
-func createInitContainer(pod *corev1.Pod, dirsToCopy map[string]struct{}, config common.OdigosConfiguration) {
-	imageName := getInitContainerImage(config)
-
+func createInitContainer(pod *corev1.Pod, dirsToCopy map[string]struct{}, config common.OdigosConfiguration, channel common.AgentVersionChannel) {
+	imageName := getAgentsImage(config, channel)
+	containerName := k8sconsts.OdigosInitContainerName
+	// the agents of a non-stable channel are copied next to the stable ones, under the channels directory
+	agentsDirectory := k8sconsts.OdigosAgentsDirectory
 	var copyCommands []string
+	if channel != "" {
+		containerName = k8sconsts.OdigosAgentVersionChannelNamePrefix + string(channel)
+		agentsDirectory = filepath.Join(k8sconsts.OdigosAgentsDirectory, consts.AgentVersionChannelsDirName, string(channel))
+		copyCommands = append(copyCommands, fmt.Sprintf("mkdir -p %s", agentsDirectory))
+	}
 
 	// Sort the map keys to ensure deterministic order.
 	// This is important only for tests due to limitations,
@@ -502,7 +575,7 @@ func createInitContainer(pod *corev1.Pod, dirsToCopy map[string]struct{}, config
 
 	for _, dir := range dirs {
 		from := strings.ReplaceAll(dir, distro.AgentPlaceholderDirectory, k8sconsts.OdigletContainerAgentDirectory)
-		to := strings.ReplaceAll(dir, distro.AgentPlaceholderDirectory, k8sconsts.OdigosAgentsDirectory)
+		to := strings.ReplaceAll(dir, distro.AgentPlaceholderDirectory, agentsDirectory)
 		copyCommands = append(copyCommands, fmt.Sprintf("cp -r %s %s", from, to))
 	}
 
@@ -512,7 +585,7 @@ func createInitContainer(pod *corev1.Pod, dirsToCopy map[string]struct{}, config
 	// required binaries without writing to the host filesystem.
 	falseConst := false
 	agentInitContainer := corev1.Container{
-		Name:            k8sconsts.OdigosInitContainerName,
+		Name:            containerName,
 		Image:           imageName,
 		ImagePullPolicy: corev1.PullIfNotPresent,
 		Command: []string{
@@ -534,13 +607,24 @@ func createInitContainer(pod *corev1.Pod, dirsToCopy map[string]struct{}, config
 		},
 	}
 
-	// Set resource limits and requests for the instrumentation init container
-	// We can always trust the values from the effective config, because it is validated and defaulted if not ok in the scheduler.
+	agentInitContainer.Resources = agentsInitContainerResources(config)
+	// Check if the init container already exists, this is done for safety and should never happen.
+	for _, existing := range pod.Spec.InitContainers {
+		if existing.Name == containerName {
+			return
+		}
+	}
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, agentInitContainer)
+}
+
+// agentsInitContainerResources returns the resource limits and requests for the instrumentation init containers.
+// We can always trust the values from the effective config, because it is validated and defaulted if not ok in the scheduler.
+func agentsInitContainerResources(config common.OdigosConfiguration) corev1.ResourceRequirements {
 	cpuRequestQuantity, _ := resource.ParseQuantity(fmt.Sprintf("%dm", config.AgentsInitContainerResources.RequestCPUm))
 	memoryRequestQuantity, _ := resource.ParseQuantity(fmt.Sprintf("%dMi", config.AgentsInitContainerResources.RequestMemoryMiB))
 	cpuLimitQuantity, _ := resource.ParseQuantity(fmt.Sprintf("%dm", config.AgentsInitContainerResources.LimitCPUm))
 	memoryLimitQuantity, _ := resource.ParseQuantity(fmt.Sprintf("%dMi", config.AgentsInitContainerResources.LimitMemoryMiB))
-	agentInitContainer.Resources = corev1.ResourceRequirements{
+	return corev1.ResourceRequirements{
 		Requests: corev1.ResourceList{
 			"cpu":    cpuRequestQuantity,
 			"memory": memoryRequestQuantity,
@@ -550,13 +634,36 @@ func createInitContainer(pod *corev1.Pod, dirsToCopy map[string]struct{}, config
 			"memory": memoryLimitQuantity,
 		},
 	}
-	// Check if the init container already exists, this is done for safety and should never happen.
-	for _, existing := range pod.Spec.InitContainers {
-		if existing.Name == k8sconsts.OdigosInitContainerName {
-			return
+}
+
+// measuredAgentOverhead returns the agent overhead measured for the container, or nil if it was not measured.
+func measuredAgentOverhead(ic *odigosv1.InstrumentationConfig, containerName string) *odigosv1.ContainerAgentOverhead {
+	if ic.Status.AgentOverhead == nil {
+		return nil
+	}
+	for i := range ic.Status.AgentOverhead.Containers {
+		if ic.Status.AgentOverhead.Containers[i].ContainerName == containerName {
+			return &ic.Status.AgentOverhead.Containers[i]
 		}
 	}
-	pod.Spec.InitContainers = append(pod.Spec.InitContainers, agentInitContainer)
+	return nil
+}
+
+// getAgentsImage returns the odigos agents image of the agent version channel.
+func getAgentsImage(config common.OdigosConfiguration, channel common.AgentVersionChannel) string {
+	if channel == "" {
+		return getInitContainerImage(config)
+	}
+	return config.AgentVersionChannels[channel].Image
+}
+
+// agentVersionChannelForContainer returns the agent version channel to serve the container agents from.
+// a channel which is no longer configured falls back to stable, until the instrumentation config is recalculated.
+func agentVersionChannelForContainer(containerConfig *odigosv1.ContainerAgentConfig, config common.OdigosConfiguration) common.AgentVersionChannel {
+	if _, configured := config.AgentVersionChannels[containerConfig.AgentVersionChannel]; !configured {
+		return ""
+	}
+	return containerConfig.AgentVersionChannel
 }
 
 func getInitContainerImage(config common.OdigosConfiguration) string {
@@ -0,0 +1,34 @@
+package podswebhook
+
+import (
+	"strings"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common/api/actions"
+	"github.com/odigos-io/odigos/distros/distro"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// InjectBaggageAttributesEnvVars sets the baggage keys to record as attributes for agents which read them from
+// an environment variable (e.g. the java agent "otel.java.experimental.span-attributes.copy-from-baggage.include" property).
+// Agents without an environment variable for a signal receive the keys with their remote config.
+// Variables already set in the container manifest are kept.
+func InjectBaggageAttributesEnvVars(existingEnvNames EnvVarNamesMap, container *corev1.Container, containerConfig *odigosv1.ContainerAgentConfig, d *distro.OtelDistro) EnvVarNamesMap {
+	if containerConfig.Traces != nil && d.Traces != nil {
+		existingEnvNames = injectBaggageKeysEnvVar(existingEnvNames, container, containerConfig.Traces.BaggageAttributes, d.Traces.BaggageAttributes)
+	}
+	if containerConfig.Metrics != nil && d.AgentMetrics != nil {
+		existingEnvNames = injectBaggageKeysEnvVar(existingEnvNames, container, containerConfig.Metrics.BaggageAttributes, d.AgentMetrics.BaggageAttributes)
+	}
+	if containerConfig.Logs != nil && d.Logs != nil {
+		existingEnvNames = injectBaggageKeysEnvVar(existingEnvNames, container, containerConfig.Logs.BaggageAttributes, d.Logs.BaggageAttributes)
+	}
+	return existingEnvNames
+}
+
+func injectBaggageKeysEnvVar(existingEnvNames EnvVarNamesMap, container *corev1.Container, config *actions.BaggageAttributesConfig, support *distro.BaggageAttributes) EnvVarNamesMap {
+	if config == nil || len(config.BaggageKeys) == 0 || support == nil || support.EnvName == "" {
+		return existingEnvNames
+	}
+	return InjectConstEnvVarToPodContainer(existingEnvNames, container, support.EnvName, strings.Join(config.BaggageKeys, ","))
+}
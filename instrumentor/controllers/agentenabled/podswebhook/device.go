@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/odigos-io/odigos/api/k8sconsts"
+	"github.com/odigos-io/odigos/common"
 	containerutils "github.com/odigos-io/odigos/k8sutils/pkg/container"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -27,6 +28,15 @@ func InjectDeviceToContainer(container *corev1.Container, device string) {
 	container.Resources.Requests[resourceName] = resource.MustParse("1")
 }
 
+// AgentVersionChannelDevice returns the device which mounts the agents of the agent version channel.
+// the device plugin serves each non-stable channel with a device named after the generic device and the channel.
+func AgentVersionChannelDevice(device string, channel common.AgentVersionChannel) string {
+	if channel == "" {
+		return device
+	}
+	return device + "-" + string(channel)
+}
+
 func CheckDevicePluginContainersHealth(ctx context.Context, kubeClient client.Client, odigosNamespace string) error {
 
 	odigletDaemonsets := &appsv1.DaemonSetList{}
@@ -0,0 +1,35 @@
+package podswebhook
+
+import (
+	"github.com/odigos-io/odigos/common"
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+// ImageVolume is enabled by default starting with kubernetes 1.35.
+// On older versions the feature gate may be disabled, in which case the api server drops the image
+// volume source and rejects the pod, so the mount method is only used where it is known to be available.
+var imageVolumeDefaultEnabledVersion = version.MustParseGeneric("1.35.0")
+
+// ImageVolumeSupported returns whether pods in a cluster of the given version can use image volumes.
+func ImageVolumeSupported(k8sVersion *version.Version) bool {
+	return k8sVersion != nil && k8sVersion.AtLeast(imageVolumeDefaultEnabledVersion)
+}
+
+// ImageVolumeEnabled returns whether image volumes can be used, taking the configured override
+// over the version check, for clusters where the ImageVolume feature gate is enabled before 1.35.
+func ImageVolumeEnabled(enabledOverride *bool, versionSupported bool) bool {
+	if enabledOverride != nil {
+		return *enabledOverride
+	}
+	return versionSupported
+}
+
+// ResolveMountMethod returns the mount method to use for a pod,
+// falling back from k8s-image-volume to k8s-init-container when image volumes are not supported.
+// Both methods copy nothing to the node and take the agents from the same image.
+func ResolveMountMethod(mountMethod common.MountMethod, imageVolumeSupported bool) common.MountMethod {
+	if mountMethod == common.K8sImageVolumeMountMethod && !imageVolumeSupported {
+		return common.K8sInitContainerMountMethod
+	}
+	return mountMethod
+}
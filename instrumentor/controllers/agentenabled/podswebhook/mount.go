@@ -5,15 +5,33 @@ import (
 	"strings"
 
 	"github.com/odigos-io/odigos/api/k8sconsts"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/consts"
 	"github.com/odigos-io/odigos/distros/distro"
 	corev1 "k8s.io/api/core/v1"
 )
 
-func MountDirectory(containerSpec *corev1.Container, dir string) {
+// MountDirectory mounts an agent directory from the odigos agents volume.
+// The agents of a non-stable agent version channel are located in the volume under the channels directory.
+func MountDirectory(containerSpec *corev1.Container, dir string, channel common.AgentVersionChannel) {
+	subPathPrefix := ""
+	if channel != "" {
+		subPathPrefix = filepath.Join(consts.AgentVersionChannelsDirName, string(channel))
+	}
+	mountDirectoryFromSubPath(containerSpec, dir, k8sconsts.OdigosAgentMountVolumeName, subPathPrefix)
+}
+
+// MountDirectoryFromImageVolume mounts an agent directory from the odigos agents image volume of the agent version channel,
+// where the agent directories are located under the image instrumentations directory.
+func MountDirectoryFromImageVolume(containerSpec *corev1.Container, dir string, channel common.AgentVersionChannel) {
+	mountDirectoryFromSubPath(containerSpec, dir, imageVolumeName(channel), strings.TrimPrefix(k8sconsts.OdigletContainerAgentDirectory, "/"))
+}
+
+func mountDirectoryFromSubPath(containerSpec *corev1.Container, dir string, volumeName string, subPathPrefix string) {
 	// TODO: assuming the directory always starts with {{ODIGOS_AGENTS_DIR}}. This should be validated.
 	// Should we return errors here to validate static values?
 	absolutePath := strings.ReplaceAll(dir, distro.AgentPlaceholderDirectory, k8sconsts.OdigosAgentsDirectory)
-	relativePath := filepath.Base(absolutePath)
+	relativePath := filepath.Join(subPathPrefix, filepath.Base(absolutePath))
 
 	// make sure we are idempotent, not adding ourselves multiple times
 	for _, volumeMount := range containerSpec.VolumeMounts {
@@ -24,7 +42,7 @@ func MountDirectory(containerSpec *corev1.Container, dir string) {
 	}
 
 	containerSpec.VolumeMounts = append(containerSpec.VolumeMounts, corev1.VolumeMount{
-		Name:      k8sconsts.OdigosAgentMountVolumeName,
+		Name:      volumeName,
 		SubPath:   relativePath,
 		MountPath: absolutePath,
 		ReadOnly:  true,
@@ -32,16 +50,20 @@ func MountDirectory(containerSpec *corev1.Container, dir string) {
 }
 
 func mountPodVolumeIfNotExists(pod *corev1.Pod, volumeSource corev1.VolumeSource) {
+	mountNamedPodVolumeIfNotExists(pod, k8sconsts.OdigosAgentMountVolumeName, volumeSource)
+}
+
+func mountNamedPodVolumeIfNotExists(pod *corev1.Pod, volumeName string, volumeSource corev1.VolumeSource) {
 	// make sure we are idempotent, not adding ourselves multiple times
 	for _, volume := range pod.Spec.Volumes {
-		if volume.Name == k8sconsts.OdigosAgentMountVolumeName {
+		if volume.Name == volumeName {
 			// the volume is already mounted, do not add it again
 			return
 		}
 	}
 
 	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
-		Name:         k8sconsts.OdigosAgentMountVolumeName,
+		Name:         volumeName,
 		VolumeSource: volumeSource,
 	})
 }
@@ -70,3 +92,22 @@ func MountPodVolumeToCSI(pod *corev1.Pod) {
 		},
 	})
 }
+
+// MountPodVolumeToImage mounts the odigos agents image of the agent version channel as a read-only volume,
+// which requires the kubernetes ImageVolume feature.
+func MountPodVolumeToImage(pod *corev1.Pod, image string, channel common.AgentVersionChannel) {
+	mountNamedPodVolumeIfNotExists(pod, imageVolumeName(channel), corev1.VolumeSource{
+		Image: &corev1.ImageVolumeSource{
+			Reference:  image,
+			PullPolicy: corev1.PullIfNotPresent,
+		},
+	})
+}
+
+// each agent version channel is mounted from its own image, so it has its own image volume.
+func imageVolumeName(channel common.AgentVersionChannel) string {
+	if channel == "" {
+		return k8sconsts.OdigosAgentMountVolumeName
+	}
+	return k8sconsts.OdigosAgentVersionChannelNamePrefix + string(channel)
+}
@@ -2,6 +2,8 @@ package podswebhook
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/odigos-io/odigos/api/k8sconsts"
@@ -126,12 +128,18 @@ func getResourceAttributesEnvVarValue(ra []resourceAttribute) string {
 	return strings.Join(attrs, ",")
 }
 
-func InjectOtelResourceAndServiceNameEnvVars(existingEnvNames EnvVarNamesMap, container *corev1.Container, distroName string, pw k8sconsts.PodWorkload, serviceName string, ownerReferences []metav1.OwnerReference) EnvVarNamesMap {
+// InjectOtelResourceAndServiceNameEnvVars sets the service name and the resource attributes odigos resolves for the container.
+// additionalAttributes, e.g. from the container override, are added after the ones odigos sets.
+func InjectOtelResourceAndServiceNameEnvVars(existingEnvNames EnvVarNamesMap, container *corev1.Container, distroName string, pw k8sconsts.PodWorkload, serviceName string,
+	ownerReferences []metav1.OwnerReference, additionalAttributes map[string]string) EnvVarNamesMap {
 	// OTEL_SERVICE_NAME
 	existingEnvNames = InjectConstEnvVarToPodContainer(existingEnvNames, container, otelServiceNameEnvVarName, serviceName)
 
 	// OTEL_RESOURCE_ATTRIBUTES or ODIGOS_RESOURCE_ATTRIBUTES
 	resourceAttributes := getResourceAttributes(pw, container.Name, ownerReferences)
+	for _, key := range slices.Sorted(maps.Keys(additionalAttributes)) {
+		resourceAttributes = append(resourceAttributes, resourceAttribute{Key: attribute.Key(key), Value: additionalAttributes[key]})
+	}
 	resourceAttributesEnvValue := getResourceAttributesEnvVarValue(resourceAttributes)
 
 	if _, exists := existingEnvNames[otelResourceAttributesEnvVarName]; !exists {
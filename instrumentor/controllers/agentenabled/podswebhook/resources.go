@@ -0,0 +1,77 @@
+package podswebhook
+
+import (
+	"fmt"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/distros/distro"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// AgentResourcesDelta resolves the resources to add to a container for the agent of distro d.
+// For each resource, the first available value is used:
+//  1. the overhead measured for the container, when configured to use it.
+//  2. the delta configured for the distro language.
+//  3. the resource overhead estimate of the distro.
+//
+// measured can be nil when the container overhead was not measured.
+// Distros without a runtime agent (e.g. eBPF based) add nothing to the container, and get an empty delta.
+func AgentResourcesDelta(config *common.AgentResourcesAdjustmentConfiguration, d *distro.OtelDistro,
+	measured *odigosv1.ContainerAgentOverhead,
+) corev1.ResourceList {
+	delta := corev1.ResourceList{}
+	if d.RuntimeAgent == nil {
+		return delta
+	}
+
+	if d.RuntimeAgent.ResourceOverhead != nil {
+		if q, err := resource.ParseQuantity(d.RuntimeAgent.ResourceOverhead.CPU); err == nil {
+			delta[corev1.ResourceCPU] = q
+		}
+		if q, err := resource.ParseQuantity(d.RuntimeAgent.ResourceOverhead.Memory); err == nil {
+			delta[corev1.ResourceMemory] = q
+		}
+	}
+
+	if languageDelta, found := config.Languages[d.Language]; found {
+		if languageDelta.CPUm > 0 {
+			delta[corev1.ResourceCPU] = resource.MustParse(fmt.Sprintf("%dm", languageDelta.CPUm))
+		}
+		if languageDelta.MemoryMiB > 0 {
+			delta[corev1.ResourceMemory] = resource.MustParse(fmt.Sprintf("%dMi", languageDelta.MemoryMiB))
+		}
+	}
+
+	if measured != nil && config.UseMeasuredOverhead != nil && *config.UseMeasuredOverhead {
+		// a negative or zero difference is measurement noise, not an agent overhead
+		if cpu := measured.CPUMillicoresAfter - measured.CPUMillicoresBefore; cpu > 0 {
+			delta[corev1.ResourceCPU] = *resource.NewMilliQuantity(cpu, resource.DecimalSI)
+		}
+		if memory := measured.MemoryBytesAfter - measured.MemoryBytesBefore; memory > 0 {
+			delta[corev1.ResourceMemory] = *resource.NewQuantity(memory, resource.BinarySI)
+		}
+	}
+
+	return delta
+}
+
+// AddAgentResourcesToContainer adds delta to the requests and limits of the container.
+// Only resources which the container already requests or limits are raised,
+// so a container without a limit stays unbounded and the pod QoS class does not change.
+func AddAgentResourcesToContainer(container *corev1.Container, delta corev1.ResourceList) {
+	for name, quantity := range delta {
+		if quantity.IsZero() {
+			continue
+		}
+		if request, found := container.Resources.Requests[name]; found {
+			request.Add(quantity)
+			container.Resources.Requests[name] = request
+		}
+		if limit, found := container.Resources.Limits[name]; found {
+			limit.Add(quantity)
+			container.Resources.Limits[name] = limit
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package podswebhook
+
+import (
+	"strings"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/api/agentsignalconfig"
+	commonconsts "github.com/odigos-io/odigos/common/consts"
+	"github.com/odigos-io/odigos/distros/distro"
+	"github.com/odigos-io/odigos/k8sutils/pkg/service"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// InjectSdkEnvVars sets the environment variables for the sdk settings of a container override.
+// It should be called before the distro environment variables are injected,
+// so the settings take precedence over the defaults of the distro.
+// Variables already set in the container manifest are kept.
+// eBPF agents are not configured with environment variables, and receive the settings with the container config.
+func InjectSdkEnvVars(existingEnvNames EnvVarNamesMap, container *corev1.Container, sdk *agentsignalconfig.AgentSdkConfig, d *distro.OtelDistro) EnvVarNamesMap {
+	if sdk == nil || d.IsEbpf {
+		return existingEnvNames
+	}
+
+	// propagators the agent does not implement are left out, since agents may fail to start their sdk with unknown propagators.
+	// they are reported in the ContextPropagation condition.
+	propagators := make([]string, 0, len(sdk.Propagators))
+	for _, p := range sdk.Propagators {
+		if d.SupportsPropagator(p) {
+			propagators = append(propagators, string(p))
+		}
+	}
+	if len(propagators) > 0 {
+		existingEnvNames = InjectConstEnvVarToPodContainer(existingEnvNames, container, commonconsts.OtelPropagatorsEnvName, strings.Join(propagators, ","))
+	}
+
+	// the protocol is relevant only for agents which export to the endpoint odigos sets in the environment
+	if sdk.ExporterProtocol != "" && d.EnvironmentVariables.OtlpHttpLocalNode {
+		existingEnvNames = InjectConstEnvVarToPodContainer(existingEnvNames, container, commonconsts.OtelExporterProtocolEnvName, string(sdk.ExporterProtocol))
+		if sdk.ExporterProtocol == common.OtlpGrpcExporterProtocol {
+			existingEnvNames = injectNodeIpEnvVar(existingEnvNames, container)
+			otlpGrpcEndpoint := "http://" + service.LocalTrafficOTLPGrpcDataCollectionEndpoint("$(NODE_IP)")
+			existingEnvNames = InjectConstEnvVarToPodContainer(existingEnvNames, container, commonconsts.OtelExporterEndpointEnvName, otlpGrpcEndpoint)
+		}
+	}
+
+	if len(sdk.DisabledInstrumentationLibraries) > 0 {
+		if d.EnvironmentVariables.DisabledInstrumentationLibrariesEnvName != "" {
+			existingEnvNames = InjectConstEnvVarToPodContainer(existingEnvNames, container,
+				d.EnvironmentVariables.DisabledInstrumentationLibrariesEnvName, strings.Join(sdk.DisabledInstrumentationLibraries, ","))
+		} else if d.EnvironmentVariables.DisabledInstrumentationLibraryEnvNamePattern != "" {
+			for _, library := range sdk.DisabledInstrumentationLibraries {
+				envName := strings.ReplaceAll(d.EnvironmentVariables.DisabledInstrumentationLibraryEnvNamePattern,
+					distro.InstrumentationLibraryPlaceholder, instrumentationLibraryEnvNamePart(library))
+				existingEnvNames = InjectConstEnvVarToPodContainer(existingEnvNames, container, envName, "false")
+			}
+		}
+	}
+
+	return existingEnvNames
+}
+
+// instrumentationLibraryEnvNamePart converts an instrumentation library name to the form used in environment variable names,
+// e.g. "spring-webmvc" to "SPRING_WEBMVC".
+func instrumentationLibraryEnvNamePart(library string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9'):
+			return r
+		default:
+			return '_'
+		}
+	}, library)
+}
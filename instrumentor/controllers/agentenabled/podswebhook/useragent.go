@@ -0,0 +1,109 @@
+package podswebhook
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	"github.com/odigos-io/odigos/distros/distro"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// MountUserDefinedAgentFiles mounts the agent directories of a user-defined distribution into the container,
+// from a pod volume dedicated to the distribution, regardless of the mount method used for the odigos agents.
+// When the files come from an image, an init container copies them into the volume.
+func MountUserDefinedAgentFiles(pod *corev1.Pod, containerSpec *corev1.Container, d *distro.OtelDistro, initContainerResources corev1.ResourceRequirements) {
+	agentFiles := d.RuntimeAgent.AgentFiles
+	volumeName := k8sconsts.OdigosUserDefinedAgentVolumeNamePrefix + d.Name
+
+	for _, dir := range d.RuntimeAgent.DirectoryNames {
+		absolutePath := strings.ReplaceAll(dir, distro.AgentPlaceholderDirectory, k8sconsts.OdigosAgentsDirectory)
+		mountVolumeIfNotExists(containerSpec, corev1.VolumeMount{
+			Name:      volumeName,
+			SubPath:   path.Base(absolutePath),
+			MountPath: absolutePath,
+			ReadOnly:  true,
+		})
+	}
+
+	for _, volume := range pod.Spec.Volumes {
+		if volume.Name == volumeName {
+			// another container already uses this distribution
+			return
+		}
+	}
+
+	var volumeSource corev1.VolumeSource
+	switch {
+	case agentFiles.PersistentVolumeClaimName != "":
+		volumeSource.PersistentVolumeClaim = &corev1.PersistentVolumeClaimVolumeSource{
+			ClaimName: agentFiles.PersistentVolumeClaimName,
+			ReadOnly:  true,
+		}
+	case agentFiles.HostPath != "":
+		hostPathType := corev1.HostPathDirectory
+		volumeSource.HostPath = &corev1.HostPathVolumeSource{
+			Path: agentFiles.HostPath,
+			Type: &hostPathType,
+		}
+	default:
+		volumeSource.EmptyDir = &corev1.EmptyDirVolumeSource{}
+		pod.Spec.InitContainers = append(pod.Spec.InitContainers, userDefinedAgentInitContainer(volumeName, d, initContainerResources))
+	}
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name:         volumeName,
+		VolumeSource: volumeSource,
+	})
+}
+
+// userDefinedAgentInitContainer copies the agent directories from the distribution image into the volume,
+// the same way the odigos agents init container does for the init-container mount method.
+func userDefinedAgentInitContainer(volumeName string, d *distro.OtelDistro, resources corev1.ResourceRequirements) corev1.Container {
+	agentFiles := d.RuntimeAgent.AgentFiles
+	imagePath := agentFiles.ImagePath
+	if imagePath == "" {
+		imagePath = k8sconsts.OdigletContainerAgentDirectory
+	}
+
+	// sorted for a deterministic command, so repeated mutations of the same pod produce the same spec
+	dirs := make([]string, 0, len(d.RuntimeAgent.DirectoryNames))
+	for _, dir := range d.RuntimeAgent.DirectoryNames {
+		dirs = append(dirs, path.Base(dir))
+	}
+	sort.Strings(dirs)
+
+	copyCommands := make([]string, 0, len(dirs))
+	for _, dir := range dirs {
+		copyCommands = append(copyCommands, fmt.Sprintf("cp -r %s %s", path.Join(imagePath, dir), path.Join(k8sconsts.OdigosAgentsDirectory, dir)))
+	}
+
+	falseConst := false
+	return corev1.Container{
+		Name:            volumeName,
+		Image:           agentFiles.Image,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command:         []string{"sh", "-c", strings.Join(copyCommands, " && ")},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      volumeName,
+				MountPath: k8sconsts.OdigosAgentsDirectory,
+			},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			Privileged:               &falseConst,
+			AllowPrivilegeEscalation: &falseConst,
+		},
+		Resources: resources,
+	}
+}
+
+func mountVolumeIfNotExists(containerSpec *corev1.Container, volumeMount corev1.VolumeMount) {
+	for _, existing := range containerSpec.VolumeMounts {
+		if existing.MountPath == volumeMount.MountPath {
+			return
+		}
+	}
+	containerSpec.VolumeMounts = append(containerSpec.VolumeMounts, volumeMount)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/odigos-io/odigos/api/k8sconsts"
 	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/instrumentor/controllers/agentenabled/dynamicconfig/traces"
 	"github.com/odigos-io/odigos/k8sutils/pkg/env"
 	"github.com/odigos-io/odigos/k8sutils/pkg/workload"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -71,6 +72,17 @@ func getAllSamplingRules(ctx context.Context, c client.Client) (*[]odigosv1.Samp
 		return nil, err
 	}
 	samplingObjects := samplingList.Items
+
+	// service level objectives can ask to keep the traces which burn their error budget
+	sloList := &odigosv1.ServiceLevelObjectiveList{}
+	err = c.List(ctx, sloList, &client.ListOptions{Namespace: env.GetCurrentNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	if sloRule := traces.ServiceLevelObjectivesSamplingRule(sloList.Items); sloRule != nil {
+		samplingObjects = append(samplingObjects, *sloRule)
+	}
+
 	return &samplingObjects, nil
 }
 
@@ -91,7 +103,8 @@ func getAgentLevelRelatedActions(ctx context.Context, c client.Client) (*[]odigo
 			action.Spec.SpanRenamer != nil ||
 			action.Spec.DbQueryTemplatization != nil ||
 			action.Spec.InferDbAttributes != nil ||
-			action.Spec.PiiMasking != nil {
+			action.Spec.PiiMasking != nil ||
+			action.Spec.BaggageAttributes != nil {
 			agentLevelActions = append(agentLevelActions, action)
 		}
 	}
@@ -131,7 +144,10 @@ func getRelevantInstrumentationRules(ctx context.Context, c client.Client) (*[]o
 			(ir.Spec.CustomInstrumentations != nil) ||
 			(ir.Spec.EbpfLogCapture != nil) ||
 			(ir.Spec.AgentDiagnostics != nil) ||
-			(ir.Spec.NetworkMetrics != nil) {
+			(ir.Spec.NetworkMetrics != nil) ||
+			(ir.Spec.Profiling != nil) ||
+			(ir.Spec.AgentVersion != nil) ||
+			(ir.Spec.ContextPropagation != nil) {
 
 			relevantIr = append(relevantIr, *ir)
 		}
@@ -0,0 +1,32 @@
+package rollout
+
+import (
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/distros"
+	"github.com/odigos-io/odigos/distros/distro"
+)
+
+// EbpfFallbackDistroName is the distro used for containers whose agent was rolled back,
+// when the eBPF fallback is enabled.
+// it is language agnostic and requires no in-process injection (and no restart),
+// so it can't cause the same crash that triggered the rollback.
+const EbpfFallbackDistroName = distro.EbpfInstrumentationDistroName
+
+func isEbpfFallbackEnabled(conf *common.OdigosConfiguration) bool {
+	return conf.RollbackEbpfFallback != nil && *conf.RollbackEbpfFallback
+}
+
+// EbpfFallbackDistro returns the distro to use instead of the given one for a rolled back container.
+// nil is returned if the fallback does not apply, in which case the container should stay uninstrumented:
+// - rollback did not occur, or the fallback is disabled in the odigos configuration.
+// - the rolled back distro is already eBPF based, thus the crash was not caused by in-process injection.
+// - the fallback distro is not available in this installation.
+func EbpfFallbackDistro(conf *common.OdigosConfiguration, rollbackOccurred bool, rolledBackDistro *distro.OtelDistro, distroGetter *distros.Getter) *distro.OtelDistro {
+	if !rollbackOccurred || !isEbpfFallbackEnabled(conf) {
+		return nil
+	}
+	if rolledBackDistro == nil || rolledBackDistro.IsEbpf {
+		return nil
+	}
+	return distroGetter.GetDistroByName(EbpfFallbackDistroName)
+}
@@ -0,0 +1,42 @@
+package rollout_test
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/distros"
+	"github.com/odigos-io/odigos/instrumentor/controllers/agentenabled/rollout"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEbpfFallbackDistro(t *testing.T) {
+	getter, err := distros.NewCommunityGetter()
+	assert.NoError(t, err)
+
+	enabled := true
+	fallbackEnabledConf := &common.OdigosConfiguration{RollbackEbpfFallback: &enabled}
+	sdkDistro := getter.GetDistroByName("python-community")
+	ebpfDistro := getter.GetDistroByName("golang-community")
+
+	t.Run("fallback disabled", func(t *testing.T) {
+		d := rollout.EbpfFallbackDistro(&common.OdigosConfiguration{}, true, sdkDistro, getter)
+		assert.Nil(t, d)
+	})
+
+	t.Run("no rollback", func(t *testing.T) {
+		d := rollout.EbpfFallbackDistro(fallbackEnabledConf, false, sdkDistro, getter)
+		assert.Nil(t, d)
+	})
+
+	t.Run("sdk distro rolled back", func(t *testing.T) {
+		d := rollout.EbpfFallbackDistro(fallbackEnabledConf, true, sdkDistro, getter)
+		if assert.NotNil(t, d) {
+			assert.Equal(t, rollout.EbpfFallbackDistroName, d.Name)
+		}
+	})
+
+	t.Run("ebpf distro rolled back", func(t *testing.T) {
+		d := rollout.EbpfFallbackDistro(fallbackEnabledConf, true, ebpfDistro, getter)
+		assert.Nil(t, d)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/gob"
 	"encoding/hex"
+	"encoding/json"
 	"slices"
 	"strings"
 
@@ -45,6 +46,33 @@ func HashForContainersConfig(containersConfig []odigosv1.ContainerAgentConfig) (
 		if err != nil {
 			return "", err
 		}
+		// the agent version channel is hashed only when set,
+		// so workloads on the stable channel keep the hash they had before channels were introduced.
+		if containersConfig[i].AgentVersionChannel != "" {
+			err = enc.Encode(string(containersConfig[i].AgentVersionChannel))
+			if err != nil {
+				return "", err
+			}
+		}
+		// the sdk settings are hashed only when set, as agents reading them from environment variables need a restart.
+		// they are encoded as json, which sorts the resource attributes keys, unlike gob.
+		if containersConfig[i].Sdk != nil {
+			sdkJson, err := json.Marshal(containersConfig[i].Sdk)
+			if err != nil {
+				return "", err
+			}
+			err = enc.Encode(sdkJson)
+			if err != nil {
+				return "", err
+			}
+		}
+		// the baggage keys are hashed only when set, as agents reading them from environment variables need a restart.
+		if baggageKeys, ok := baggageKeysForHash(&containersConfig[i]); ok {
+			err = enc.Encode(baggageKeys)
+			if err != nil {
+				return "", err
+			}
+		}
 		hash.Write(buf.Bytes())
 		buf.Reset()
 	}
@@ -55,3 +83,25 @@ func HashForContainersConfig(containersConfig []odigosv1.ContainerAgentConfig) (
 	hashAsHex := hex.EncodeToString(shortHash)
 	return hashAsHex, nil
 }
+
+type baggageKeysHashFields struct {
+	Traces  []string
+	Metrics []string
+	Logs    []string
+}
+
+// baggageKeysForHash returns the baggage keys to record as attributes for each signal of the container,
+// and false when none is set.
+func baggageKeysForHash(containerConfig *odigosv1.ContainerAgentConfig) (baggageKeysHashFields, bool) {
+	var fields baggageKeysHashFields
+	if containerConfig.Traces != nil && containerConfig.Traces.BaggageAttributes != nil {
+		fields.Traces = containerConfig.Traces.BaggageAttributes.BaggageKeys
+	}
+	if containerConfig.Metrics != nil && containerConfig.Metrics.BaggageAttributes != nil {
+		fields.Metrics = containerConfig.Metrics.BaggageAttributes.BaggageKeys
+	}
+	if containerConfig.Logs != nil && containerConfig.Logs.BaggageAttributes != nil {
+		fields.Logs = containerConfig.Logs.BaggageAttributes.BaggageKeys
+	}
+	return fields, len(fields.Traces) > 0 || len(fields.Metrics) > 0 || len(fields.Logs) > 0
+}
@@ -6,6 +6,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	odigosv1alpha1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/api/actions"
+	"github.com/odigos-io/odigos/common/api/agentsignalconfig"
 )
 
 func TestHashForContainersConfig(t *testing.T) {
@@ -64,4 +67,32 @@ func TestHashForContainersConfig(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Greater(t, len(hash4), 0)
 	assert.NotEqual(t, hashText, hash4)
+
+	// pin the agent version channel and check if the hash is different
+	containersConfig[1].AgentVersionChannel = common.PreviousAgentVersionChannel
+	hash5, err := HashForContainersConfig(containersConfig)
+	assert.NoError(t, err)
+	assert.NotEqual(t, hash4, hash5)
+
+	// set sdk settings and check if the hash is different, and stable for the same settings
+	containersConfig[1].Sdk = &agentsignalconfig.AgentSdkConfig{
+		Propagators:        []common.Propagator{common.B3Propagator},
+		ResourceAttributes: map[string]string{"team": "payments", "tier": "backend", "region": "eu"},
+	}
+	hash6, err := HashForContainersConfig(containersConfig)
+	assert.NoError(t, err)
+	assert.NotEqual(t, hash5, hash6)
+	for range 10 {
+		hash7, err := HashForContainersConfig(containersConfig)
+		assert.NoError(t, err)
+		assert.Equal(t, hash6, hash7)
+	}
+
+	// set baggage keys to record on spans and check if the hash is different
+	containersConfig[1].Traces = &agentsignalconfig.AgentTracesConfig{
+		BaggageAttributes: &actions.BaggageAttributesConfig{BaggageKeys: []string{"tenant_id"}},
+	}
+	hash8, err := HashForContainersConfig(containersConfig)
+	assert.NoError(t, err)
+	assert.NotEqual(t, hash6, hash8)
 }
@@ -191,7 +191,7 @@ func Do(ctx context.Context, c client.Client, ic *odigosv1alpha1.Instrumentation
 			}
 			if shouldRollback {
 				return triggerRollback(ctx, c, logger, ic, workloadObj, workloadKey,
-					rolloutConcurrencyLimiter, &backOffInfo, pw)
+					rolloutConcurrencyLimiter, &backOffInfo, pw, rollBackOptions.EbpfFallback)
 			}
 
 			// Requeue to wait for workload to finish or enter backoff state
@@ -235,7 +235,7 @@ func Do(ctx context.Context, c client.Client, ic *odigosv1alpha1.Instrumentation
 		}
 		if shouldRollback {
 			return triggerRollback(ctx, c, logger, ic, workloadObj, workloadKey,
-				rolloutConcurrencyLimiter, &backOffInfo, pw)
+				rolloutConcurrencyLimiter, &backOffInfo, pw, rollBackOptions.EbpfFallback)
 		}
 	}
 
@@ -254,7 +254,7 @@ func Do(ctx context.Context, c client.Client, ic *odigosv1alpha1.Instrumentation
 		}
 		if shouldRollback {
 			return triggerRollback(ctx, c, logger, ic, workloadObj, workloadKey,
-				rolloutConcurrencyLimiter, &backOffInfo, pw)
+				rolloutConcurrencyLimiter, &backOffInfo, pw, rollBackOptions.EbpfFallback)
 		}
 	}
 
@@ -552,6 +552,8 @@ func recoverFromRollback(ic *odigosv1alpha1.InstrumentationConfig) bool {
 }
 
 // triggerRollback executes the rollback: disables agents, updates IC, and restarts the workload.
+// When ebpfFallback is set, the agents are still disabled here, and the next spec calculation
+// enables the eBPF fallback distro for the rolled back containers (see EbpfFallbackDistro).
 func triggerRollback(
 	ctx context.Context,
 	c client.Client,
@@ -562,9 +564,11 @@ func triggerRollback(
 	rolloutConcurrencyLimiter *RolloutConcurrencyLimiter,
 	backOffInfo *podBackOffInfo,
 	pw k8sconsts.PodWorkload,
+	ebpfFallback bool,
 ) (RolloutResult, error) {
 	logger.Info("Triggering rollback due to backoff",
 		"reason", backOffInfo.reason,
+		"ebpfFallback", ebpfFallback,
 		"workload", pw.Name,
 		"namespace", pw.Namespace)
 
@@ -591,8 +595,12 @@ func triggerRollback(
 		return RolloutResult{}, rolloutErr
 	}
 
-	meta.SetStatusCondition(&ic.Status.Conditions, newConditionTriggeredWithMessage(backOffInfo.message))
-	meta.SetStatusCondition(&ic.Status.Conditions, newConditionAgentDisabledDueToBackoff(backOffInfo.reason, backOffInfo.message))
+	message := backOffInfo.message
+	if ebpfFallback {
+		message = fmt.Sprintf("%s; falling back to %s", message, EbpfFallbackDistroName)
+	}
+	meta.SetStatusCondition(&ic.Status.Conditions, newConditionTriggeredWithMessage(message))
+	meta.SetStatusCondition(&ic.Status.Conditions, newConditionAgentDisabledDueToBackoff(backOffInfo.reason, message))
 
 	return RolloutResult{StatusChanged: true, Result: ctrl.Result{RequeueAfter: RequeueWaitingForWorkloadRollout}}, nil
 }
@@ -13,6 +13,8 @@ type RollBackOptions struct {
 	RollbackGraceTime       time.Duration
 	RollbackStabilityWindow time.Duration
 	MaxConcurrentRollouts   int
+	// when true, rolled back containers are instrumented with the eBPF fallback distro instead of being left uninstrumented.
+	EbpfFallback bool
 }
 
 // GetRolloutAndRollbackOptions extracts rollout and rollback configuration from OdigosConfiguration.
@@ -51,6 +53,7 @@ func getRolloutAndRollbackOptions(conf *common.OdigosConfiguration) (isAutomatic
 		RollbackGraceTime:       rollbackGraceTime,
 		RollbackStabilityWindow: rollbackStabilityWindow,
 		MaxConcurrentRollouts:   maxConcurrentRollouts,
+		EbpfFallback:            isEbpfFallbackEnabled(conf),
 	}
 	return isAutomaticRolloutDisabled, rollBackOptions, nil
 }
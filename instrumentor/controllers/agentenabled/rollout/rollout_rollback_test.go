@@ -164,6 +164,39 @@ func Test_TriggeredRollout_PodInMidRollout_RollbackRestartAnnotation(t *testing.
 	assert.Contains(t, updatedDeployment.Spec.Template.Annotations, "kubectl.kubernetes.io/restartedAt")
 }
 
+func Test_TriggeredRollout_PodInMidRollout_RollbackWithEbpfFallback(t *testing.T) {
+	// Arrange: Crashlooping pod past grace time, with the eBPF fallback enabled in the configuration
+	s := newTestSetup()
+	ebpfFallback := true
+	s.conf.RollbackEbpfFallback = &ebpfFallback
+	deployment := newMockDeploymentMidRollout(s.ns, "test-deployment")
+	ic := mockICMidRollout(testutil.NewMockInstrumentationConfig(deployment))
+	pw := k8sconsts.PodWorkload{Name: deployment.Name, Namespace: deployment.Namespace, Kind: k8sconsts.WorkloadKindDeployment}
+	now := metav1.Now()
+	ic.Status.InstrumentationTime = &now
+	ic.Spec.AgentInjectionEnabled = true
+
+	podStartTime := metav1.NewTime(time.Now().Add(-6 * time.Minute))
+	crashingPod := newMockCrashingPod(s.ns, deployment.Name, ic.Spec.AgentsMetaHash, podStartTime)
+
+	fakeClient := s.newFakeClient(deployment, crashingPod, ic)
+	rateLimiter := newRolloutConcurrencyLimiterNoLimit()
+
+	// Act
+	rolloutResult, err := rollout.Do(s.ctx, fakeClient, ic, pw, s.conf, s.distroProvider, rateLimiter)
+
+	// Assert: Rollback is triggered as usual (injection disabled, workload restarted),
+	// and the conditions record that the workload falls back to the eBPF distro.
+	assertTriggeredRollback(t, rolloutResult, err, ic)
+	assert.False(t, ic.Spec.AgentInjectionEnabled, "agent injection should be disabled after rollback")
+	assert.Contains(t, ic.Status.Conditions[0].Message, rollout.EbpfFallbackDistroName)
+
+	var updatedDeployment appsv1.Deployment
+	err = fakeClient.Get(s.ctx, client.ObjectKey{Name: deployment.Name, Namespace: deployment.Namespace}, &updatedDeployment)
+	assert.NoError(t, err)
+	assert.Contains(t, updatedDeployment.Spec.Template.Annotations, "kubectl.kubernetes.io/restartedAt")
+}
+
 func Test_TriggeredRollout_PodInMidRollout_RollbackRestartAtArgoRollout(t *testing.T) {
 	// Arrange: Argo Rollout with crashlooping pod for 6min - should trigger rollback via spec.restartAt
 	s := newTestSetup()
@@ -0,0 +1,107 @@
+package agentenabled
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/api/agentsignalconfig"
+	"github.com/odigos-io/odigos/distros"
+)
+
+// resolveContainerSdkConfig returns the sdk settings of a container, from its container override
+// and the instrumentation rules which apply to it. Settings of the container override take precedence over rules.
+// Returns nil when nothing is set, so the agent uses its defaults.
+func resolveContainerSdkConfig(containerOverride *odigosv1.ContainerOverride, rules []odigosv1.InstrumentationRule) *agentsignalconfig.AgentSdkConfig {
+	var sdk *agentsignalconfig.AgentSdkConfig
+	if containerOverride != nil && containerOverride.Sdk != nil {
+		sdk = containerOverride.Sdk.DeepCopy()
+	}
+
+	if sdk == nil || len(sdk.Propagators) == 0 {
+		if propagators := resolvePropagators(rules); len(propagators) > 0 {
+			if sdk == nil {
+				sdk = &agentsignalconfig.AgentSdkConfig{}
+			}
+			sdk.Propagators = propagators
+		}
+	}
+
+	return sdk
+}
+
+// resolvePropagators returns the context propagators of the first rule (rules are sorted by namespace and name)
+// which sets them, or nil when no rule does.
+func resolvePropagators(rules []odigosv1.InstrumentationRule) []common.Propagator {
+	for _, rule := range rules {
+		if rule.Spec.ContextPropagation == nil || len(rule.Spec.ContextPropagation.Propagators) == 0 {
+			continue
+		}
+		propagators := make([]common.Propagator, len(rule.Spec.ContextPropagation.Propagators))
+		copy(propagators, rule.Spec.ContextPropagation.Propagators)
+		return propagators
+	}
+	return nil
+}
+
+// setContextPropagationCondition sets the status condition reporting whether the agents use the propagators
+// configured for their containers, and returns true if the conditions changed.
+// The condition is removed when no container with an enabled agent has propagators configured.
+func setContextPropagationCondition(ic *odigosv1.InstrumentationConfig, distrosGetter *distros.Getter) bool {
+	condition := contextPropagationCondition(ic.Spec.Containers, distrosGetter)
+	if condition == nil {
+		return meta.RemoveStatusCondition(&ic.Status.Conditions, odigosv1.ContextPropagationStatusConditionType)
+	}
+	return meta.SetStatusCondition(&ic.Status.Conditions, *condition)
+}
+
+// contextPropagationCondition returns the context propagation condition of the containers,
+// or nil when none of the containers with an enabled agent has propagators configured.
+// Distros which only implement some of the propagators declare them in their manifest (the go and OBI eBPF agents
+// have no propagators config and only implement the w3c trace context), and the other propagators are reported as unsupported.
+func contextPropagationCondition(containers []odigosv1.ContainerAgentConfig, distrosGetter *distros.Getter) *metav1.Condition {
+	configured := false
+	var unsupported []string
+	for _, container := range containers {
+		if !container.AgentEnabled || container.Sdk == nil || len(container.Sdk.Propagators) == 0 {
+			continue
+		}
+		configured = true
+
+		d := distrosGetter.GetDistroByName(container.OtelDistroName)
+		if d == nil {
+			continue
+		}
+		var ignored []string
+		for _, propagator := range container.Sdk.Propagators {
+			if !d.SupportsPropagator(propagator) {
+				ignored = append(ignored, string(propagator))
+			}
+		}
+		if len(ignored) > 0 {
+			unsupported = append(unsupported, fmt.Sprintf("%s (%s): %s", container.ContainerName, d.Name, strings.Join(ignored, ", ")))
+		}
+	}
+
+	if !configured {
+		return nil
+	}
+	if len(unsupported) > 0 {
+		return &metav1.Condition{
+			Type:    odigosv1.ContextPropagationStatusConditionType,
+			Status:  metav1.ConditionFalse,
+			Reason:  string(odigosv1.ContextPropagationReasonUnsupportedPropagators),
+			Message: "the agents of some containers do not implement some of the configured propagators, and ignore them: " + strings.Join(unsupported, "; "),
+		}
+	}
+	return &metav1.Condition{
+		Type:    odigosv1.ContextPropagationStatusConditionType,
+		Status:  metav1.ConditionTrue,
+		Reason:  string(odigosv1.ContextPropagationReasonPropagatorsApplied),
+		Message: "the agents use the configured context propagators",
+	}
+}
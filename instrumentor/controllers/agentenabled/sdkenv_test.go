@@ -0,0 +1,203 @@
+package agentenabled
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	odigosv1alpha1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/api/actions"
+	"github.com/odigos-io/odigos/common/api/agentsignalconfig"
+	"github.com/odigos-io/odigos/common/api/instrumentationrules"
+	"github.com/odigos-io/odigos/distros"
+	"github.com/odigos-io/odigos/distros/distro"
+	"github.com/odigos-io/odigos/instrumentor/controllers/agentenabled/podswebhook"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func envValue(container *corev1.Container, name string) (string, bool) {
+	for _, env := range container.Env {
+		if env.Name == name {
+			return env.Value, true
+		}
+	}
+	return "", false
+}
+
+func TestInjectSdkEnvVars(t *testing.T) {
+	javaDistro := &distro.OtelDistro{
+		Name: "java-community",
+		EnvironmentVariables: distro.EnvironmentVariables{
+			OtlpHttpLocalNode: true,
+			DisabledInstrumentationLibraryEnvNamePattern: "OTEL_INSTRUMENTATION_{{INSTRUMENTATION_LIBRARY}}_ENABLED",
+		},
+	}
+	sdk := &agentsignalconfig.AgentSdkConfig{
+		DisabledInstrumentationLibraries: []string{"spring-webmvc", "jdbc"},
+		Propagators:                      []common.Propagator{common.TraceContextPropagator, common.B3Propagator},
+		ExporterProtocol:                 common.OtlpGrpcExporterProtocol,
+	}
+	// a variable from the container manifest is kept
+	container := &corev1.Container{
+		Name: "app",
+		Env:  []corev1.EnvVar{{Name: "OTEL_INSTRUMENTATION_JDBC_ENABLED", Value: "true"}},
+	}
+
+	podswebhook.InjectSdkEnvVars(podswebhook.GetEnvVarNamesSet(container), container, sdk, javaDistro)
+
+	propagators, _ := envValue(container, "OTEL_PROPAGATORS")
+	assert.Equal(t, "tracecontext,b3", propagators)
+	protocol, _ := envValue(container, "OTEL_EXPORTER_OTLP_PROTOCOL")
+	assert.Equal(t, "grpc", protocol)
+	endpoint, _ := envValue(container, "OTEL_EXPORTER_OTLP_ENDPOINT")
+	assert.Contains(t, endpoint, ":4317")
+	webmvc, _ := envValue(container, "OTEL_INSTRUMENTATION_SPRING_WEBMVC_ENABLED")
+	assert.Equal(t, "false", webmvc)
+	jdbc, _ := envValue(container, "OTEL_INSTRUMENTATION_JDBC_ENABLED")
+	assert.Equal(t, "true", jdbc)
+
+	// a list variable is used when the distro has one
+	pythonDistro := &distro.OtelDistro{
+		Name: "python-community",
+		EnvironmentVariables: distro.EnvironmentVariables{
+			DisabledInstrumentationLibrariesEnvName: "OTEL_PYTHON_DISABLED_INSTRUMENTATIONS",
+		},
+	}
+	container = &corev1.Container{Name: "app"}
+	podswebhook.InjectSdkEnvVars(podswebhook.GetEnvVarNamesSet(container), container, sdk, pythonDistro)
+	disabled, _ := envValue(container, "OTEL_PYTHON_DISABLED_INSTRUMENTATIONS")
+	assert.Equal(t, "spring-webmvc,jdbc", disabled)
+	// the distro does not export to an endpoint set in the environment
+	_, found := envValue(container, "OTEL_EXPORTER_OTLP_PROTOCOL")
+	assert.False(t, found)
+
+	// eBPF agents are not configured with environment variables
+	container = &corev1.Container{Name: "app"}
+	podswebhook.InjectSdkEnvVars(podswebhook.GetEnvVarNamesSet(container), container, sdk, &distro.OtelDistro{Name: "golang-community", IsEbpf: true})
+	assert.Empty(t, container.Env)
+}
+
+func TestInjectOtelResourceAttributesWithOverride(t *testing.T) {
+	container := &corev1.Container{Name: "app"}
+	pw := k8sconsts.PodWorkload{Namespace: "default", Kind: k8sconsts.WorkloadKindDeployment, Name: "frontend"}
+
+	podswebhook.InjectOtelResourceAndServiceNameEnvVars(podswebhook.GetEnvVarNamesSet(container), container, "java-community", pw, "frontend", nil,
+		map[string]string{"team": "payments", "deployment.environment": "prod"})
+
+	attributes, _ := envValue(container, "OTEL_RESOURCE_ATTRIBUTES")
+	assert.Contains(t, attributes, "k8s.deployment.name=frontend")
+	assert.Contains(t, attributes, ",deployment.environment=prod,team=payments")
+}
+
+func TestResolveContainerSdkConfig(t *testing.T) {
+	rules := []odigosv1alpha1.InstrumentationRule{
+		{Spec: odigosv1alpha1.InstrumentationRuleSpec{AgentVersion: &instrumentationrules.AgentVersion{Channel: common.CanaryAgentVersionChannel}}},
+		{Spec: odigosv1alpha1.InstrumentationRuleSpec{ContextPropagation: &instrumentationrules.ContextPropagation{
+			Propagators: []common.Propagator{common.TraceContextPropagator, common.XRayPropagator},
+		}}},
+		{Spec: odigosv1alpha1.InstrumentationRuleSpec{ContextPropagation: &instrumentationrules.ContextPropagation{
+			Propagators: []common.Propagator{common.B3Propagator},
+		}}},
+	}
+
+	assert.Nil(t, resolveContainerSdkConfig(nil, nil))
+
+	// the first rule which sets propagators is used
+	sdk := resolveContainerSdkConfig(nil, rules)
+	assert.Equal(t, []common.Propagator{common.TraceContextPropagator, common.XRayPropagator}, sdk.Propagators)
+
+	// the rule fills the propagators of an override which does not set them
+	override := &odigosv1alpha1.ContainerOverride{
+		ContainerName: "app",
+		Sdk:           &agentsignalconfig.AgentSdkConfig{ExporterProtocol: common.OtlpGrpcExporterProtocol},
+	}
+	sdk = resolveContainerSdkConfig(override, rules)
+	assert.Equal(t, common.OtlpGrpcExporterProtocol, sdk.ExporterProtocol)
+	assert.Equal(t, []common.Propagator{common.TraceContextPropagator, common.XRayPropagator}, sdk.Propagators)
+	assert.Empty(t, override.Sdk.Propagators)
+
+	// propagators of the override take precedence over rules
+	override.Sdk.Propagators = []common.Propagator{common.JaegerPropagator}
+	sdk = resolveContainerSdkConfig(override, rules)
+	assert.Equal(t, []common.Propagator{common.JaegerPropagator}, sdk.Propagators)
+}
+
+func TestContextPropagationCondition(t *testing.T) {
+	getter, err := distros.NewCommunityGetter()
+	assert.NoError(t, err)
+	b3 := &agentsignalconfig.AgentSdkConfig{Propagators: []common.Propagator{common.TraceContextPropagator, common.B3Propagator}}
+
+	// no propagators configured
+	assert.Nil(t, contextPropagationCondition([]odigosv1alpha1.ContainerAgentConfig{
+		{ContainerName: "app", AgentEnabled: true, OtelDistroName: "golang-community"},
+	}, getter))
+
+	// native agents receive the propagators in the environment
+	condition := contextPropagationCondition([]odigosv1alpha1.ContainerAgentConfig{
+		{ContainerName: "app", AgentEnabled: true, OtelDistroName: "java-community", Sdk: b3},
+	}, getter)
+	assert.Equal(t, string(odigosv1alpha1.ContextPropagationReasonPropagatorsApplied), condition.Reason)
+
+	// eBPF agents only propagate the w3c trace context
+	condition = contextPropagationCondition([]odigosv1alpha1.ContainerAgentConfig{
+		{ContainerName: "app", AgentEnabled: true, OtelDistroName: "java-community", Sdk: b3},
+		{ContainerName: "proxy", AgentEnabled: true, OtelDistroName: "golang-community", Sdk: b3},
+	}, getter)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Equal(t, string(odigosv1alpha1.ContextPropagationReasonUnsupportedPropagators), condition.Reason)
+	assert.Contains(t, condition.Message, "proxy (golang-community): b3")
+	assert.NotContains(t, condition.Message, "app")
+
+	// native agents report the propagators their distro does not implement
+	xray := &agentsignalconfig.AgentSdkConfig{Propagators: []common.Propagator{common.TraceContextPropagator, common.XRayPropagator}}
+	condition = contextPropagationCondition([]odigosv1alpha1.ContainerAgentConfig{
+		{ContainerName: "api", AgentEnabled: true, OtelDistroName: "dotnet-community", Sdk: xray},
+	}, getter)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+	assert.Contains(t, condition.Message, "api (dotnet-community): xray")
+}
+
+func TestInjectSdkEnvVarsUnsupportedPropagators(t *testing.T) {
+	dotnetDistro := &distro.OtelDistro{
+		Name:   "dotnet-community",
+		Traces: &distro.Traces{ContextPropagation: &distro.ContextPropagation{SupportedPropagators: []common.Propagator{common.TraceContextPropagator, common.BaggagePropagator}}},
+	}
+	container := &corev1.Container{Name: "app"}
+
+	// propagators the distro does not implement are not passed to the agent
+	sdk := &agentsignalconfig.AgentSdkConfig{Propagators: []common.Propagator{common.TraceContextPropagator, common.XRayPropagator}}
+	podswebhook.InjectSdkEnvVars(podswebhook.GetEnvVarNamesSet(container), container, sdk, dotnetDistro)
+	propagators, _ := envValue(container, "OTEL_PROPAGATORS")
+	assert.Equal(t, "tracecontext", propagators)
+
+	// the variable is not set when none of the propagators is implemented
+	container = &corev1.Container{Name: "app"}
+	sdk = &agentsignalconfig.AgentSdkConfig{Propagators: []common.Propagator{common.XRayPropagator}}
+	podswebhook.InjectSdkEnvVars(podswebhook.GetEnvVarNamesSet(container), container, sdk, dotnetDistro)
+	_, found := envValue(container, "OTEL_PROPAGATORS")
+	assert.False(t, found)
+}
+
+func TestInjectBaggageAttributesEnvVars(t *testing.T) {
+	getter, err := distros.NewCommunityGetter()
+	assert.NoError(t, err)
+	containerConfig := &odigosv1alpha1.ContainerAgentConfig{
+		ContainerName: "app",
+		Traces: &agentsignalconfig.AgentTracesConfig{
+			BaggageAttributes: &actions.BaggageAttributesConfig{BaggageKeys: []string{"experiment", "tenant_id"}},
+		},
+	}
+
+	// the java agent reads the keys from the environment
+	container := &corev1.Container{Name: "app"}
+	podswebhook.InjectBaggageAttributesEnvVars(podswebhook.GetEnvVarNamesSet(container), container, containerConfig, getter.GetDistroByName("java-community"))
+	keys, _ := envValue(container, "OTEL_JAVA_EXPERIMENTAL_SPAN_ATTRIBUTES_COPY_FROM_BAGGAGE_INCLUDE")
+	assert.Equal(t, "experiment,tenant_id", keys)
+
+	// the nodejs agent receives the keys with its remote config
+	container = &corev1.Container{Name: "app"}
+	podswebhook.InjectBaggageAttributesEnvVars(podswebhook.GetEnvVarNamesSet(container), container, containerConfig, getter.GetDistroByName("nodejs-community"))
+	assert.Empty(t, container.Env)
+}
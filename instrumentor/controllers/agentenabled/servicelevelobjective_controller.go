@@ -0,0 +1,20 @@
+package agentenabled
+
+import (
+	"context"
+
+	"github.com/odigos-io/odigos/distros"
+	"github.com/odigos-io/odigos/instrumentor/controllers/agentenabled/rollout"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type ServiceLevelObjectiveController struct {
+	client.Client
+	DistrosProvider           *distros.Provider
+	RolloutConcurrencyLimiter *rollout.RolloutConcurrencyLimiter
+}
+
+func (r *ServiceLevelObjectiveController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return reconcileAll(ctx, r.Client, r.DistrosProvider, r.RolloutConcurrencyLimiter)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"path/filepath"
 	"slices"
 	"strings"
@@ -14,12 +15,14 @@ import (
 	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
 	"github.com/odigos-io/odigos/common"
 	commonapi "github.com/odigos-io/odigos/common/api"
+	"github.com/odigos-io/odigos/common/api/instrumentationrules"
 	commonconsts "github.com/odigos-io/odigos/common/consts"
 	commonlogger "github.com/odigos-io/odigos/common/logger"
 	"github.com/odigos-io/odigos/distros"
 	"github.com/odigos-io/odigos/distros/distro"
 	"github.com/odigos-io/odigos/instrumentor/controllers/agentenabled/distroresolver"
 	"github.com/odigos-io/odigos/instrumentor/controllers/agentenabled/dynamicconfig"
+	"github.com/odigos-io/odigos/instrumentor/controllers/agentenabled/dynamicconfig/profiles"
 	"github.com/odigos-io/odigos/instrumentor/controllers/agentenabled/rollout"
 	"github.com/odigos-io/odigos/instrumentor/controllers/agentenabled/signals"
 	"github.com/odigos-io/odigos/k8sutils/pkg/scope"
@@ -123,9 +126,13 @@ func reconcileWorkload(ctx context.Context, c client.Client, icName string, name
 	}
 
 	agentEnabledChanged := meta.SetStatusCondition(&ic.Status.Conditions, cond)
+	contextPropagationChanged := setContextPropagationCondition(&ic, distroProvider.Getter)
+	agentVersions := calculateAgentVersions(ic.Spec.Containers, conf)
+	agentVersionsChanged := !slices.Equal(ic.Status.AgentVersions, agentVersions)
+	ic.Status.AgentVersions = agentVersions
 	rolloutResult, doErr := rollout.Do(ctx, c, &ic, pw, conf, distroProvider, rolloutConcurrencyLimiter)
 
-	if rolloutResult.StatusChanged || agentEnabledChanged {
+	if rolloutResult.StatusChanged || agentEnabledChanged || contextPropagationChanged || agentVersionsChanged {
 		updateErr := c.Status().Update(ctx, &ic)
 		if updateErr != nil {
 			return k8sutils.K8SUpdateErrorHandler(updateErr)
@@ -226,6 +233,9 @@ func updateInstrumentationConfigSpec(ctx context.Context, c client.Client, pw k8
 	collectorConfigs := make([]commonapi.ContainerCollectorConfig, 0, len(ic.Spec.Containers))
 	runtimeDetailsByContainer := ic.RuntimeDetailsByContainer()
 	podManifestInjectionOptional := true // pod manifest is optional, unless some container agent requires it
+	usingEbpfFallback := false           // set if any container is instrumented with the eBPF fallback distro after rollback
+	// profiling is done by the eBPF profiler on the node, so it applies to containers with or without an agent
+	profilingByContainer := make(map[string]*instrumentationrules.Profiling)
 
 	for containerName, containerRuntimeDetails := range runtimeDetailsByContainer {
 		containerLanguage := common.UnknownProgrammingLanguage
@@ -233,23 +243,11 @@ func updateInstrumentationConfigSpec(ctx context.Context, c client.Client, pw k8
 			containerLanguage = containerRuntimeDetails.Language
 		}
 
-		// filter the relevant rules from irls for this container specifically
-		rulesForContainer := make([]odigosv1.InstrumentationRule, 0)
-		if irls != nil {
-			for _, rule := range *irls {
-				if scope.SourceScopeMatchesContainer(rule.Spec.Scopes, pw, containerLanguage) {
-					rulesForContainer = append(rulesForContainer, rule)
-				}
-			}
+		rulesForContainer := instrumentationRulesForContainer(irls, pw, containerLanguage)
+
+		if profilingConfig := profiles.CalculateProfilingConfig(&rulesForContainer, effectiveConfig); profilingConfig != nil {
+			profilingByContainer[containerName] = profilingConfig
 		}
-		// Since we can have multiple rules editing the same target, sort the rules since the list order is not deterministic
-		// This will prevent magical behavior that will arise from the nondeterminism of rule applying due to ordering
-		slices.SortFunc(rulesForContainer, func(a, b odigosv1.InstrumentationRule) int {
-			if c := cmp.Compare(a.Namespace, b.Namespace); c != 0 {
-				return c
-			}
-			return cmp.Compare(a.Name, b.Name)
-		})
 
 		distroPerLanguage := distroresolver.CalculateDefaultDistroPerLanguage(defaultDistrosPerLanguage, &rulesForContainer, distroProvider.Getter)
 
@@ -269,6 +267,16 @@ func updateInstrumentationConfigSpec(ctx context.Context, c client.Client, pw k8
 			continue
 		}
 
+		// if the agent of this container was rolled back, it might be instrumented with the eBPF fallback distro instead.
+		// the rest of the calculation is done for the fallback distro, as if it was the resolved one.
+		containerRollbackOccurred := rollbackOccurred
+		rolledBackDistroName := ""
+		if fallbackDistro := rollout.EbpfFallbackDistro(effectiveConfig, rollbackOccurred, containerDistro, distroProvider.Getter); fallbackDistro != nil {
+			rolledBackDistroName = containerDistro.Name
+			containerDistro = fallbackDistro
+			containerRollbackOccurred = false
+		}
+
 		// calculate and verify there are enabled signals for this container.
 		enabledSignals, disabledInfo := signals.GetEnabledSignalsForContainer(nodeCollectorsGroup, &rulesForContainer)
 		if disabledInfo != nil {
@@ -294,13 +302,20 @@ func updateInstrumentationConfigSpec(ctx context.Context, c client.Client, pw k8
 		}
 
 		allowConcurrentAgents := resolveAllowConcurrentAgents(effectiveConfig, containerOverride)
-		agentConfig := calculateContainerAgentConfig(containerName, containerDistro, effectiveConfig, containerRuntimeDetails, rollbackOccurred, existingBackoffReason, allowConcurrentAgents)
+		agentConfig := calculateContainerAgentConfig(containerName, containerDistro, effectiveConfig, containerRuntimeDetails, containerRollbackOccurred, existingBackoffReason, allowConcurrentAgents)
+		if agentConfig.AgentEnabled && rolledBackDistroName != "" {
+			agentConfig.AgentEnabledReason = odigosv1.AgentEnabledReason(agentInjectionEnabled.AgentEnabledReasonRollbackFallbackToEbpf)
+			agentConfig.AgentEnabledMessage = fmt.Sprintf("pods entered backoff with %s; falling back to %s", rolledBackDistroName, containerDistro.Name)
+			usingEbpfFallback = true
+		}
 		// add the dynamic agent configs to enabled agents
 		if agentConfig.AgentEnabled {
 			agentConfig.Traces = dynamicContainerConfigs.AgentTracesConfig
 			agentConfig.Metrics = dynamicContainerConfigs.AgentMetricsConfig
 			agentConfig.Logs = dynamicContainerConfigs.AgentLogsConfig
 			agentConfig.AgentDiagnostics = dynamicContainerConfigs.AgentDiagnostics
+			agentConfig.AgentVersionChannel = resolveAgentVersionChannel(rulesForContainer, containerDistro, effectiveConfig)
+			agentConfig.Sdk = resolveContainerSdkConfig(containerOverride, rulesForContainer)
 		}
 		containersConfig = append(containersConfig, agentConfig)
 
@@ -319,6 +334,8 @@ func updateInstrumentationConfigSpec(ctx context.Context, c client.Client, pw k8
 		}
 	}
 
+	collectorConfigs = addProfilingCollectorConfigs(collectorConfigs, profilingByContainer)
+
 	ic.Spec.Containers = containersConfig
 	ic.Spec.PodManifestInjectionOptional = podManifestInjectionOptional
 	ic.Spec.WorkloadCollectorConfig = collectorConfigs
@@ -343,12 +360,20 @@ func updateInstrumentationConfigSpec(ctx context.Context, c client.Client, pw k8
 		// if any instrumented containers are found, the pods webhook should process pods for this workload.
 		// set the AgentInjectionEnabled to true to signal that.
 		ic.Spec.AgentInjectionEnabled = !rollbackOccurred
-		ic.Spec.PodManifestInjectionOptional = ic.Spec.AgentInjectionEnabled && podManifestInjectionOptional
+		// the eBPF fallback distro is attached to running processes, so the pods are not injected, nor restarted.
+		ic.Spec.PodManifestInjectionOptional = (ic.Spec.AgentInjectionEnabled || usingEbpfFallback) && podManifestInjectionOptional
 		agentsDeploymentHash, err := rollout.HashForContainersConfig(containersConfig)
 		if err != nil {
 			return nil, err
 		}
 		updateInstrumentationConfigAgentsMetaHash(ic, string(agentsDeploymentHash))
+		if usingEbpfFallback {
+			return &agentInjectedStatusCondition{
+				Status:  agentInjectionEnabled.AgentEnabledRollbackFallbackToEbpf.K8sConditionStatus,
+				Reason:  odigosv1.AgentEnabledReason(agentInjectionEnabled.AgentEnabledReasonRollbackFallbackToEbpf),
+				Message: fmt.Sprintf("agent rolled back, falling back to eBPF instrumentation in %d containers: %v", len(instrumentedContainerNames), instrumentedContainerNames),
+			}, nil
+		}
 		return &agentInjectedStatusCondition{
 			Status:  agentInjectionEnabled.AgentEnabledEnabledSuccessfully.K8sConditionStatus,
 			Reason:  odigosv1.AgentEnabledReason(agentInjectionEnabled.AgentEnabledReasonEnabledSuccessfully),
@@ -665,6 +690,27 @@ func gotReadySignals(cg *odigosv1.CollectorsGroup) (bool, string) {
 	return true, ""
 }
 
+// instrumentationRulesForContainer filters the relevant rules from irls for a container specifically.
+func instrumentationRulesForContainer(irls *[]odigosv1.InstrumentationRule, pw k8sconsts.PodWorkload, containerLanguage common.ProgrammingLanguage) []odigosv1.InstrumentationRule {
+	rulesForContainer := make([]odigosv1.InstrumentationRule, 0)
+	if irls != nil {
+		for _, rule := range *irls {
+			if scope.SourceScopeMatchesContainer(rule.Spec.Scopes, pw, containerLanguage) {
+				rulesForContainer = append(rulesForContainer, rule)
+			}
+		}
+	}
+	// Since we can have multiple rules editing the same target, sort the rules since the list order is not deterministic
+	// This will prevent magical behavior that will arise from the nondeterminism of rule applying due to ordering
+	slices.SortFunc(rulesForContainer, func(a, b odigosv1.InstrumentationRule) int {
+		if c := cmp.Compare(a.Namespace, b.Namespace); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return rulesForContainer
+}
+
 // resolveAllowConcurrentAgents returns the effective allow_concurrent_agents for a
 // container: container override wins when set, otherwise the global config (nil = false).
 func resolveAllowConcurrentAgents(cfg *common.OdigosConfiguration, containerOverride *odigosv1.ContainerOverride) bool {
@@ -682,3 +728,22 @@ func otherAgentNames(agents []odigosv1.OtherAgent) string {
 	}
 	return strings.Join(names, ", ")
 }
+
+// addProfilingCollectorConfigs sets the profiling config on the collector config of each profiled container,
+// adding a collector config for containers which don't have one (e.g. when no agent is injected).
+func addProfilingCollectorConfigs(collectorConfigs []commonapi.ContainerCollectorConfig, profilingByContainer map[string]*instrumentationrules.Profiling) []commonapi.ContainerCollectorConfig {
+	for i := range collectorConfigs {
+		if profilingConfig, ok := profilingByContainer[collectorConfigs[i].ContainerName]; ok {
+			collectorConfigs[i].Profiling = profilingConfig
+			delete(profilingByContainer, collectorConfigs[i].ContainerName)
+		}
+	}
+	containerNames := slices.Sorted(maps.Keys(profilingByContainer))
+	for _, containerName := range containerNames {
+		collectorConfigs = append(collectorConfigs, commonapi.ContainerCollectorConfig{
+			ContainerName: containerName,
+			Profiling:     profilingByContainer[containerName],
+		})
+	}
+	return collectorConfigs
+}
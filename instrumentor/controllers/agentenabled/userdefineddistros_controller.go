@@ -0,0 +1,86 @@
+package agentenabled
+
+import (
+	"context"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	commonlogger "github.com/odigos-io/odigos/common/logger"
+	"github.com/odigos-io/odigos/distros"
+	"github.com/odigos-io/odigos/distros/distro"
+	"github.com/odigos-io/odigos/instrumentor/controllers/agentenabled/rollout"
+	"github.com/odigos-io/odigos/k8sutils/pkg/env"
+)
+
+// UserDefinedDistrosReconciler reloads the user-defined otel distributions when the odigos-otel-distributions
+// ConfigMap changes, then re-evaluates all the workloads which may use them.
+// The initial load is done by LoadUserDefinedDistros before the manager and the webhooks start.
+type UserDefinedDistrosReconciler struct {
+	client.Client
+	// ConfigMapReader reads from the cache which holds only the odigos-otel-distributions ConfigMap
+	ConfigMapReader           client.Reader
+	DistrosProvider           *distros.Provider
+	RolloutConcurrencyLimiter *rollout.RolloutConcurrencyLimiter
+}
+
+func (r *UserDefinedDistrosReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if err := LoadUserDefinedDistros(ctx, r.ConfigMapReader, r.DistrosProvider); err != nil {
+		return ctrl.Result{}, err
+	}
+	return reconcileAll(ctx, r.Client, r.DistrosProvider, r.RolloutConcurrencyLimiter)
+}
+
+// LoadUserDefinedDistros loads the user-defined otel distributions from the odigos-otel-distributions
+// ConfigMap into the distros provider, so they can be selected by container overrides and OtelDistros rules
+// like the built-in ones.
+// It is called synchronously on startup, so pods admitted by the webhook already see them.
+func LoadUserDefinedDistros(ctx context.Context, c client.Reader, dp *distros.Provider) error {
+	logger := commonlogger.FromContext(ctx)
+
+	var data map[string]string
+	var cm corev1.ConfigMap
+	err := c.Get(ctx, client.ObjectKey{Namespace: env.GetCurrentNamespace(), Name: k8sconsts.OdigosUserDefinedDistrosConfigMapName}, &cm)
+	if err == nil {
+		data = cm.Data
+	} else if !apierrors.IsNotFound(err) {
+		return err
+	}
+	// when the config map is deleted, data is empty and all the user-defined distributions are removed
+
+	userDefinedDistros := parseUserDefinedDistros(data, func(key string, err error) {
+		// an invalid entry should not prevent the other distributions from being used
+		logger.Error(err, "ignoring invalid user-defined otel distribution", "key", key)
+	})
+	if err := dp.SetUserDefinedDistros(userDefinedDistros); err != nil {
+		// keep the previously loaded distributions, so workloads using them are not un-instrumented
+		logger.Error(err, "failed to load user-defined otel distributions")
+		return nil
+	}
+	logger.Info("loaded user-defined otel distributions", "count", len(userDefinedDistros))
+	return nil
+}
+
+// parseUserDefinedDistros parses each data entry as an OtelDistribution yaml document, in key order.
+func parseUserDefinedDistros(data map[string]string, onInvalid func(key string, err error)) []*distro.OtelDistro {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	userDefinedDistros := make([]*distro.OtelDistro, 0, len(keys))
+	for _, key := range keys {
+		d, err := distros.ParseUserDefinedDistro([]byte(data[key]))
+		if err != nil {
+			onInvalid(key, err)
+			continue
+		}
+		userDefinedDistros = append(userDefinedDistros, d)
+	}
+	return userDefinedDistros
+}
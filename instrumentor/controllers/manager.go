@@ -1,12 +1,14 @@
 package controllers
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"time"
 
 	"github.com/go-logr/logr"
 
+	"github.com/odigos-io/odigos/api/k8sconsts"
 	"github.com/odigos-io/odigos/common"
 	"github.com/odigos-io/odigos/distros"
 	"github.com/odigos-io/odigos/instrumentor/controllers/agentenabled"
@@ -16,6 +18,7 @@ import (
 	argorolloutsv1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/odigos-io/odigos/common/consts"
 	cacheutils "github.com/odigos-io/odigos/k8sutils/pkg/cache"
+	"github.com/odigos-io/odigos/k8sutils/pkg/compatibility"
 	"github.com/odigos-io/odigos/k8sutils/pkg/env"
 	"github.com/odigos-io/odigos/k8sutils/pkg/workload"
 	openshiftappsv1 "github.com/openshift/api/apps/v1"
@@ -32,6 +35,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -105,6 +109,9 @@ func CreateManager(opts KubeManagerOptions) (ctrl.Manager, error) {
 			// but need to consider the RBAC and semantics of such a change.
 			Field: nsSelector,
 		},
+		&odigosv1.ServiceLevelObjective{}: {
+			Field: nsSelector,
+		},
 	}
 
 	newInformerWithTransformFunc := cacheutils.CreateNewInformerWithTransformFunc(scheme, cacheByObjectConfig)
@@ -189,8 +196,9 @@ func SetupWithManager(mgr manager.Manager, dp *distros.Provider, k8sVersion *ver
 }
 
 type WebhookConfig struct {
-	DistrosProvider *distros.Provider
-	WaspMutator     func(*corev1.Pod, common.OdigosConfiguration) error
+	DistrosProvider      *distros.Provider
+	WaspMutator          func(*corev1.Pod, common.OdigosConfiguration) error
+	ImageVolumeSupported bool
 }
 
 func RegisterWebhooks(mgr manager.Manager, config WebhookConfig) error {
@@ -214,6 +222,9 @@ func RegisterWebhooks(mgr manager.Manager, config WebhookConfig) error {
 		DistrosGetter: config.DistrosProvider.Getter,
 		Decoder:       decoder,
 		WaspMutator:   config.WaspMutator,
+		// decided once, the kubernetes version does not change while the instrumentor runs
+		ImageVolumeSupported: config.ImageVolumeSupported,
+		APIReader:            mgr.GetAPIReader(),
 	}
 
 	// Register directly with GetWebhookServer() since this webhook uses admission.Handler for full control.
@@ -224,6 +235,35 @@ func RegisterWebhooks(mgr manager.Manager, config WebhookConfig) error {
 		&admission.Webhook{Handler: webhook},
 	)
 
+	// the endpoints below are reached through the api server service proxy.
+	// callers check their own access to what the endpoints expose before proxying, so no user credentials are sent.
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return err
+	}
+
+	// dry-run of the pods webhook for a workload
+	mgr.GetWebhookServer().Register(
+		k8sconsts.InstrumentorInjectionPreviewPath,
+		&agentenabled.InjectionPreviewHandler{Webhook: webhook},
+	)
+
+	// pre-flight compatibility report of a namespace.
+	// odiglets are reached through the api server pods proxy to inspect workloads which are not instrumented,
+	// and only serve the service account of the instrumentor.
+	odigosNamespace := env.GetCurrentNamespace()
+	mgr.GetWebhookServer().Register(
+		k8sconsts.InstrumentorCompatibilityReportPath,
+		&agentenabled.CompatibilityReportHandler{
+			Client:          mgr.GetClient(),
+			APIReader:       mgr.GetAPIReader(),
+			DistrosProvider: config.DistrosProvider,
+			InspectNamespace: func(ctx context.Context, namespace string) ([]compatibility.InspectedWorkload, error) {
+				return compatibility.InspectNamespace(ctx, clientset, mgr.GetConfig(), odigosNamespace, namespace)
+			},
+		},
+	)
+
 	return nil
 }
 
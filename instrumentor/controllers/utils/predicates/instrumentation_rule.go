@@ -17,7 +17,10 @@ func isRuleRelevantForAgentInjection(spec *odigosv1alpha1.InstrumentationRuleSpe
 		spec.CodeAttributes != nil ||
 		spec.EbpfLogCapture != nil ||
 		spec.AgentDiagnostics != nil ||
-		spec.NetworkMetrics != nil
+		spec.NetworkMetrics != nil ||
+		spec.Profiling != nil ||
+		spec.AgentVersion != nil ||
+		spec.ContextPropagation != nil
 }
 
 func (o AgentInjectionRelevantRulesPredicate) Create(e event.CreateEvent) bool {
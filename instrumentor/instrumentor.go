@@ -15,6 +15,8 @@ import (
 	commonlogger "github.com/odigos-io/odigos/common/logger"
 	"github.com/odigos-io/odigos/distros"
 	"github.com/odigos-io/odigos/instrumentor/controllers"
+	"github.com/odigos-io/odigos/instrumentor/controllers/agentenabled"
+	"github.com/odigos-io/odigos/instrumentor/controllers/agentenabled/podswebhook"
 	"github.com/odigos-io/odigos/instrumentor/report"
 	"github.com/odigos-io/odigos/k8sutils/pkg/certs"
 	"github.com/odigos-io/odigos/k8sutils/pkg/utils"
@@ -35,6 +37,8 @@ type Instrumentor struct {
 	dp                 *distros.Provider
 	webhooksRegistered *atomic.Bool
 	waspMutator        func(*corev1.Pod, common.OdigosConfiguration) error
+
+	imageVolumeSupported bool
 }
 
 func New(opts controllers.KubeManagerOptions, dp *distros.Provider, waspMutator func(*corev1.Pod, common.OdigosConfiguration) error) (*Instrumentor, error) {
@@ -93,6 +97,12 @@ func New(opts controllers.KubeManagerOptions, dp *distros.Provider, waspMutator
 		return nil, fmt.Errorf("unable to add cert rotator: %w", err)
 	}
 
+	// load the user-defined distributions before the webhooks are registered, so the first pods admitted
+	// can already use them. later changes are applied by the agentenabled controller.
+	if err := agentenabled.LoadUserDefinedDistros(context.Background(), mgr.GetAPIReader(), dp); err != nil {
+		return nil, fmt.Errorf("unable to load user-defined otel distributions: %w", err)
+	}
+
 	k8sVersion, err := utils.ClusterVersion()
 	if err != nil {
 		return nil, err
@@ -131,6 +141,8 @@ func New(opts controllers.KubeManagerOptions, dp *distros.Provider, waspMutator
 		dp:                 dp,
 		webhooksRegistered: webhooksRegistered,
 		waspMutator:        waspMutator,
+
+		imageVolumeSupported: podswebhook.ImageVolumeSupported(k8sVersion),
 	}, nil
 }
 
@@ -180,8 +192,9 @@ func (i *Instrumentor) Run(ctx context.Context, odigosTelemetryDisabled bool) {
 		}
 		logger.Info("Cert rotator is ready")
 		err := controllers.RegisterWebhooks(i.mgr, controllers.WebhookConfig{
-			DistrosProvider: i.dp,
-			WaspMutator:     i.waspMutator,
+			DistrosProvider:      i.dp,
+			WaspMutator:          i.waspMutator,
+			ImageVolumeSupported: i.imageVolumeSupported,
 		})
 		if err != nil {
 			return err
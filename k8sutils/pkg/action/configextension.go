@@ -18,15 +18,25 @@ func IsConfigExtension(action *odigosv1.Action) bool {
 // ConfigExtensionProcessorTypes returns processor types from the action catalog
 // that use the odigosConfigExtension config mechanism for this Action CR.
 func ConfigExtensionProcessorTypes(action *odigosv1.Action) []string {
+	processors := []string{}
+	for _, processorMetadata := range ConfigExtensionProcessors(action) {
+		processors = append(processors, processorMetadata.Type)
+	}
+	return processors
+}
+
+// ConfigExtensionProcessors returns the catalog metadata of the processors
+// that use the odigosConfigExtension config mechanism for this Action CR.
+func ConfigExtensionProcessors(action *odigosv1.Action) []actionscatalog.Processor {
 	manifest, ok := actionManifestForCRD(action)
 	if !ok {
 		return nil
 	}
 
-	processors := []string{}
+	processors := []actionscatalog.Processor{}
 	for _, processorMetadata := range manifest.Spec.Processors {
 		if processorMetadata.ConfigMechanism == odigosConfigExtensionMechanism {
-			processors = append(processors, processorMetadata.Type)
+			processors = append(processors, processorMetadata)
 		}
 	}
 	return processors
@@ -59,6 +69,8 @@ func CatalogType(action *odigosv1.Action) string {
 		return odigosactions.ActionNameDbQueryTemplatization
 	case action.Spec.InferDbAttributes != nil:
 		return odigosactions.ActionNameInferDbAttributes
+	case action.Spec.BaggageAttributes != nil:
+		return odigosactions.ActionNameBaggageAttributes
 	default:
 		return ""
 	}
@@ -0,0 +1,111 @@
+package compatibility
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/k8sutils/pkg/proxyauth"
+)
+
+// BlockerType is a known condition of a container which can break the agent or the application once instrumented,
+// even when odigos has an agent for the container.
+type BlockerType string
+
+const (
+	// the container root filesystem is mounted read-only, agents which write to it (caches, logs) may fail.
+	BlockerTypeReadOnlyRootFilesystem BlockerType = "ReadOnlyRootFilesystem"
+	// another instrumentation agent was detected running in the container.
+	BlockerTypeOtherAgent BlockerType = "OtherAgent"
+	// the process runs in secure-execution mode, in which the dynamic loader ignores LD_PRELOAD.
+	BlockerTypeSecureExecutionMode BlockerType = "SecureExecutionMode"
+	// the container limits leave less room above its requests than the agent typically consumes.
+	BlockerTypeResourceHeadroom BlockerType = "ResourceHeadroom"
+)
+
+type Blocker struct {
+	Type    BlockerType `json:"type"`
+	Message string      `json:"message"`
+}
+
+// ContainerReport is the compatibility of a single container with odigos.
+type ContainerReport struct {
+	ContainerName  string                     `json:"containerName"`
+	Language       common.ProgrammingLanguage `json:"language,omitempty"`
+	RuntimeVersion string                     `json:"runtimeVersion,omitempty"`
+
+	// the otel distribution odigos would use for the container, empty when no distribution matches.
+	OtelDistroName string `json:"otelDistroName,omitempty"`
+
+	// true when odigos would enable an agent for the container.
+	// the reason and message explain why not, or add information on an enabled agent.
+	Supported bool   `json:"supported"`
+	Reason    string `json:"reason,omitempty"`
+	Message   string `json:"message,omitempty"`
+
+	Blockers []Blocker `json:"blockers,omitempty"`
+}
+
+type WorkloadReport struct {
+	Kind k8sconsts.WorkloadKind `json:"kind"`
+	Name string                 `json:"name"`
+
+	// true when the workload is already marked for instrumentation by a source.
+	Instrumented bool `json:"instrumented"`
+
+	Containers []ContainerReport `json:"containers"`
+}
+
+// NamespaceReport is the pre-flight compatibility report of the workloads in a namespace,
+// computed before the namespace is instrumented.
+type NamespaceReport struct {
+	Namespace string           `json:"namespace"`
+	Workloads []WorkloadReport `json:"workloads"`
+
+	// set when the runtime inspection of some of the nodes failed,
+	// in which case containers of workloads running on those nodes are reported without runtime details.
+	InspectionError string `json:"inspectionError,omitempty"`
+}
+
+// InspectedWorkload holds the runtime details odiglet detected in the running pods of a workload on its node.
+type InspectedWorkload struct {
+	Kind           k8sconsts.WorkloadKind               `json:"kind"`
+	Name           string                               `json:"name"`
+	RuntimeDetails []odigosv1.RuntimeDetailsByContainer `json:"runtimeDetails"`
+}
+
+// Get computes the compatibility report of a namespace in the instrumentor,
+// through the kubernetes api server proxy to the instrumentor service.
+// The report exposes the containers and runtimes of the namespace, so the caller must be allowed to list its pods.
+func Get(ctx context.Context, client kubernetes.Interface, odigosNamespace string, namespace string) (*NamespaceReport, error) {
+	err := proxyauth.CheckAccess(ctx, client, authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      "list",
+		Resource:  "pods",
+	})
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{
+		"namespace": namespace,
+	}
+	instrumentor := utilnet.JoinSchemeNamePort("https", k8sconsts.InstrumentorServiceName, strconv.Itoa(k8sconsts.InstrumentorWebhookPort))
+	response, err := proxyauth.ProxyGet(ctx, client, "", "services", odigosNamespace, instrumentor, k8sconsts.InstrumentorCompatibilityReportPath, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get compatibility report from instrumentor: %w: %s", err, string(response))
+	}
+
+	var report NamespaceReport
+	if err := json.Unmarshal(response, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse compatibility report: %w", err)
+	}
+	return &report, nil
+}
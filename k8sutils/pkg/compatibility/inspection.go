@@ -0,0 +1,130 @@
+package compatibility
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/k8sutils/pkg/proxyauth"
+)
+
+// InspectNamespace asks every running odiglet to inspect the pods of the namespace on its node,
+// through the kubernetes api server proxy to the odiglet pods.
+// The results of all nodes are merged per workload.
+// Results are returned along with an error when only some of the odiglets fail.
+// Odiglet only serves the instrumentor, so the config must be the in-cluster config of the instrumentor.
+func InspectNamespace(ctx context.Context, client kubernetes.Interface, config *rest.Config, odigosNamespace string, namespace string) ([]InspectedWorkload, error) {
+	token, err := proxyauth.Token(config)
+	if err != nil {
+		return nil, err
+	}
+	odigletPods, err := client.CoreV1().Pods(odigosNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{
+			"app.kubernetes.io/name": k8sconsts.OdigletAppLabelValue,
+		}).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list odiglet pods: %w", err)
+	}
+
+	var (
+		mu         sync.Mutex
+		wg         sync.WaitGroup
+		inspectErr error
+		byNode     [][]InspectedWorkload
+	)
+	for i := range odigletPods.Items {
+		pod := &odigletPods.Items[i]
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			inspected, err := inspectNamespaceOnNode(ctx, client, token, pod, namespace)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				inspectErr = errors.Join(inspectErr, fmt.Errorf("node %s: %w", pod.Spec.NodeName, err))
+				return
+			}
+			byNode = append(byNode, inspected)
+		}()
+	}
+	wg.Wait()
+
+	return mergeInspectedWorkloads(byNode), inspectErr
+}
+
+func inspectNamespaceOnNode(ctx context.Context, client kubernetes.Interface, token string, odigletPod *corev1.Pod, namespace string) ([]InspectedWorkload, error) {
+	odiglet := utilnet.JoinSchemeNamePort("https", odigletPod.Name, strconv.Itoa(k8sconsts.OdigletRuntimeInspectionPort))
+	params := map[string]string{"namespace": namespace}
+	response, err := proxyauth.ProxyGet(ctx, client, token, "pods", odigletPod.Namespace, odiglet, k8sconsts.OdigletRuntimeInspectionPath, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect namespace %s: %w: %s", namespace, err, string(response))
+	}
+
+	var inspected []InspectedWorkload
+	if err := json.Unmarshal(response, &inspected); err != nil {
+		return nil, fmt.Errorf("failed to parse runtime inspection: %w", err)
+	}
+	return inspected, nil
+}
+
+// mergeInspectedWorkloads combines the runtime details of the same workload found on several nodes.
+// The first details of each container are kept, unless they have no known language and later details do.
+func mergeInspectedWorkloads(byNode [][]InspectedWorkload) []InspectedWorkload {
+	type workloadKey struct {
+		kind k8sconsts.WorkloadKind
+		name string
+	}
+
+	merged := []InspectedWorkload{}
+	indexByKey := map[workloadKey]int{}
+	for _, nodeWorkloads := range byNode {
+		for _, inspected := range nodeWorkloads {
+			key := workloadKey{kind: inspected.Kind, name: inspected.Name}
+			i, found := indexByKey[key]
+			if !found {
+				indexByKey[key] = len(merged)
+				merged = append(merged, inspected)
+				continue
+			}
+			merged[i].RuntimeDetails = mergeRuntimeDetails(merged[i].RuntimeDetails, inspected.RuntimeDetails)
+		}
+	}
+	return merged
+}
+
+func mergeRuntimeDetails(existing, other []odigosv1.RuntimeDetailsByContainer) []odigosv1.RuntimeDetailsByContainer {
+	for _, details := range other {
+		found := false
+		for i := range existing {
+			if existing[i].ContainerName != details.ContainerName {
+				continue
+			}
+			found = true
+			if existing[i].Language == common.UnknownProgrammingLanguage && details.Language != common.UnknownProgrammingLanguage {
+				existing[i] = details
+			}
+			break
+		}
+		if !found {
+			existing = append(existing, details)
+		}
+	}
+	return existing
+}
@@ -0,0 +1,61 @@
+package compatibility
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	odigosv1 "github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+)
+
+func TestMergeInspectedWorkloads(t *testing.T) {
+	node1 := []InspectedWorkload{
+		{
+			Kind: k8sconsts.WorkloadKindDeployment,
+			Name: "frontend",
+			RuntimeDetails: []odigosv1.RuntimeDetailsByContainer{
+				{ContainerName: "app", Language: common.UnknownProgrammingLanguage},
+				{ContainerName: "proxy", Language: common.GoProgrammingLanguage},
+			},
+		},
+	}
+	node2 := []InspectedWorkload{
+		{
+			Kind: k8sconsts.WorkloadKindDeployment,
+			Name: "frontend",
+			RuntimeDetails: []odigosv1.RuntimeDetailsByContainer{
+				{ContainerName: "app", Language: common.JavascriptProgrammingLanguage, RuntimeVersion: "20.1.0"},
+				{ContainerName: "proxy", Language: common.UnknownProgrammingLanguage},
+			},
+		},
+		{
+			Kind: k8sconsts.WorkloadKindStatefulSet,
+			Name: "frontend",
+			RuntimeDetails: []odigosv1.RuntimeDetailsByContainer{
+				{ContainerName: "db", Language: common.JavaProgrammingLanguage},
+			},
+		},
+	}
+
+	merged := mergeInspectedWorkloads([][]InspectedWorkload{node1, node2})
+
+	assert.Equal(t, []InspectedWorkload{
+		{
+			Kind: k8sconsts.WorkloadKindDeployment,
+			Name: "frontend",
+			RuntimeDetails: []odigosv1.RuntimeDetailsByContainer{
+				{ContainerName: "app", Language: common.JavascriptProgrammingLanguage, RuntimeVersion: "20.1.0"},
+				{ContainerName: "proxy", Language: common.GoProgrammingLanguage},
+			},
+		},
+		{
+			Kind: k8sconsts.WorkloadKindStatefulSet,
+			Name: "frontend",
+			RuntimeDetails: []odigosv1.RuntimeDetailsByContainer{
+				{ContainerName: "db", Language: common.JavaProgrammingLanguage},
+			},
+		},
+	}, merged)
+}
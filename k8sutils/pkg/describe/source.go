@@ -69,6 +69,27 @@ func printInstrumentationConfigInfo(analyze *source.SourceAnalyze, sb *strings.B
 	}
 }
 
+func printAgentOverhead(analyze *source.SourceAnalyze, sb *strings.Builder) {
+	if analyze.AgentOverhead == nil {
+		return
+	}
+	describeText(sb, 0, false, "\nAgent Overhead:")
+	printProperty(sb, 1, &analyze.AgentOverhead.InstrumentationTime)
+	printProperty(sb, 1, &analyze.AgentOverhead.Window)
+	printProperty(sb, 1, analyze.AgentOverhead.Message)
+	if len(analyze.AgentOverhead.Containers) > 0 {
+		describeText(sb, 1, false, "Containers:")
+	}
+	for i := range analyze.AgentOverhead.Containers {
+		container := analyze.AgentOverhead.Containers[i]
+		printProperty(sb, 2, &container.ContainerName)
+		printProperty(sb, 2, &container.CPU)
+		printProperty(sb, 2, &container.Memory)
+		printProperty(sb, 2, container.RuntimeCPU)
+		printProperty(sb, 2, container.RuntimeMemory)
+	}
+}
+
 func printPodsInfo(analyze *source.SourceAnalyze, sb *strings.Builder) {
 	describeText(sb, 0, false, "\nPods (Total %d, %s):", analyze.TotalPods, analyze.PodsPhasesCount)
 
@@ -109,6 +130,7 @@ func DescribeSourceToText(analyze *source.SourceAnalyze) string {
 	printWorkloadManifestInfo(analyze, &sb)
 	printRuntimeDetails(analyze, &sb)
 	printInstrumentationConfigInfo(analyze, &sb)
+	printAgentOverhead(analyze, &sb)
 	printPodsInfo(analyze, &sb)
 
 	return sb.String()
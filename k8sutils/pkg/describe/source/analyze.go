@@ -46,6 +46,21 @@ type OtelAgentsAnalyze struct {
 	Containers []ContainerAgentConfigAnalyze `json:"containers"`
 }
 
+type ContainerAgentOverheadAnalyze struct {
+	ContainerName properties.EntityProperty  `json:"containerName"`
+	CPU           properties.EntityProperty  `json:"cpu"`
+	Memory        properties.EntityProperty  `json:"memory"`
+	RuntimeCPU    *properties.EntityProperty `json:"runtimeCpu,omitempty"`
+	RuntimeMemory *properties.EntityProperty `json:"runtimeMemory,omitempty"`
+}
+
+type AgentOverheadAnalyze struct {
+	InstrumentationTime properties.EntityProperty       `json:"instrumentationTime"`
+	Window              properties.EntityProperty       `json:"window"`
+	Message             *properties.EntityProperty      `json:"message"`
+	Containers          []ContainerAgentOverheadAnalyze `json:"containers"`
+}
+
 type InstrumentationInstanceAnalyze struct {
 	Healthy               properties.EntityProperty   `json:"healthy"`
 	Message               *properties.EntityProperty  `json:"message"`
@@ -76,8 +91,9 @@ type SourceAnalyze struct {
 	Namespace             properties.EntityProperty     `json:"namespace"`
 	SourceObjectsAnalysis InstrumentationSourcesAnalyze `json:"sourceObjects"`
 
-	RuntimeInfo *RuntimeInfoAnalyze `json:"runtimeInfo"`
-	OtelAgents  OtelAgentsAnalyze   `json:"otelAgents"`
+	RuntimeInfo   *RuntimeInfoAnalyze   `json:"runtimeInfo"`
+	OtelAgents    OtelAgentsAnalyze     `json:"otelAgents"`
+	AgentOverhead *AgentOverheadAnalyze `json:"agentOverhead,omitempty"`
 
 	TotalPods       int          `json:"totalPods"`
 	PodsPhasesCount string       `json:"podsPhasesCount"`
@@ -310,6 +326,78 @@ func analyzeRuntimeInfo(resources *OdigosSourceResources) *RuntimeInfoAnalyze {
 	}
 }
 
+// analyzeAgentOverhead returns nil when the overhead of the agents was not measured for this workload.
+func analyzeAgentOverhead(resources *OdigosSourceResources) *AgentOverheadAnalyze {
+	if resources.InstrumentationConfig == nil || resources.InstrumentationConfig.Status.AgentOverhead == nil {
+		return nil
+	}
+	overhead := resources.InstrumentationConfig.Status.AgentOverhead
+
+	var message *properties.EntityProperty
+	if overhead.Message != "" {
+		message = &properties.EntityProperty{
+			Name:    "Message",
+			Value:   overhead.Message,
+			Explain: "why some or all of the containers could not be measured",
+		}
+	}
+
+	containers := make([]ContainerAgentOverheadAnalyze, 0, len(overhead.Containers))
+	for _, c := range overhead.Containers {
+		var runtimeCPU, runtimeMemory *properties.EntityProperty
+		if c.RuntimeCPUMillicoresAfter != nil {
+			runtimeCPU = &properties.EntityProperty{
+				Name:    "Runtime CPU",
+				Value:   fmt.Sprintf("%dm", *c.RuntimeCPUMillicoresAfter),
+				Explain: "average cpu usage per pod of the instrumented process after the agent was enabled, as reported by the agent",
+			}
+		}
+		if c.RuntimeMemoryBytesAfter != nil {
+			runtimeMemory = &properties.EntityProperty{
+				Name:    "Runtime Memory",
+				Value:   fmt.Sprintf("%dMi", *c.RuntimeMemoryBytesAfter>>20),
+				Explain: "average memory used per pod by the instrumented process after the agent was enabled, as reported by the agent",
+			}
+		}
+		containers = append(containers, ContainerAgentOverheadAnalyze{
+			ContainerName: properties.EntityProperty{
+				Name:    "Container Name",
+				Value:   c.ContainerName,
+				ListKey: true,
+			},
+			CPU: properties.EntityProperty{
+				Name: "CPU",
+				Value: fmt.Sprintf("%dm -> %dm (%+dm)",
+					c.CPUMillicoresBefore, c.CPUMillicoresAfter, c.CPUMillicoresAfter-c.CPUMillicoresBefore),
+				Explain: "average cpu usage per pod before and after the agent was enabled",
+			},
+			Memory: properties.EntityProperty{
+				Name: "Memory",
+				Value: fmt.Sprintf("%dMi -> %dMi (%+dMi)",
+					c.MemoryBytesBefore>>20, c.MemoryBytesAfter>>20, (c.MemoryBytesAfter-c.MemoryBytesBefore)>>20),
+				Explain: "average memory usage per pod before and after the agent was enabled",
+			},
+			RuntimeCPU:    runtimeCPU,
+			RuntimeMemory: runtimeMemory,
+		})
+	}
+
+	return &AgentOverheadAnalyze{
+		InstrumentationTime: properties.EntityProperty{
+			Name:    "Instrumentation Time",
+			Value:   overhead.InstrumentationTime.String(),
+			Explain: "the time the agents were enabled, the usage is compared around it",
+		},
+		Window: properties.EntityProperty{
+			Name:    "Window",
+			Value:   overhead.Window,
+			Explain: "the length of the windows before and after the instrumentation time the usage is averaged over",
+		},
+		Message:    message,
+		Containers: containers,
+	}
+}
+
 func analyzeInstrumentationInstance(instrumentationInstance *odigosv1.InstrumentationInstance) InstrumentationInstanceAnalyze {
 	var healthy properties.EntityProperty
 	if instrumentationInstance.Status.Healthy == nil {
@@ -546,8 +634,9 @@ func AnalyzeSource(resources *OdigosSourceResources, workloadObj *K8sSourceObjec
 			Explain: "the namespace of the k8s workload object that this source describes"},
 		SourceObjectsAnalysis: sourcesAnalysis,
 
-		RuntimeInfo: runtimeAnalysis,
-		OtelAgents:  icAnalysis,
+		RuntimeInfo:   runtimeAnalysis,
+		OtelAgents:    icAnalysis,
+		AgentOverhead: analyzeAgentOverhead(resources),
 
 		TotalPods:       len(pods),
 		PodsPhasesCount: podsText,
@@ -0,0 +1,72 @@
+package injectionpreview
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	"github.com/odigos-io/odigos/k8sutils/pkg/proxyauth"
+	"github.com/odigos-io/odigos/k8sutils/pkg/workload"
+)
+
+// InjectionPreview is the outcome of running the odigos pods webhook against the current pod template of a workload,
+// without creating a pod.
+type InjectionPreview struct {
+	// true when the webhook would inject odigos to the pods of the workload.
+	Injected bool `json:"injected"`
+
+	// the JSON patch (RFC 6902) the webhook would apply to a pod created from the pod template,
+	// e.g. env vars, volumes, device resources and labels.
+	Patch []PatchOperation `json:"patch,omitempty"`
+
+	// why the webhook would skip the pod, or some of its containers.
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+type PatchOperation struct {
+	Operation string `json:"op"`
+	Path      string `json:"path"`
+	Value     any    `json:"value,omitempty"`
+}
+
+// Get runs the injection preview of a workload in the instrumentor,
+// through the kubernetes api server proxy to the instrumentor service.
+// The preview exposes the pod template of the workload, so the caller must be allowed to get it.
+func Get(ctx context.Context, client kubernetes.Interface, odigosNamespace string, pw k8sconsts.PodWorkload) (*InjectionPreview, error) {
+	groupResource, ok := workload.GroupResourceFromWorkloadKind(pw.Kind)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", workload.ErrKindNotSupported, pw.Kind)
+	}
+	err := proxyauth.CheckAccess(ctx, client, authorizationv1.ResourceAttributes{
+		Namespace: pw.Namespace,
+		Verb:      "get",
+		Group:     groupResource.Group,
+		Resource:  groupResource.Resource,
+		Name:      pw.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	params := map[string]string{
+		"namespace": pw.Namespace,
+		"kind":      string(pw.Kind),
+		"name":      pw.Name,
+	}
+	instrumentor := utilnet.JoinSchemeNamePort("https", k8sconsts.InstrumentorServiceName, strconv.Itoa(k8sconsts.InstrumentorWebhookPort))
+	response, err := proxyauth.ProxyGet(ctx, client, "", "services", odigosNamespace, instrumentor, k8sconsts.InstrumentorInjectionPreviewPath, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get injection preview from instrumentor: %w: %s", err, string(response))
+	}
+
+	var preview InjectionPreview
+	if err := json.Unmarshal(response, &preview); err != nil {
+		return nil, fmt.Errorf("failed to parse injection preview: %w", err)
+	}
+	return &preview, nil
+}
@@ -0,0 +1,28 @@
+package metricsstore
+
+import (
+	"fmt"
+
+	promapi "github.com/prometheus/client_golang/api"
+	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
+)
+
+// ServiceName is the service of the odigos own metrics store.
+const ServiceName = "odigos-victoriametrics"
+
+// URL returns the address of the Prometheus query API of the own metrics store in the odigos namespace.
+func URL(odigosNamespace string) string {
+	return fmt.Sprintf("http://%s.%s.svc:8428", ServiceName, odigosNamespace)
+}
+
+// NewAPI returns a Prometheus query API client of the own metrics store in the odigos namespace.
+func NewAPI(odigosNamespace string) (v1.API, error) {
+	client, err := promapi.NewClient(promapi.Config{
+		Address:      URL(odigosNamespace),
+		RoundTripper: promapi.DefaultRoundTripper,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v1.NewAPI(client), nil
+}
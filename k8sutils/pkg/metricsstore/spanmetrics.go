@@ -0,0 +1,76 @@
+package metricsstore
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	"github.com/odigos-io/odigos/common/consts"
+)
+
+// Selectors of the span metrics calculated by the node collector spanmetrics connector.
+// The metric names are matched with optional suffixes, depending on the metrics store OTLP naming.
+const (
+	SpanMetricsCallsName          = `traces_span_metrics_calls(_total)?`
+	SpanMetricsDurationBucketName = `traces_span_metrics_duration(_milliseconds)?_bucket`
+	ServerSpanKindMatcher         = `span.kind="SPAN_KIND_SERVER"`
+	ErrorStatusMatcher            = `status.code="STATUS_CODE_ERROR"`
+)
+
+// WorkloadNameLabel is the resource attribute carrying the name of a workload of the kind.
+type WorkloadNameLabel struct {
+	Kind  k8sconsts.WorkloadKind
+	Label string
+}
+
+// K8sWorkloadNameLabels are the workload name attributes set on the span metrics series.
+// Only one of them is set on each series, and only when the odigos workload attributes are missing.
+// DeploymentConfig pods carry k8s.deployment.name, so they are reported as deployments.
+var K8sWorkloadNameLabels = []WorkloadNameLabel{
+	{Kind: k8sconsts.WorkloadKindDeployment, Label: "k8s.deployment.name"},
+	{Kind: k8sconsts.WorkloadKindStatefulSet, Label: "k8s.statefulset.name"},
+	{Kind: k8sconsts.WorkloadKindDaemonSet, Label: "k8s.daemonset.name"},
+	{Kind: k8sconsts.WorkloadKindCronJob, Label: "k8s.cronjob.name"},
+	{Kind: k8sconsts.WorkloadKindJob, Label: "k8s.job.name"},
+	{Kind: k8sconsts.WorkloadKindArgoRollout, Label: "k8s.argoproj.rollout.name"},
+}
+
+// K8sWorkloadNameLabel returns the workload name attribute of the kind.
+func K8sWorkloadNameLabel(kind k8sconsts.WorkloadKind) (string, bool) {
+	for _, l := range K8sWorkloadNameLabels {
+		if l.Kind == kind {
+			return l.Label, true
+		}
+	}
+	return "", false
+}
+
+// WorkloadMatchers returns the alternative label matchers of the series of a workload.
+// A series carries the workload either as the odigos workload attributes, whose kind is not consistently cased,
+// or as the k8s workload name attribute of its kind, so each matcher is queried separately and the results joined.
+func WorkloadMatchers(kind k8sconsts.WorkloadKind, name string) []string {
+	matchers := []string{
+		fmt.Sprintf(`%s=~%q, %s=%q`, consts.OdigosWorkloadKindAttribute, "(?i)"+regexp.QuoteMeta(string(kind)), consts.OdigosWorkloadNameAttribute, name),
+	}
+	if label, ok := K8sWorkloadNameLabel(kind); ok {
+		matchers = append(matchers, fmt.Sprintf(`%s=%q`, label, name))
+	}
+	return matchers
+}
+
+// PromDuration formats the duration as a PromQL range, in the largest whole unit of hours, minutes or seconds.
+// Durations shorter than a second are rounded up to one.
+func PromDuration(duration time.Duration) string {
+	if duration >= time.Hour && duration%time.Hour == 0 {
+		return fmt.Sprintf("%dh", int(duration.Hours()))
+	}
+	if duration >= time.Minute && duration%time.Minute == 0 {
+		return fmt.Sprintf("%dm", int(duration.Minutes()))
+	}
+	seconds := int(duration.Seconds())
+	if seconds < 1 {
+		seconds = 1
+	}
+	return fmt.Sprintf("%ds", seconds)
+}
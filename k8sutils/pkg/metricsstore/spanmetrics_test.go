@@ -0,0 +1,27 @@
+package metricsstore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+)
+
+func TestPromDuration(t *testing.T) {
+	require.Equal(t, "1h", PromDuration(time.Hour))
+	require.Equal(t, "15m", PromDuration(15*time.Minute))
+	require.Equal(t, "90s", PromDuration(90*time.Second))
+	require.Equal(t, "1s", PromDuration(500*time.Millisecond))
+}
+
+func TestWorkloadMatchers(t *testing.T) {
+	require.Equal(t, []string{
+		`odigos.workload.kind=~"(?i)Deployment", odigos.workload.name="cart"`,
+		`k8s.deployment.name="cart"`,
+	}, WorkloadMatchers(k8sconsts.WorkloadKindDeployment, "cart"))
+
+	// static pods have no k8s workload name attribute
+	require.Len(t, WorkloadMatchers(k8sconsts.WorkloadKindStaticPod, "cart"), 1)
+}
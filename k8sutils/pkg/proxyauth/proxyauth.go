@@ -0,0 +1,155 @@
+// Package proxyauth authenticates and authorizes the requests to the internal http endpoints of odigos components,
+// which are reached through the kubernetes api server proxy.
+//
+// Users (the cli and the ui) never send their credentials to odigos components. Before proxying a request,
+// CheckAccess verifies with a SelfSubjectAccessReview that the caller is allowed to read what the endpoint exposes,
+// which works with any kubeconfig (bearer tokens, client certificates, exec plugins), and reaching the
+// endpoint through the proxy requires the caller to be allowed the proxy subresource in the odigos namespace.
+//
+// Odigos components calling each other (the instrumentor calling odiglet) pass their service account token
+// in the TokenHeader header instead, since the api server removes the Authorization header from the requests it proxies.
+// The endpoint verifies the token with a TokenReview, so reaching it directly from another pod grants nothing.
+package proxyauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// TokenHeader is the request header holding the service account token of the calling odigos component.
+const TokenHeader = "X-Odigos-Token"
+
+var (
+	ErrUnauthenticated = errors.New("request is not authenticated")
+	ErrForbidden       = errors.New("request is forbidden")
+)
+
+// Token returns the bearer token the client config authenticates with, including tokens read from a file.
+// It is meant for the in-cluster config of odigos components, which always authenticate with a service account token.
+func Token(config *rest.Config) (string, error) {
+	var token string
+	rt, err := rest.HTTPWrappersForConfig(config, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if authorization := req.Header.Get("Authorization"); strings.HasPrefix(authorization, "Bearer ") {
+			token = strings.TrimPrefix(authorization, "Bearer ")
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+	}))
+	if err != nil {
+		return "", fmt.Errorf("failed to build the transport of the kubernetes client: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "https://kubernetes.default.svc", nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the bearer token of the kubernetes client: %w", err)
+	}
+	_ = resp.Body.Close()
+
+	if token == "" {
+		return "", errors.New("the kubernetes credentials do not include a bearer token")
+	}
+	return token, nil
+}
+
+// CheckAccess verifies that the caller of the client is allowed the given resource attributes,
+// with a SelfSubjectAccessReview, before it requests an endpoint which exposes them.
+func CheckAccess(ctx context.Context, client kubernetes.Interface, attributes authorizationv1.ResourceAttributes) error {
+	review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attributes},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to review access: %w", err)
+	}
+	if !review.Status.Allowed {
+		return fmt.Errorf("%w: cannot %s %s in namespace %q", ErrForbidden, attributes.Verb, attributes.Resource, attributes.Namespace)
+	}
+	return nil
+}
+
+// ProxyGet sends a GET request to a service or a pod (resource "services" or "pods") through the api server proxy.
+// A non-empty token is sent in the TokenHeader header. name is in the [scheme:]name[:port] form of the proxy subresource.
+func ProxyGet(ctx context.Context, client kubernetes.Interface, token string, resource string, namespace string, name string, path string, params map[string]string) ([]byte, error) {
+	request := client.CoreV1().RESTClient().Get().
+		Namespace(namespace).
+		Resource(resource).
+		Name(name).
+		SubResource("proxy").
+		Suffix(path)
+	if token != "" {
+		request = request.SetHeader(TokenHeader, token)
+	}
+	for key, value := range params {
+		request = request.Param(key, value)
+	}
+	return request.DoRaw(ctx)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Authorizer checks the caller of a request against the kubernetes api server.
+type Authorizer struct {
+	client kubernetes.Interface
+}
+
+func NewAuthorizer(client kubernetes.Interface) *Authorizer {
+	return &Authorizer{client: client}
+}
+
+// Authenticate verifies the bearer token of the request and returns the user it belongs to.
+func (a *Authorizer) Authenticate(ctx context.Context, r *http.Request) (*authenticationv1.UserInfo, error) {
+	token := r.Header.Get(TokenHeader)
+	if token == "" {
+		return nil, fmt.Errorf("%w: missing %s header", ErrUnauthenticated, TokenHeader)
+	}
+
+	review, err := a.client.AuthenticationV1().TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to review token: %w", err)
+	}
+	if !review.Status.Authenticated {
+		return nil, fmt.Errorf("%w: %s", ErrUnauthenticated, review.Status.Error)
+	}
+	return &review.Status.User, nil
+}
+
+// AuthorizeServiceAccount authenticates the request, and checks that it was made by the given service account.
+func (a *Authorizer) AuthorizeServiceAccount(ctx context.Context, r *http.Request, namespace, name string) error {
+	user, err := a.Authenticate(ctx, r)
+	if err != nil {
+		return err
+	}
+	if expected := "system:serviceaccount:" + namespace + ":" + name; user.Username != expected {
+		return fmt.Errorf("%w: only %s is allowed, got %q", ErrForbidden, expected, user.Username)
+	}
+	return nil
+}
+
+// WriteError writes the http error response of a failed authentication or authorization.
+func WriteError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, ErrUnauthenticated):
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+	case errors.Is(err, ErrForbidden):
+		http.Error(w, err.Error(), http.StatusForbidden)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
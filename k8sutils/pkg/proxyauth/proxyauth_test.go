@@ -0,0 +1,101 @@
+package proxyauth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newFakeAuthorizer authenticates the token "valid" as user "alice".
+func newFakeAuthorizer() *Authorizer {
+	client := fake.NewClientset()
+	client.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		if review.Spec.Token == "valid" {
+			review.Status.Authenticated = true
+			review.Status.User = authenticationv1.UserInfo{Username: "alice", Groups: []string{"system:authenticated"}}
+		}
+		return true, review, nil
+	})
+	return NewAuthorizer(client)
+}
+
+func requestWithToken(token string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	if token != "" {
+		r.Header.Set(TokenHeader, token)
+	}
+	return r
+}
+
+func TestAuthenticate(t *testing.T) {
+	a := newFakeAuthorizer()
+	ctx := context.Background()
+
+	user, err := a.Authenticate(ctx, requestWithToken("valid"))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", user.Username)
+
+	_, err = a.Authenticate(ctx, requestWithToken(""))
+	assert.True(t, errors.Is(err, ErrUnauthenticated))
+
+	_, err = a.Authenticate(ctx, requestWithToken("forged"))
+	assert.True(t, errors.Is(err, ErrUnauthenticated))
+}
+
+func TestCheckAccess(t *testing.T) {
+	// the caller is allowed to get deployments only
+	client := fake.NewClientset()
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		attributes := review.Spec.ResourceAttributes
+		review.Status.Allowed = attributes.Verb == "get" && attributes.Resource == "deployments"
+		return true, review, nil
+	})
+	ctx := context.Background()
+
+	getDeployment := authorizationv1.ResourceAttributes{Namespace: "default", Verb: "get", Group: "apps", Resource: "deployments", Name: "api"}
+	require.NoError(t, CheckAccess(ctx, client, getDeployment))
+
+	listPods := authorizationv1.ResourceAttributes{Namespace: "default", Verb: "list", Resource: "pods"}
+	err := CheckAccess(ctx, client, listPods)
+	assert.True(t, errors.Is(err, ErrForbidden))
+}
+
+func TestAuthorizeServiceAccount(t *testing.T) {
+	a := newFakeAuthorizer()
+	err := a.AuthorizeServiceAccount(context.Background(), requestWithToken("valid"), "odigos-system", "odigos-instrumentor")
+	assert.True(t, errors.Is(err, ErrForbidden))
+}
+
+func TestWriteError(t *testing.T) {
+	for err, status := range map[error]int{
+		ErrUnauthenticated:       http.StatusUnauthorized,
+		ErrForbidden:             http.StatusForbidden,
+		errors.New("api failed"): http.StatusInternalServerError,
+	} {
+		w := httptest.NewRecorder()
+		WriteError(w, err)
+		assert.Equal(t, status, w.Code)
+	}
+}
+
+func TestToken(t *testing.T) {
+	token, err := Token(&rest.Config{Host: "https://example.com", BearerToken: "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, "secret", token)
+
+	_, err = Token(&rest.Config{Host: "https://example.com", Username: "admin", Password: "admin"})
+	assert.Error(t, err)
+}
@@ -8,6 +8,7 @@ import (
 	v1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	argorolloutsv1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
@@ -164,3 +165,30 @@ func ClientListObjectFromWorkloadKind(kind k8sconsts.WorkloadKind) client.Object
 		return nil
 	}
 }
+
+// GroupResourceFromWorkloadKind returns the api group and resource of a workload kind,
+// e.g. to check the access of a user to a workload with a SubjectAccessReview.
+func GroupResourceFromWorkloadKind(kind k8sconsts.WorkloadKind) (schema.GroupResource, bool) {
+	switch kind {
+	case k8sconsts.WorkloadKindDeployment:
+		return schema.GroupResource{Group: "apps", Resource: "deployments"}, true
+	case k8sconsts.WorkloadKindDaemonSet:
+		return schema.GroupResource{Group: "apps", Resource: "daemonsets"}, true
+	case k8sconsts.WorkloadKindStatefulSet:
+		return schema.GroupResource{Group: "apps", Resource: "statefulsets"}, true
+	case k8sconsts.WorkloadKindNamespace:
+		return schema.GroupResource{Resource: "namespaces"}, true
+	case k8sconsts.WorkloadKindStaticPod:
+		return schema.GroupResource{Resource: "pods"}, true
+	case k8sconsts.WorkloadKindCronJob:
+		return schema.GroupResource{Group: "batch", Resource: "cronjobs"}, true
+	case k8sconsts.WorkloadKindJob:
+		return schema.GroupResource{Group: "batch", Resource: "jobs"}, true
+	case k8sconsts.WorkloadKindDeploymentConfig:
+		return schema.GroupResource{Group: "apps.openshift.io", Resource: "deploymentconfigs"}, true
+	case k8sconsts.WorkloadKindArgoRollout:
+		return schema.GroupResource{Group: "argoproj.io", Resource: "rollouts"}, true
+	default:
+		return schema.GroupResource{}, false
+	}
+}
@@ -61,3 +61,16 @@ func TestClientObjectFromWorkloadKind(t *testing.T) {
 	invalid := workload.ClientObjectFromWorkloadKind("Invalid")
 	assert.Equal(t, nil, invalid)
 }
+
+func TestGroupResourceFromWorkloadKind(t *testing.T) {
+	dep, ok := workload.GroupResourceFromWorkloadKind(k8sconsts.WorkloadKindDeployment)
+	assert.True(t, ok)
+	assert.Equal(t, "apps", dep.Group)
+	assert.Equal(t, "deployments", dep.Resource)
+	cj, ok := workload.GroupResourceFromWorkloadKind(k8sconsts.WorkloadKindCronJob)
+	assert.True(t, ok)
+	assert.Equal(t, "batch", cj.Group)
+	assert.Equal(t, "cronjobs", cj.Resource)
+	_, ok = workload.GroupResourceFromWorkloadKind("Invalid")
+	assert.False(t, ok)
+}
@@ -297,6 +297,12 @@ func OdigletInitPhase(clientset *kubernetes.Clientset) {
 		os.Exit(-1)
 	}
 
+	err = fs.CopyAgentChannelsToHost(k8sconsts.OdigletContainerAgentChannelsDirectory, k8sconsts.OdigosAgentsDirectory, nil)
+	if err != nil {
+		logger.Error("Failed to copy agent version channels to host", "err", err)
+		os.Exit(-1)
+	}
+
 	nn, ok := os.LookupEnv(k8sconsts.NodeNameEnvVar)
 	if !ok {
 		logger.Error("Failed to load env", "err", fmt.Errorf("env var %s is not set", k8sconsts.NodeNameEnvVar))
@@ -13,6 +13,7 @@ import (
 	"github.com/odigos-io/odigos/instrumentation"
 
 	"go.opentelemetry.io/auto"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 )
 
@@ -58,7 +59,8 @@ func (g *GoInstrumentationFactory) CreateInstrumentation(ctx context.Context, pi
 		ctx,
 		auto.WithEnv(), // for OTEL_LOG_LEVEL
 		auto.WithPID(pid),
-		auto.WithResourceAttributes(settings.ResourceAttributes...),
+		// the attributes odigos sets come last, so they take precedence over the ones of the container override
+		auto.WithResourceAttributes(append(sdkResourceAttributes(settings.InitialConfig), settings.ResourceAttributes...)...),
 		auto.WithServiceName(settings.ServiceName),
 		auto.WithTraceExporter(defaultExporter),
 		auto.WithGlobal(),
@@ -119,7 +121,36 @@ func convertToGoInstrumentationConfig(config instrumentation.Config) (auto.Instr
 		}
 	}
 
+	// libraries disabled in the container override take precedence over the trace verbosity rules
+	if containerConfig.Sdk != nil && len(containerConfig.Sdk.DisabledInstrumentationLibraries) > 0 {
+		if ic.InstrumentationLibraryConfigs == nil {
+			ic.InstrumentationLibraryConfigs = make(map[auto.InstrumentationLibraryID]auto.InstrumentationLibrary)
+		}
+		falseVal := false
+		for _, libName := range containerConfig.Sdk.DisabledInstrumentationLibraries {
+			libID := auto.InstrumentationLibraryID{InstrumentedPkg: libName}
+			ic.InstrumentationLibraryConfigs[libID] = auto.InstrumentationLibrary{TracesEnabled: &falseVal}
+		}
+	}
+
 	// TODO: take sampling config from containerConfig.Traces.HeadSampling
 	ic.Sampler = auto.DefaultSampler()
+
+	// the go instrumentation injects and extracts the w3c trace context only.
+	// other propagators in containerConfig.Sdk are reported by the instrumentor in the ContextPropagation condition.
 	return ic, nil
 }
+
+// sdkResourceAttributes returns the resource attributes of the container override.
+// They are set when the process is instrumented, and changes apply to processes instrumented afterwards.
+func sdkResourceAttributes(config instrumentation.Config) []attribute.KeyValue {
+	containerConfig, ok := config.(*odigosv1.ContainerAgentConfig)
+	if !ok || containerConfig == nil || containerConfig.Sdk == nil {
+		return nil
+	}
+	attrs := make([]attribute.KeyValue, 0, len(containerConfig.Sdk.ResourceAttributes))
+	for key, value := range containerConfig.Sdk.ResourceAttributes {
+		attrs = append(attrs, attribute.String(key, value))
+	}
+	return attrs
+}
@@ -64,6 +64,8 @@ func NewManager() *Manager {
 // collector. PIDs are supplied dynamically via the DynamicPIDSelector.
 func obiConfigForOdigos() *obipkg.Config {
 	cfg := obipkg.DefaultConfig
+	// OBI propagates the w3c traceparent header only. Other propagators configured for a container
+	// are reported as unsupported by the instrumentor in the ContextPropagation condition.
 	cfg.EBPF.ContextPropagation = obiconfig.ContextPropagationHeaders
 
 	// Export traces to the node collector (same node as odiglet). Use http scheme for insecure gRPC.
@@ -226,10 +228,22 @@ func networkMetricsEnabled(config instrumentation.Config) bool {
 // dynamicPIDOptions maps instrumentation.Settings (from InstrumentationConfig service name +
 // odiglet-built resource attributes) to OBI's per-PID DynamicPIDOptions. Service name and
 // resource attributes are shared across all signals for the same PID.
+// The resource attributes of the container override are added, without replacing the ones odiglet built.
 func dynamicPIDOptions(settings instrumentation.Settings) selection.DynamicPIDOptions {
+	attrs := resourceAttributesMap(settings.ResourceAttributes)
+	if cc, ok := settings.InitialConfig.(*odigosv1.ContainerAgentConfig); ok && cc != nil && cc.Sdk != nil {
+		for key, value := range cc.Sdk.ResourceAttributes {
+			if attrs == nil {
+				attrs = make(map[string]string, len(cc.Sdk.ResourceAttributes))
+			}
+			if _, exists := attrs[key]; !exists {
+				attrs[key] = value
+			}
+		}
+	}
 	return selection.DynamicPIDOptions{
 		ServiceName:        settings.ServiceName,
-		ResourceAttributes: resourceAttributesMap(settings.ResourceAttributes),
+		ResourceAttributes: attrs,
 	}
 }
 
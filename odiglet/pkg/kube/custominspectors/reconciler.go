@@ -0,0 +1,71 @@
+package custominspectors
+
+import (
+	"context"
+	"reflect"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/odigos-io/odigos/common"
+	odigospredicate "github.com/odigos-io/odigos/k8sutils/pkg/predicate"
+	k8sutils "github.com/odigos-io/odigos/k8sutils/pkg/utils"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors"
+)
+
+var (
+	mu sync.Mutex
+	// applied holds the definitions last applied to the language detection, nil until they are first loaded.
+	applied *[]common.CustomInspector
+)
+
+// Load applies the custom inspectors from the effective config to the language detection used by odiglet.
+// It is called before the startup runtime detection, so the first scan already uses them,
+// and returns true when it replaces definitions which were loaded before.
+func Load(ctx context.Context, c client.Client) (bool, error) {
+	cfg, err := k8sutils.GetCurrentOdigosConfiguration(ctx, c)
+	if err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if applied != nil && reflect.DeepEqual(*applied, cfg.CustomInspectors) {
+		return false, nil
+	}
+	updated := applied != nil
+	definitions := cfg.CustomInspectors
+	applied = &definitions
+
+	// invalid definitions are skipped, there is no point in retrying until the config changes
+	if err := inspectors.SetCustomInspectors(definitions); err != nil {
+		log.FromContext(ctx).Error(err, "some custom inspectors are invalid and were ignored")
+	}
+	return updated, nil
+}
+
+// CustomInspectorsReconciler applies changes of the custom inspectors in the effective config,
+// and repeats the runtime detection of the pods on the node, as their detected language may change.
+type CustomInspectorsReconciler struct {
+	client.Client
+	Redetect func(ctx context.Context) error
+}
+
+func (r *CustomInspectorsReconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
+	updated, err := Load(ctx, r.Client)
+	if err != nil || !updated {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, r.Redetect(ctx)
+}
+
+func SetupWithManager(mgr ctrl.Manager, redetect func(ctx context.Context) error) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("custominspectors-effectiveconfig").
+		For(&corev1.ConfigMap{}).
+		WithEventFilter(&odigospredicate.OdigosEffectiveConfigMapPredicate).
+		Complete(&CustomInspectorsReconciler{Client: mgr.GetClient(), Redetect: redetect})
+}
@@ -326,7 +326,10 @@ func getContainerID(containerStatuses []corev1.ContainerStatus, containerName st
 	return ""
 }
 
-func persistRuntimeDetailsToInstrumentationConfig(ctx context.Context, kubeclient client.Client, instrumentationConfig *odigosv1.InstrumentationConfig, inspectionResults InspectionResults) error {
+// persistRuntimeDetailsToInstrumentationConfig writes the inspection results to the instrumentation config status.
+// languageChanged is set when the language detection itself changed (custom inspectors were updated),
+// so a detected language may replace the one already recorded.
+func persistRuntimeDetailsToInstrumentationConfig(ctx context.Context, kubeclient client.Client, instrumentationConfig *odigosv1.InstrumentationConfig, inspectionResults InspectionResults, languageChanged bool) error {
 	// fetch a fresh copy of instrumentation config.
 	// TODO: is this necessary? can we do it with the existing object?
 	currentConfig := &odigosv1.InstrumentationConfig{}
@@ -349,7 +352,7 @@ func persistRuntimeDetailsToInstrumentationConfig(ctx context.Context, kubeclien
 				existingDetail := &currentConfig.Status.RuntimeDetailsByContainer[j]
 				if newDetail.ContainerName == existingDetail.ContainerName {
 					podKey := strings.Join([]string{currentConfig.Namespace, currentConfig.Name}, "/")
-					if mergeRuntimeDetails(existingDetail, newDetail, podKey) {
+					if mergeRuntimeDetails(existingDetail, newDetail, podKey, languageChanged) {
 						updated = true
 					}
 				}
@@ -417,10 +420,11 @@ func addConditions(inspectionResults InspectionResults, currentConfig *odigosv1.
 	})
 }
 
-func mergeRuntimeDetails(existing *odigosv1.RuntimeDetailsByContainer, new odigosv1.RuntimeDetailsByContainer, podIdentintifier string) bool {
+func mergeRuntimeDetails(existing *odigosv1.RuntimeDetailsByContainer, new odigosv1.RuntimeDetailsByContainer, podIdentintifier string, languageChanged bool) bool {
 	logger := commonlogger.LoggerCompat().With("subsystem", "runtimeinspection")
-	// Skip merging if languages are different, except when updating from unknown to known language.
-	if new.Language != existing.Language &&
+	// Skip merging if languages are different, except when updating from unknown to known language,
+	// or when the language detection changed and the container is re-detected.
+	if new.Language != existing.Language && !languageChanged &&
 		!(new.Language != common.UnknownProgrammingLanguage && existing.Language == common.UnknownProgrammingLanguage) {
 		logger.Info("detected different language, skipping merge runtime details", "pod_identifier", podIdentintifier, "container_name", new.ContainerName, "new.Language", new.Language, "existing.Language", existing.Language)
 		return false
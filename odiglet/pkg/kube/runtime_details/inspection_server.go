@@ -0,0 +1,238 @@
+package runtime_details
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	commonlogger "github.com/odigos-io/odigos/common/logger"
+	"github.com/odigos-io/odigos/k8sutils/pkg/compatibility"
+	criwrapper "github.com/odigos-io/odigos/k8sutils/pkg/cri"
+	"github.com/odigos-io/odigos/k8sutils/pkg/proxyauth"
+	"github.com/odigos-io/odigos/k8sutils/pkg/workload"
+	"github.com/odigos-io/odigos/odiglet/pkg/process"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	certutil "k8s.io/client-go/util/cert"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// results of a namespace inspection are reused for this long,
+// so repeated compatibility reports do not scan /proc of the node on every request.
+const runtimeInspectionCacheTTL = 30 * time.Second
+
+// runtimeInspectionServer is a Runnable that serves on-demand runtime inspection of the pods on this node.
+// Unlike the runtime details controllers, it inspects workloads which are not instrumented,
+// and returns the results to the caller instead of persisting them to an instrumentation config.
+// It is used by the instrumentor to report the compatibility of a namespace before it is instrumented,
+// and only serves the service account of the instrumentor, through the api server pods proxy.
+type runtimeInspectionServer struct {
+	client               client.Client
+	criClient            *criwrapper.CriClient
+	runtimeDetectionEnvs map[string]struct{}
+	authorizer           *proxyauth.Authorizer
+	odigosNamespace      string
+	cache                inspectionCache
+}
+
+var _ manager.Runnable = &runtimeInspectionServer{}
+
+func (s *runtimeInspectionServer) Start(ctx context.Context) error {
+	logger := commonlogger.FromContext(ctx)
+
+	// the api server proxy reaches the pod by its IP. Without it, the server is only reachable locally.
+	host, found := os.LookupEnv(k8sconsts.PodIPEnvVar)
+	if !found {
+		logger.Info("pod IP is not set, runtime inspection is only served on localhost", "env", k8sconsts.PodIPEnvVar)
+		host = "127.0.0.1"
+	}
+
+	// the api server proxy does not verify the certificate of pods,
+	// but tls keeps the token of the caller from being sent in plain text.
+	certPEM, keyPEM, err := certutil.GenerateSelfSignedCertKey(host, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to generate the runtime inspection server certificate: %w", err)
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return fmt.Errorf("failed to load the runtime inspection server certificate: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(k8sconsts.OdigletRuntimeInspectionPath, s.handleInspection)
+	server := &http.Server{
+		Addr:              net.JoinHostPort(host, strconv.Itoa(k8sconsts.OdigletRuntimeInspectionPort)),
+		Handler:           mux,
+		TLSConfig:         &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12},
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	if err := server.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("runtime inspection server failed: %w", err)
+	}
+	return nil
+}
+
+func (s *runtimeInspectionServer) handleInspection(w http.ResponseWriter, r *http.Request) {
+	logger := commonlogger.FromContext(r.Context())
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	namespace := r.URL.Query().Get("namespace")
+	if namespace == "" {
+		http.Error(w, "namespace is required", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.authorizer.AuthorizeServiceAccount(r.Context(), r, s.odigosNamespace, k8sconsts.InstrumentorServiceAccountName); err != nil {
+		logger.Info("rejected runtime inspection request", "namespace", namespace, "reason", err.Error())
+		proxyauth.WriteError(w, err)
+		return
+	}
+	// odigos and the control plane are never instrumented, and their processes are not exposed
+	if namespace == s.odigosNamespace || namespace == metav1.NamespaceSystem {
+		http.Error(w, fmt.Sprintf("namespace %s cannot be inspected", namespace), http.StatusForbidden)
+		return
+	}
+
+	inspected, err := s.cache.get(r.Context(), namespace, s.inspectNamespace)
+	if err != nil {
+		logger.Error(err, "failed to inspect namespace", "namespace", namespace)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(inspected); err != nil {
+		logger.Error(err, "failed to write runtime inspection response")
+	}
+}
+
+// inspectNamespace inspects the running pods of the namespace on this node, grouped by their workload.
+// All the processes are grouped with a single /proc scan, as done in the startup runtime detection.
+func (s *runtimeInspectionServer) inspectNamespace(ctx context.Context, namespace string) ([]compatibility.InspectedWorkload, error) {
+	// the pods cache of odiglet only holds the pods of this node
+	var podList corev1.PodList
+	if err := s.client.List(ctx, &podList, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	workloadPods := map[k8sconsts.PodWorkload][]corev1.Pod{}
+	var workloads []k8sconsts.PodWorkload
+	var allPCs []process.PodContainer
+	for _, pod := range podList.Items {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		pw, err := workload.PodWorkloadObject(&pod)
+		if err != nil || pw == nil {
+			// pods which are not managed by a workload cannot be instrumented by a source
+			continue
+		}
+		if _, found := workloadPods[*pw]; !found {
+			workloads = append(workloads, *pw)
+		}
+		workloadPods[*pw] = append(workloadPods[*pw], pod)
+
+		uid := workload.PodUID(&pod)
+		for _, c := range pod.Spec.Containers {
+			allPCs = append(allPCs, process.PodContainer{
+				PodContainerKey: process.PodContainerKey{
+					PodUID: uid, ContainerName: c.Name,
+				},
+				ContainerID: getContainerID(pod.Status.ContainerStatuses, c.Name),
+				QOSClass:    pod.Status.QOSClass,
+			})
+		}
+	}
+
+	inspected := make([]compatibility.InspectedWorkload, 0, len(workloads))
+	if len(workloads) == 0 {
+		return inspected, nil
+	}
+
+	groups, err := process.GroupByPodContainer(allPCs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to group processes: %w", err)
+	}
+
+	var inspectionErr error
+	for _, pw := range workloads {
+		results, err := runtimeInspectionFromGroupedPIDs(ctx, workloadPods[pw], groups, s.criClient, s.runtimeDetectionEnvs)
+		if err != nil {
+			inspectionErr = errors.Join(inspectionErr, fmt.Errorf("%s/%s: %w", pw.Kind, pw.Name, err))
+			continue
+		}
+		inspected = append(inspected, compatibility.InspectedWorkload{
+			Kind:           pw.Kind,
+			Name:           pw.Name,
+			RuntimeDetails: results.runtimeDetailsList(),
+		})
+	}
+	if inspectionErr != nil {
+		if len(inspected) == 0 {
+			return nil, inspectionErr
+		}
+		commonlogger.FromContext(ctx).Error(inspectionErr, "failed to inspect some of the workloads", "namespace", namespace)
+	}
+
+	return inspected, nil
+}
+
+type cachedInspection struct {
+	inspected []compatibility.InspectedWorkload
+	expires   time.Time
+}
+
+// inspectionCache keeps the recent inspection of each namespace, and serializes the inspections,
+// so concurrent requests never run more than one /proc scan at a time.
+type inspectionCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedInspection
+}
+
+func (c *inspectionCache) get(ctx context.Context, namespace string,
+	inspect func(ctx context.Context, namespace string) ([]compatibility.InspectedWorkload, error)) ([]compatibility.InspectedWorkload, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if cached, found := c.entries[namespace]; found && now.Before(cached.expires) {
+		return cached.inspected, nil
+	}
+
+	inspected, err := inspect(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	for ns, cached := range c.entries {
+		if !now.Before(cached.expires) {
+			delete(c.entries, ns)
+		}
+	}
+	if c.entries == nil {
+		c.entries = map[string]cachedInspection{}
+	}
+	c.entries[namespace] = cachedInspection{inspected: inspected, expires: now.Add(runtimeInspectionCacheTTL)}
+	return inspected, nil
+}
@@ -0,0 +1,123 @@
+package runtime_details
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	"github.com/odigos-io/odigos/k8sutils/pkg/compatibility"
+	"github.com/odigos-io/odigos/k8sutils/pkg/proxyauth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const testOdigosNamespace = "odigos-system"
+
+// newTestInspectionServer authenticates the token "instrumentor" as the instrumentor service account,
+// and the token "ui" as the service account of the ui.
+func newTestInspectionServer() *runtimeInspectionServer {
+	clientset := fake.NewClientset()
+	clientset.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		switch review.Spec.Token {
+		case "instrumentor":
+			review.Status.Authenticated = true
+			review.Status.User.Username = "system:serviceaccount:" + testOdigosNamespace + ":" + k8sconsts.InstrumentorServiceAccountName
+		case "ui":
+			review.Status.Authenticated = true
+			review.Status.User.Username = "system:serviceaccount:" + testOdigosNamespace + ":" + k8sconsts.UIServiceAccountName
+		}
+		return true, review, nil
+	})
+	return &runtimeInspectionServer{
+		authorizer:      proxyauth.NewAuthorizer(clientset),
+		odigosNamespace: testOdigosNamespace,
+	}
+}
+
+func inspectionRequest(token string, namespace string) *http.Request {
+	r := httptest.NewRequest(http.MethodGet, k8sconsts.OdigletRuntimeInspectionPath+"?namespace="+namespace, nil)
+	if token != "" {
+		r.Header.Set(proxyauth.TokenHeader, token)
+	}
+	return r
+}
+
+func TestHandleInspection_Rejected(t *testing.T) {
+	s := newTestInspectionServer()
+
+	for name, tc := range map[string]struct {
+		token     string
+		namespace string
+		status    int
+	}{
+		"missing token":         {token: "", namespace: "default", status: http.StatusUnauthorized},
+		"unknown token":         {token: "forged", namespace: "default", status: http.StatusUnauthorized},
+		"other service":         {token: "ui", namespace: "default", status: http.StatusForbidden},
+		"odigos namespace":      {token: "instrumentor", namespace: testOdigosNamespace, status: http.StatusForbidden},
+		"kube-system namespace": {token: "instrumentor", namespace: "kube-system", status: http.StatusForbidden},
+	} {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			s.handleInspection(w, inspectionRequest(tc.token, tc.namespace))
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
+
+func TestHandleInspection_ServesInstrumentor(t *testing.T) {
+	s := newTestInspectionServer()
+	cached := []compatibility.InspectedWorkload{{Kind: k8sconsts.WorkloadKindDeployment, Name: "api"}}
+	s.cache.entries = map[string]cachedInspection{
+		"default": {inspected: cached, expires: time.Now().Add(time.Minute)},
+	}
+
+	w := httptest.NewRecorder()
+	s.handleInspection(w, inspectionRequest("instrumentor", "default"))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var inspected []compatibility.InspectedWorkload
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &inspected))
+	assert.Equal(t, cached, inspected)
+}
+
+func TestInspectionCache(t *testing.T) {
+	var c inspectionCache
+	calls := 0
+	inspect := func(ctx context.Context, namespace string) ([]compatibility.InspectedWorkload, error) {
+		calls++
+		return []compatibility.InspectedWorkload{{Name: namespace}}, nil
+	}
+
+	_, err := c.get(context.Background(), "default", inspect)
+	require.NoError(t, err)
+	inspected, err := c.get(context.Background(), "default", inspect)
+	require.NoError(t, err)
+	assert.Equal(t, "default", inspected[0].Name)
+	assert.Equal(t, 1, calls, "a recent inspection is reused")
+
+	_, err = c.get(context.Background(), "other", inspect)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+
+	c.entries["default"] = cachedInspection{expires: time.Now().Add(-time.Second)}
+	_, err = c.get(context.Background(), "default", inspect)
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls, "an expired inspection is repeated")
+
+	failing := func(ctx context.Context, namespace string) ([]compatibility.InspectedWorkload, error) {
+		return nil, errors.New("scan failed")
+	}
+	_, err = c.get(context.Background(), "failing", failing)
+	assert.Error(t, err)
+	assert.NotContains(t, c.entries, "failing", "failed inspections are not cached")
+}
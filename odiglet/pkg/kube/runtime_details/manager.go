@@ -5,8 +5,12 @@ import (
 	"github.com/odigos-io/odigos/common/consts"
 
 	criwrapper "github.com/odigos-io/odigos/k8sutils/pkg/cri"
+	"github.com/odigos-io/odigos/k8sutils/pkg/env"
 	odigospredicate "github.com/odigos-io/odigos/k8sutils/pkg/predicate"
+	"github.com/odigos-io/odigos/k8sutils/pkg/proxyauth"
+	"github.com/odigos-io/odigos/odiglet/pkg/kube/custominspectors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 )
@@ -23,16 +27,38 @@ func SetupWithManager(mgr ctrl.Manager, criClient *criwrapper.CriClient, appendE
 
 	// Runnable that runs once on startup to batch-scan all relevant
 	// processes on the node and update their runtime details if required.
-	if err := mgr.Add(&startupRuntimeDetection{
+	startupDetection := &startupRuntimeDetection{
 		client:               mgr.GetClient(),
 		criClient:            criClient,
 		runtimeDetectionEnvs: runtimeDetectionEnvs,
+	}
+	if err := mgr.Add(startupDetection); err != nil {
+		return err
+	}
+
+	// Custom inspectors are loaded by the startup scan, later changes re-detect the pods on the node.
+	if err := custominspectors.SetupWithManager(mgr, startupDetection.redetect); err != nil {
+		return err
+	}
+
+	// Runnable that serves on-demand runtime inspection of the pods on the node,
+	// used for the compatibility report of workloads which are not instrumented yet.
+	clientset, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return err
+	}
+	if err := mgr.Add(&runtimeInspectionServer{
+		client:               mgr.GetClient(),
+		criClient:            criClient,
+		runtimeDetectionEnvs: runtimeDetectionEnvs,
+		authorizer:           proxyauth.NewAuthorizer(clientset),
+		odigosNamespace:      env.GetCurrentNamespace(),
 	}); err != nil {
 		return err
 	}
 
 	readyPred := &odigospredicate.AllContainersBecomeReadyPredicate{}
-	err := builder.
+	err = builder.
 		ControllerManagedBy(mgr).
 		Named("Odiglet-RuntimeDetails-Pods").
 		For(&corev1.Pod{}, builder.WithPredicates(readyPred)).
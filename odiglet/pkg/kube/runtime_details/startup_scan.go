@@ -11,6 +11,7 @@ import (
 	criwrapper "github.com/odigos-io/odigos/k8sutils/pkg/cri"
 	"github.com/odigos-io/odigos/k8sutils/pkg/workload"
 	kubecommon "github.com/odigos-io/odigos/odiglet/pkg/kube/common"
+	"github.com/odigos-io/odigos/odiglet/pkg/kube/custominspectors"
 	"github.com/odigos-io/odigos/odiglet/pkg/process"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -33,7 +34,12 @@ var _ manager.Runnable = &startupRuntimeDetection{}
 func (s *startupRuntimeDetection) Start(ctx context.Context) error {
 	logger := commonlogger.FromContext(ctx)
 
-	icCount, err := s.scan(ctx)
+	// custom inspectors take part in the language detection, so they must be applied before the first scan
+	if _, err := custominspectors.Load(ctx, s.client); err != nil {
+		logger.Error(err, "failed to load custom inspectors, runtime detection will use the built-in inspectors only")
+	}
+
+	icCount, err := s.scan(ctx, false)
 	if err != nil {
 		logger.Error(err, "failed to perform runtime-detection initial scan, some of the instrumented workloads might have stale runtime details")
 	} else {
@@ -43,7 +49,18 @@ func (s *startupRuntimeDetection) Start(ctx context.Context) error {
 	return nil
 }
 
-func (s *startupRuntimeDetection) scan(ctx context.Context) (int, error) {
+// redetect repeats the scan after the language detection changed (e.g. custom inspectors were updated),
+// so the runtime details already recorded for the pods on the node reflect the new detection.
+func (s *startupRuntimeDetection) redetect(ctx context.Context) error {
+	icCount, err := s.scan(ctx, true)
+	if err != nil {
+		return err
+	}
+	commonlogger.FromContext(ctx).Info("Completed runtime details re-detection", "workloads count", icCount)
+	return nil
+}
+
+func (s *startupRuntimeDetection) scan(ctx context.Context, languageChanged bool) (int, error) {
 	var icList odigosv1.InstrumentationConfigList
 	if err := s.client.List(ctx, &icList); err != nil {
 		return 0, fmt.Errorf("failed to list instrumentation configs: %w", err)
@@ -116,7 +133,7 @@ func (s *startupRuntimeDetection) scan(ctx context.Context) (int, error) {
 			Jitter:   0.1,
 			Steps:    5,
 		}, func() (bool, error) {
-			err := persistRuntimeDetailsToInstrumentationConfig(ctx, s.client, entry.ic, results)
+			err := persistRuntimeDetailsToInstrumentationConfig(ctx, s.client, entry.ic, results, languageChanged)
 			if err != nil {
 				return false, nil
 			}
@@ -10,6 +10,7 @@ import (
 	"github.com/odigos-io/odigos/api/odigos/v1alpha1"
 	"github.com/odigos-io/odigos/k8sutils/pkg/container"
 	"github.com/odigos-io/odigos/opampserver/pkg/agent"
+	"github.com/odigos-io/odigos/opampserver/pkg/sdkconfig/configsections"
 	"github.com/odigos-io/odigos/opampserver/protobufs"
 	"google.golang.org/protobuf/proto"
 )
@@ -136,11 +137,17 @@ func (c *ConnectionsCache) UpdateWorkloadRemoteConfig(workload k8sconsts.PodWork
 			ContentType: "application/json",
 		}
 
+		sdkContent, sdkSectionName, err := configsections.SdkRemoteConfigToOpamp(configsections.SdkConfigFromContainerConfig(containerConfig))
+		if err != nil {
+			return err
+		}
+
 		// copy the old remote config to avoid it being accessed concurrently
 		newRemoteConfigMap := proto.Clone(conn.AgentRemoteConfig.Config).(*protobufs.AgentConfigMap)
 		if containerConfigContent != nil {
 			newRemoteConfigMap.ConfigMap["container_config"] = containerConfigContent
 		}
+		newRemoteConfigMap.ConfigMap[sdkSectionName] = sdkContent
 
 		conn.AgentRemoteConfig = &protobufs.AgentRemoteConfig{
 			Config:     newRemoteConfigMap,
@@ -149,3 +156,24 @@ func (c *ConnectionsCache) UpdateWorkloadRemoteConfig(workload k8sconsts.PodWork
 	}
 	return nil
 }
+
+// UpdatePodRemoteConfigSection sets a single remote config section for all the connections of a specific pod.
+// It is used for pod scoped overrides, which should not affect other pods of the same workload.
+func (c *ConnectionsCache) UpdatePodRemoteConfigSection(namespace string, podName string, sectionName string, section *protobufs.AgentConfigFile) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	for _, conn := range c.liveConnections {
+		if conn.Pod == nil || conn.Pod.Namespace != namespace || conn.Pod.Name != podName {
+			continue
+		}
+
+		// copy the old remote config to avoid it being accessed concurrently
+		newRemoteConfigMap := proto.Clone(conn.AgentRemoteConfig.Config).(*protobufs.AgentConfigMap)
+		newRemoteConfigMap.ConfigMap[sectionName] = section
+
+		conn.AgentRemoteConfig = &protobufs.AgentRemoteConfig{
+			Config:     newRemoteConfigMap,
+			ConfigHash: CalcRemoteConfigHash(newRemoteConfigMap),
+		}
+	}
+}
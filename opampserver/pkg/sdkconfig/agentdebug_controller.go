@@ -0,0 +1,62 @@
+package sdkconfig
+
+import (
+	"context"
+	"time"
+
+	"github.com/odigos-io/odigos/opampserver/pkg/connection"
+	"github.com/odigos-io/odigos/opampserver/pkg/sdkconfig/configsections"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// AgentDebugReconciler pushes the pod scoped debug mode to the agents of a pod,
+// and reverts it once the requested time window is over.
+type AgentDebugReconciler struct {
+	client.Client
+	Scheme          *runtime.Scheme
+	ConnectionCache *connection.ConnectionsCache
+}
+
+func (r *AgentDebugReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	pod := &corev1.Pod{}
+	err := r.Get(ctx, req.NamespacedName, pod)
+	if err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	now := time.Now()
+	debugConfig, until, err := configsections.AgentDebugConfigFromAnnotations(pod.GetAnnotations(), now)
+	if err != nil {
+		// invalid user input, retrying will not help.
+		// the agents are kept in (or reverted to) their regular config.
+		logger.Error(err, "ignoring agent debug request for pod", "pod", req.NamespacedName)
+	}
+
+	debugSection, sectionName, err := configsections.AgentDebugRemoteConfigToOpamp(debugConfig)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	r.ConnectionCache.UpdatePodRemoteConfigSection(pod.Namespace, pod.Name, sectionName, debugSection)
+
+	if !debugConfig.Enabled {
+		return ctrl.Result{}, nil
+	}
+
+	// reconcile again when debug mode expires, so the regular config is pushed back to the agents.
+	return ctrl.Result{RequeueAfter: until.Sub(now)}, nil
+}
+
+func (r *AgentDebugReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("opampserver-agentdebug").
+		For(&corev1.Pod{}).
+		WithEventFilter(predicate.AnnotationChangedPredicate{}).
+		Complete(r)
+}
@@ -0,0 +1,63 @@
+package configsections
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+	"github.com/odigos-io/odigos/opampserver/protobufs"
+)
+
+const (
+	agentDebugLogLevel = "debug"
+
+	// debug mode is meant for live incident investigation.
+	// to avoid a forgotten annotation from keeping a pod in debug mode (and 100% sampling) for a long time,
+	// the requested duration is capped.
+	MaxAgentDebugDuration = time.Hour
+)
+
+// AgentDebugConfigFromAnnotations calculates the debug config for a pod, based on its annotations.
+// It returns the config to send to the agent, and the time at which debug mode ends.
+// If debug mode is not requested or already expired, a disabled config and zero time are returned.
+func AgentDebugConfigFromAnnotations(annotations map[string]string, now time.Time) (AgentDebugConfig, time.Time, error) {
+	value, ok := annotations[k8sconsts.AgentDebugUntilAnnotation]
+	if !ok || value == "" {
+		return AgentDebugConfig{}, time.Time{}, nil
+	}
+
+	until, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return AgentDebugConfig{}, time.Time{}, fmt.Errorf("invalid %s annotation value %q: %w", k8sconsts.AgentDebugUntilAnnotation, value, err)
+	}
+
+	if !until.After(now) {
+		return AgentDebugConfig{}, time.Time{}, nil
+	}
+
+	if maxUntil := now.Add(MaxAgentDebugDuration); until.After(maxUntil) {
+		until = maxUntil
+	}
+
+	fullSampling := 1.0
+	return AgentDebugConfig{
+		Enabled:            true,
+		LogLevel:           agentDebugLogLevel,
+		TraceSamplingRatio: &fullSampling,
+		ExpiresAt:          until.Unix(),
+	}, until, nil
+}
+
+func AgentDebugRemoteConfigToOpamp(debugConfig AgentDebugConfig) (*protobufs.AgentConfigFile, string, error) {
+	debugConfigBytes, err := json.Marshal(debugConfig)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal agent debug remote config: %w", err)
+	}
+
+	debugConfigContent := protobufs.AgentConfigFile{
+		Body:        debugConfigBytes,
+		ContentType: "application/json",
+	}
+	return &debugConfigContent, string(RemoteConfigAgentDebugSectionName), nil
+}
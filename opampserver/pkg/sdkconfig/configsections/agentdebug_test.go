@@ -0,0 +1,53 @@
+package configsections
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/odigos-io/odigos/api/k8sconsts"
+)
+
+func TestAgentDebugConfigFromAnnotations(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("no annotation", func(t *testing.T) {
+		cfg, until, err := AgentDebugConfigFromAnnotations(nil, now)
+		assert.NoError(t, err)
+		assert.False(t, cfg.Enabled)
+		assert.True(t, until.IsZero())
+	})
+
+	t.Run("active", func(t *testing.T) {
+		annotations := map[string]string{k8sconsts.AgentDebugUntilAnnotation: now.Add(15 * time.Minute).Format(time.RFC3339)}
+		cfg, until, err := AgentDebugConfigFromAnnotations(annotations, now)
+		assert.NoError(t, err)
+		assert.True(t, cfg.Enabled)
+		assert.Equal(t, "debug", cfg.LogLevel)
+		assert.Equal(t, 1.0, *cfg.TraceSamplingRatio)
+		assert.Equal(t, now.Add(15*time.Minute), until)
+		assert.Equal(t, until.Unix(), cfg.ExpiresAt)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		annotations := map[string]string{k8sconsts.AgentDebugUntilAnnotation: now.Add(-time.Minute).Format(time.RFC3339)}
+		cfg, _, err := AgentDebugConfigFromAnnotations(annotations, now)
+		assert.NoError(t, err)
+		assert.False(t, cfg.Enabled)
+	})
+
+	t.Run("capped", func(t *testing.T) {
+		annotations := map[string]string{k8sconsts.AgentDebugUntilAnnotation: now.Add(24 * time.Hour).Format(time.RFC3339)}
+		_, until, err := AgentDebugConfigFromAnnotations(annotations, now)
+		assert.NoError(t, err)
+		assert.Equal(t, now.Add(MaxAgentDebugDuration), until)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		annotations := map[string]string{k8sconsts.AgentDebugUntilAnnotation: "15m"}
+		cfg, _, err := AgentDebugConfigFromAnnotations(annotations, now)
+		assert.Error(t, err)
+		assert.False(t, cfg.Enabled)
+	})
+}
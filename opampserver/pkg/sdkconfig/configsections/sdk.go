@@ -0,0 +1,48 @@
+package configsections
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/opampserver/pkg/sdkconfig/configresolvers"
+	"github.com/odigos-io/odigos/opampserver/protobufs"
+)
+
+// SdkConfigFromContainerConfig returns the sdk section for an agent, based on the sdk settings of its container.
+// A container without sdk settings gets an empty section, so removing the settings resets the agent to its defaults.
+func SdkConfigFromContainerConfig(containerConfig *v1alpha1.ContainerAgentConfig) SdkConfig {
+	if containerConfig == nil || containerConfig.Sdk == nil {
+		return SdkConfig{}
+	}
+	sdk := containerConfig.Sdk
+
+	sdkConfig := SdkConfig{
+		DisabledInstrumentationLibraries: sdk.DisabledInstrumentationLibraries,
+	}
+	for key, value := range sdk.ResourceAttributes {
+		sdkConfig.ResourceAttributes = append(sdkConfig.ResourceAttributes, configresolvers.ResourceAttribute{Key: key, Value: value})
+	}
+	// the map order is random, and the config hash should only change when the attributes do
+	sort.Slice(sdkConfig.ResourceAttributes, func(i, j int) bool {
+		return sdkConfig.ResourceAttributes[i].Key < sdkConfig.ResourceAttributes[j].Key
+	})
+	for _, propagator := range sdk.Propagators {
+		sdkConfig.Propagators = append(sdkConfig.Propagators, string(propagator))
+	}
+	return sdkConfig
+}
+
+func SdkRemoteConfigToOpamp(sdkConfig SdkConfig) (*protobufs.AgentConfigFile, string, error) {
+	sdkConfigBytes, err := json.Marshal(sdkConfig)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal sdk remote config: %w", err)
+	}
+
+	sdkConfigContent := protobufs.AgentConfigFile{
+		Body:        sdkConfigBytes,
+		ContentType: "application/json",
+	}
+	return &sdkConfigContent, string(RemoteConfigSdkConfigSectionName), nil
+}
@@ -0,0 +1,39 @@
+package configsections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/odigos-io/odigos/api/odigos/v1alpha1"
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/common/api/agentsignalconfig"
+	"github.com/odigos-io/odigos/opampserver/pkg/sdkconfig/configresolvers"
+)
+
+func TestSdkConfigFromContainerConfig(t *testing.T) {
+	t.Run("no sdk settings", func(t *testing.T) {
+		assert.Equal(t, SdkConfig{}, SdkConfigFromContainerConfig(&v1alpha1.ContainerAgentConfig{ContainerName: "app"}))
+		assert.Equal(t, SdkConfig{}, SdkConfigFromContainerConfig(nil))
+	})
+
+	t.Run("sdk settings", func(t *testing.T) {
+		containerConfig := &v1alpha1.ContainerAgentConfig{
+			ContainerName: "app",
+			Sdk: &agentsignalconfig.AgentSdkConfig{
+				DisabledInstrumentationLibraries: []string{"redis"},
+				ResourceAttributes:               map[string]string{"team": "payments", "deployment.environment": "prod"},
+				Propagators:                      []common.Propagator{common.TraceContextPropagator, common.B3Propagator},
+				ExporterProtocol:                 common.OtlpGrpcExporterProtocol,
+			},
+		}
+		assert.Equal(t, SdkConfig{
+			ResourceAttributes: []configresolvers.ResourceAttribute{
+				{Key: "deployment.environment", Value: "prod"},
+				{Key: "team", Value: "payments"},
+			},
+			DisabledInstrumentationLibraries: []string{"redis"},
+			Propagators:                      []string{"tracecontext", "b3"},
+		}, SdkConfigFromContainerConfig(containerConfig))
+	})
+}
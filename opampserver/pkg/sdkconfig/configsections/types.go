@@ -7,6 +7,8 @@ type ConfigSectionName string
 const (
 	RemoteConfigInstrumentationLibrariesConfigSectionName ConfigSectionName = "InstrumentationLibraries"
 	RemoteConfigContainerConfigSectionName                ConfigSectionName = "container_config"
+	RemoteConfigAgentDebugSectionName                     ConfigSectionName = "agent_debug"
+	RemoteConfigSdkConfigSectionName                      ConfigSectionName = "sdk_config"
 )
 
 type TraceSignalGeneralConfig struct {
@@ -60,3 +62,38 @@ type RemoteConfigInstrumentationLibrary struct {
 type RemoteConfigInstrumentationLibraryTraces struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
+
+// AgentDebugConfig is a temporary, pod scoped override pushed to agents while debug mode is active.
+// It is sent as a separate section so agents that do not support it can simply ignore it.
+// When debug mode ends, the section is sent with Enabled false, and the agent should
+// restore its regular logging and sampling.
+type AgentDebugConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// the log level the SDK should use for its own internal logs while debug mode is active.
+	LogLevel string `json:"logLevel,omitempty"`
+
+	// sampling ratio (0.0 - 1.0) that overrides any head sampling configured in the agent.
+	TraceSamplingRatio *float64 `json:"traceSamplingRatio,omitempty"`
+
+	// unix timestamp in seconds at which the agent should revert to its regular config by itself,
+	// even if it does not receive another update from the server.
+	ExpiresAt int64 `json:"expiresAt,omitempty"`
+}
+
+// SdkConfig is the OpenTelemetry SDK settings set for the container of the agent in its container override.
+// It is sent as a separate section so agents can apply it without reading the full container config,
+// and agents that do not support it can simply ignore it.
+// Empty fields mean the agent should keep its defaults.
+// The exporter protocol is not part of it, since agents connect to the exporter endpoint injected in their environment
+// before they receive any remote config.
+type SdkConfig struct {
+	// resource attributes to add to the telemetry of the container, sorted by key.
+	ResourceAttributes []configresolvers.ResourceAttribute `json:"resourceAttributes,omitempty"`
+
+	// names of instrumentation libraries which should not produce telemetry, as named by the agent.
+	DisabledInstrumentationLibraries []string `json:"disabledInstrumentationLibraries,omitempty"`
+
+	// context propagators to extract and inject trace context with, named as in OTEL_PROPAGATORS.
+	Propagators []string `json:"propagators,omitempty"`
+}
@@ -3,6 +3,7 @@ package sdkconfig
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/odigos-io/odigos/api/k8sconsts"
@@ -38,11 +39,20 @@ func NewSdkConfigManager(mgr ctrl.Manager, connectionCache *connection.Connectio
 		logger.Error(err, "unable to create controller for opamp server sdk config", "controller", "InstrumentationConfig")
 	}
 
+	// setup the controller to watch for pods which are requested to run their agents in debug mode
+	if err := (&AgentDebugReconciler{
+		Client:          mgr.GetClient(),
+		Scheme:          mgr.GetScheme(),
+		ConnectionCache: connectionCache,
+	}).SetupWithManager(mgr); err != nil {
+		logger.Error(err, "unable to create controller for opamp server sdk config", "controller", "AgentDebug")
+	}
+
 	return sdkConfigManager
 }
 
 func (m *SdkConfigManager) GetFullConfig(ctx context.Context, remoteResourceAttributes []configresolvers.ResourceAttribute, podWorkload *k8sconsts.PodWorkload, instrumentedAppName string, programmingLanguage string,
-	instrumentationConfig *odigosv1.InstrumentationConfig, containerName string) (*protobufs.AgentRemoteConfig, error) {
+	instrumentationConfig *odigosv1.InstrumentationConfig, containerName string, podAnnotations map[string]string) (*protobufs.AgentRemoteConfig, error) {
 
 	containerConfig := container.GetContainerConfigByName(instrumentationConfig.Spec.Containers, containerName)
 	if containerConfig == nil {
@@ -63,10 +73,30 @@ func (m *SdkConfigManager) GetFullConfig(ctx context.Context, remoteResourceAttr
 		return nil, err
 	}
 
+	// the debug section is always sent (disabled by default), so that toggling debug mode
+	// only changes the content of an existing section.
+	agentDebugConfig, _, err := configsections.AgentDebugConfigFromAnnotations(podAnnotations, time.Now())
+	if err != nil {
+		m.logger.Error(err, "ignoring agent debug request for pod")
+	}
+	opampRemoteConfigAgentDebug, agentDebugSectionName, err := configsections.AgentDebugRemoteConfigToOpamp(agentDebugConfig)
+	if err != nil {
+		m.logger.Error(err, "failed to marshal agent debug config")
+		return nil, err
+	}
+
+	opampRemoteConfigSdk, sdkSectionName, err := configsections.SdkRemoteConfigToOpamp(configsections.SdkConfigFromContainerConfig(containerConfig))
+	if err != nil {
+		m.logger.Error(err, "failed to marshal sdk config")
+		return nil, err
+	}
+
 	agentConfigMap := protobufs.AgentConfigMap{
 		ConfigMap: map[string]*protobufs.AgentConfigFile{
 			instrumentationLibrariesSectionName: opampRemoteConfigInstrumentationLibraries,
 			containerConfigSectionName:          opampRemoteConfigContainerConfig,
+			agentDebugSectionName:               opampRemoteConfigAgentDebug,
+			sdkSectionName:                      opampRemoteConfigSdk,
 		},
 	}
 	configHash := connection.CalcRemoteConfigHash(&agentConfigMap)
@@ -0,0 +1,227 @@
+package custom
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/utils"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+// CustomInspector detects a language based on a user provided common.CustomInspector definition.
+// Unlike the built-in inspectors, all the configured criteria are evaluated together,
+// and the memory maps are only read if the definition includes mapped libraries.
+type CustomInspector struct {
+	name            string
+	language        common.ProgrammingLanguage
+	exePathGlobs    []string
+	cmdLineRegexes  []*regexp.Regexp
+	envVars         []string
+	mappedLibraries []string
+
+	versionSource common.CustomInspectorVersionSource
+	versionEnvVar string
+	versionRegex  *regexp.Regexp
+}
+
+// NewCustomInspector validates the definition and compiles it into an inspector.
+func NewCustomInspector(def common.CustomInspector) (*CustomInspector, error) {
+	if def.Name == "" {
+		return nil, errors.New("custom inspector name is required")
+	}
+	if def.Language == "" {
+		return nil, fmt.Errorf("custom inspector %s: language is required", def.Name)
+	}
+	// the language is used for distro selection, so a value odigos does not know is never useful.
+	// csharp is a known language value as well, even though the built-in inspectors do not report it.
+	if !common.IsDetectableProgrammingLanguage(def.Language) && def.Language != common.CSharpProgrammingLanguage {
+		return nil, fmt.Errorf("custom inspector %s: unknown language %q", def.Name, def.Language)
+	}
+	if len(def.ExePathGlobs) == 0 && len(def.CmdLineRegexes) == 0 && len(def.EnvVars) == 0 && len(def.MappedLibraries) == 0 {
+		return nil, fmt.Errorf("custom inspector %s: at least one match criteria is required", def.Name)
+	}
+
+	for _, glob := range def.ExePathGlobs {
+		if _, err := path.Match(glob, ""); err != nil {
+			return nil, fmt.Errorf("custom inspector %s: invalid exe path glob %q: %w", def.Name, glob, err)
+		}
+	}
+
+	cmdLineRegexes := make([]*regexp.Regexp, 0, len(def.CmdLineRegexes))
+	for _, expr := range def.CmdLineRegexes {
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("custom inspector %s: invalid cmdline regex %q: %w", def.Name, expr, err)
+		}
+		cmdLineRegexes = append(cmdLineRegexes, re)
+	}
+
+	inspector := &CustomInspector{
+		name:            def.Name,
+		language:        def.Language,
+		exePathGlobs:    def.ExePathGlobs,
+		cmdLineRegexes:  cmdLineRegexes,
+		envVars:         def.EnvVars,
+		mappedLibraries: def.MappedLibraries,
+	}
+
+	if def.RuntimeVersion != nil {
+		rule := def.RuntimeVersion
+		switch rule.Source {
+		case common.CustomInspectorVersionSourceExePath, common.CustomInspectorVersionSourceCmdLine, common.CustomInspectorVersionSourceMaps:
+			if rule.Regex == "" {
+				return nil, fmt.Errorf("custom inspector %s: runtime version regex is required for source %s", def.Name, rule.Source)
+			}
+		case common.CustomInspectorVersionSourceEnv:
+			if rule.EnvVar == "" {
+				return nil, fmt.Errorf("custom inspector %s: runtime version env var is required for source %s", def.Name, rule.Source)
+			}
+		default:
+			return nil, fmt.Errorf("custom inspector %s: unsupported runtime version source %q", def.Name, rule.Source)
+		}
+
+		if rule.Regex != "" {
+			re, err := regexp.Compile(rule.Regex)
+			if err != nil {
+				return nil, fmt.Errorf("custom inspector %s: invalid runtime version regex %q: %w", def.Name, rule.Regex, err)
+			}
+			if re.NumSubexp() < 1 {
+				return nil, fmt.Errorf("custom inspector %s: runtime version regex %q must have a capture group", def.Name, rule.Regex)
+			}
+			inspector.versionRegex = re
+		}
+		inspector.versionSource = rule.Source
+		inspector.versionEnvVar = rule.EnvVar
+	}
+
+	return inspector, nil
+}
+
+func (c *CustomInspector) Name() string {
+	return c.name
+}
+
+func (c *CustomInspector) Language() common.ProgrammingLanguage {
+	return c.language
+}
+
+// EnvVars returns the environment variables this inspector reads,
+// which should be collected from the process environment.
+func (c *CustomInspector) EnvVars() []string {
+	envs := append([]string{}, c.envVars...)
+	if c.versionEnvVar != "" {
+		envs = append(envs, c.versionEnvVar)
+	}
+	return envs
+}
+
+// Matches reports whether the process matches all the criteria configured for this inspector.
+func (c *CustomInspector) Matches(pcx *process.ProcessContext) bool {
+	if len(c.exePathGlobs) > 0 && !c.matchExePath(pcx.ExePath) {
+		return false
+	}
+
+	if len(c.cmdLineRegexes) > 0 && !c.matchCmdLine(pcx.CmdLine) {
+		return false
+	}
+
+	if len(c.envVars) > 0 && !c.matchEnvVars(pcx) {
+		return false
+	}
+
+	// maps are checked last, as it requires reading a file from /proc
+	if len(c.mappedLibraries) > 0 {
+		mapsFile, err := pcx.GetMapsFile()
+		if err != nil {
+			return false
+		}
+		if !utils.IsMapsFileContainsBinary(mapsFile, c.mappedLibraries) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *CustomInspector) matchExePath(exePath string) bool {
+	if exePath == "" {
+		return false
+	}
+	baseExe := path.Base(exePath)
+	for _, glob := range c.exePathGlobs {
+		if matched, _ := path.Match(glob, exePath); matched {
+			return true
+		}
+		if matched, _ := path.Match(glob, baseExe); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *CustomInspector) matchCmdLine(cmdLine string) bool {
+	readableCmdLine := readableCmdLine(cmdLine)
+	for _, re := range c.cmdLineRegexes {
+		if re.MatchString(readableCmdLine) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *CustomInspector) matchEnvVars(pcx *process.ProcessContext) bool {
+	for _, envVar := range c.envVars {
+		if _, exists := pcx.GetDetailedEnvsValue(envVar); exists {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *CustomInspector) GetRuntimeVersion(pcx *process.ProcessContext) string {
+	switch c.versionSource {
+	case common.CustomInspectorVersionSourceExePath:
+		return c.extractVersion(pcx.ExePath)
+	case common.CustomInspectorVersionSourceCmdLine:
+		return c.extractVersion(readableCmdLine(pcx.CmdLine))
+	case common.CustomInspectorVersionSourceEnv:
+		value, exists := pcx.GetDetailedEnvsValue(c.versionEnvVar)
+		if !exists {
+			return ""
+		}
+		if c.versionRegex == nil {
+			return value
+		}
+		return c.extractVersion(value)
+	case common.CustomInspectorVersionSourceMaps:
+		mapsFile, err := pcx.GetMapsFile()
+		if err != nil {
+			return ""
+		}
+		scanner := bufio.NewScanner(mapsFile)
+		for scanner.Scan() {
+			if version := c.extractVersion(scanner.Text()); version != "" {
+				return version
+			}
+		}
+	}
+	return ""
+}
+
+func (c *CustomInspector) extractVersion(text string) string {
+	if m := c.versionRegex.FindStringSubmatch(text); len(m) > 1 {
+		return m[1]
+	}
+	return ""
+}
+
+// the cmdline file separates arguments with null bytes,
+// which are replaced with spaces so users can write regexes naturally.
+func readableCmdLine(cmdLine string) string {
+	return strings.TrimSpace(strings.ReplaceAll(cmdLine, "\x00", " "))
+}
@@ -0,0 +1,80 @@
+package custom
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+func TestNewCustomInspectorValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		def  common.CustomInspector
+	}{
+		{name: "missing name", def: common.CustomInspector{Language: common.JavaProgrammingLanguage, ExePathGlobs: []string{"myjvm"}}},
+		{name: "missing language", def: common.CustomInspector{Name: "jvm", ExePathGlobs: []string{"myjvm"}}},
+		{name: "unknown language", def: common.CustomInspector{Name: "jvm", Language: "kotlin", ExePathGlobs: []string{"myjvm"}}},
+		{name: "no criteria", def: common.CustomInspector{Name: "jvm", Language: common.JavaProgrammingLanguage}},
+		{name: "invalid glob", def: common.CustomInspector{Name: "jvm", Language: common.JavaProgrammingLanguage, ExePathGlobs: []string{"[myjvm"}}},
+		{name: "invalid regex", def: common.CustomInspector{Name: "jvm", Language: common.JavaProgrammingLanguage, CmdLineRegexes: []string{"(launcher"}}},
+		{
+			name: "version regex without capture group",
+			def: common.CustomInspector{Name: "jvm", Language: common.JavaProgrammingLanguage, ExePathGlobs: []string{"myjvm"},
+				RuntimeVersion: &common.CustomInspectorVersionRule{Source: common.CustomInspectorVersionSourceExePath, Regex: `\d+`}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewCustomInspector(tt.def); err == nil {
+				t.Errorf("NewCustomInspector() expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestNewCustomInspectorCSharp(t *testing.T) {
+	def := common.CustomInspector{Name: "mono", Language: common.CSharpProgrammingLanguage, ExePathGlobs: []string{"*/mono"}}
+	if _, err := NewCustomInspector(def); err != nil {
+		t.Fatalf("NewCustomInspector() unexpected error: %v", err)
+	}
+}
+
+func TestMatchesAndVersion(t *testing.T) {
+	inspector, err := NewCustomInspector(common.CustomInspector{
+		Name:           "python-launcher",
+		Language:       common.PythonProgrammingLanguage,
+		ExePathGlobs:   []string{"/opt/launcher/bin/*"},
+		CmdLineRegexes: []string{`--app\s+\S+\.py`},
+		RuntimeVersion: &common.CustomInspectorVersionRule{
+			Source: common.CustomInspectorVersionSourceEnv,
+			EnvVar: "LAUNCHER_PY_VERSION",
+			Regex:  `py(\d+\.\d+)`,
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewCustomInspector() unexpected error: %v", err)
+	}
+
+	matching := process.NewProcessContext(process.Details{
+		ExePath:      "/opt/launcher/bin/launch",
+		CmdLine:      "launch\x00--app\x00main.py",
+		Environments: process.ProcessEnvs{DetailedEnvs: map[string]string{"LAUNCHER_PY_VERSION": "py3.11-rc"}},
+	})
+	if !inspector.Matches(matching) {
+		t.Errorf("Matches() = false; want true")
+	}
+	if v := inspector.GetRuntimeVersion(matching); v != "3.11" {
+		t.Errorf("GetRuntimeVersion() = %q; want %q", v, "3.11")
+	}
+
+	// matches the exe path, but not the command line
+	nonMatching := process.NewProcessContext(process.Details{
+		ExePath: "/opt/launcher/bin/launch",
+		CmdLine: "launch\x00--version",
+	})
+	if inspector.Matches(nonMatching) {
+		t.Errorf("Matches() = true; want false")
+	}
+}
@@ -0,0 +1,60 @@
+package elasticsearch
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+// This is an experimental feature, It is not a language
+// but in order to avoid huge refactoring we are adding it as a language for now
+type ElasticsearchInspector struct{}
+
+var (
+	// elasticsearch runs on the JVM, so it is identified by the main class on the command line.
+	// elasticsearch 8 uses the module path, where the main class is prefixed with the module name
+	// (org.elasticsearch.server/org.elasticsearch.bootstrap.Elasticsearch).
+	mainClasses = []string{
+		"org.elasticsearch.bootstrap.Elasticsearch",
+		"org.opensearch.bootstrap.OpenSearch",
+	}
+
+	// /usr/share/elasticsearch/lib/elasticsearch-8.13.4.jar
+	esJarVersionRe = regexp.MustCompile(`/(?:elasticsearch|opensearch)-(\d+\.\d+\.\d+)\.jar`)
+)
+
+// IsElasticsearch reports whether the process is an elasticsearch (or opensearch) node.
+// It is used by the java inspector to avoid detecting it as a java application.
+func IsElasticsearch(pcx *process.ProcessContext) bool {
+	// cmdline arguments are separated by null bytes
+	for _, arg := range strings.Split(pcx.CmdLine, "\x00") {
+		for _, mainClass := range mainClasses {
+			if arg == mainClass || strings.HasSuffix(arg, "/"+mainClass) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (n *ElasticsearchInspector) QuickScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	if IsElasticsearch(pcx) {
+		return common.ElasticsearchProgrammingLanguage, true
+	}
+
+	return "", false
+}
+
+func (n *ElasticsearchInspector) DeepScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	return "", false
+}
+
+func (n *ElasticsearchInspector) GetRuntimeVersion(pcx *process.ProcessContext) string {
+	if m := esJarVersionRe.FindStringSubmatch(pcx.CmdLine); len(m) > 1 {
+		return m[1]
+	}
+
+	return ""
+}
@@ -0,0 +1,57 @@
+package elasticsearch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+func TestIsElasticsearch(t *testing.T) {
+	tests := []struct {
+		name     string
+		cmdLine  []string
+		expected bool
+	}{
+		{
+			name:     "elasticsearch 7",
+			cmdLine:  []string{"/usr/share/elasticsearch/jdk/bin/java", "-Xms1g", "-cp", "/usr/share/elasticsearch/lib/*", "org.elasticsearch.bootstrap.Elasticsearch"},
+			expected: true,
+		},
+		{
+			name:     "elasticsearch 8 module path",
+			cmdLine:  []string{"/usr/share/elasticsearch/jdk/bin/java", "--module-path", "/usr/share/elasticsearch/lib", "-m", "org.elasticsearch.server/org.elasticsearch.bootstrap.Elasticsearch"},
+			expected: true,
+		},
+		{
+			name:     "opensearch",
+			cmdLine:  []string{"java", "-cp", "/usr/share/opensearch/lib/*", "org.opensearch.bootstrap.OpenSearch"},
+			expected: true,
+		},
+		{
+			name:     "application using the elasticsearch client",
+			cmdLine:  []string{"java", "-cp", "/app/lib/elasticsearch-java-8.13.4.jar:/app/app.jar", "com.example.SearchService"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pcx := process.NewProcessContext(process.Details{ExePath: "/usr/bin/java", CmdLine: strings.Join(tt.cmdLine, "\x00")})
+			if result := IsElasticsearch(pcx); result != tt.expected {
+				t.Errorf("IsElasticsearch() = %v; want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetRuntimeVersion(t *testing.T) {
+	pcx := process.NewProcessContext(process.Details{
+		ExePath: "/usr/bin/java",
+		CmdLine: strings.Join([]string{"java", "-cp", "/usr/share/elasticsearch/lib/elasticsearch-8.13.4.jar", "org.elasticsearch.bootstrap.Elasticsearch"}, "\x00"),
+	})
+	inspector := &ElasticsearchInspector{}
+	if v := inspector.GetRuntimeVersion(pcx); v != "8.13.4" {
+		t.Errorf("GetRuntimeVersion() = %q; want %q", v, "8.13.4")
+	}
+}
@@ -0,0 +1,43 @@
+package elixir
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/erlang"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+type ElixirInspector struct{}
+
+var (
+	// -pa /usr/local/lib/elixir/lib/elixir/ebin
+	// -boot_var RELEASE_LIB /app/lib (release, with /app/lib/elixir-1.16.0/ebin)
+	elixirPathVersionRe = regexp.MustCompile(`elixir-(\d+\.\d+\.\d+)`)
+)
+
+func (n *ElixirInspector) QuickScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	if erlang.IsBeamProcess(pcx) && erlang.IsElixirProcess(pcx) && !erlang.IsRabbitMQProcess(pcx) {
+		return common.ElixirProgrammingLanguage, true
+	}
+
+	return "", false
+}
+
+func (n *ElixirInspector) DeepScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	return "", false
+}
+
+func (n *ElixirInspector) GetRuntimeVersion(pcx *process.ProcessContext) string {
+	// official elixir images set the version as env var, e.g "v1.16.0"
+	if value, exists := pcx.GetDetailedEnvsValue(process.ElixirVersionConst); exists {
+		return strings.TrimPrefix(value, "v")
+	}
+
+	if m := elixirPathVersionRe.FindStringSubmatch(pcx.CmdLine); len(m) > 1 {
+		return m[1]
+	}
+
+	return ""
+}
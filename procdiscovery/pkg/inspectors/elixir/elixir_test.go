@@ -0,0 +1,81 @@
+package elixir
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+const beamPath = "/usr/local/lib/erlang/erts-14.2.1/bin/beam.smp"
+
+func TestQuickScan(t *testing.T) {
+	tests := []struct {
+		name     string
+		details  process.Details
+		expected bool
+	}{
+		{
+			name:     "elixir cli",
+			details:  process.Details{ExePath: beamPath, CmdLine: "beam.smp\x00-pa\x00/usr/local/lib/elixir/bin/../lib/elixir/ebin\x00-s\x00elixir\x00start_cli"},
+			expected: true,
+		},
+		{
+			name: "elixir release",
+			details: process.Details{
+				ExePath:      beamPath,
+				CmdLine:      "beam.smp\x00--\x00-root\x00/app\x00-boot_var\x00RELEASE_LIB\x00/app/lib",
+				Environments: process.ProcessEnvs{DetailedEnvs: map[string]string{process.ElixirVersionConst: "v1.16.0"}},
+			},
+			expected: true,
+		},
+		{
+			name:     "erlang beam",
+			details:  process.Details{ExePath: beamPath, CmdLine: "beam.smp\x00--\x00-root\x00/usr/local/lib/erlang"},
+			expected: false,
+		},
+		{
+			name:     "rabbitmq node",
+			details:  process.Details{ExePath: beamPath, CmdLine: "beam.smp\x00-pa\x00/opt/rabbitmq/ebin\x00-elixir\x00ansi_enabled\x00true\x00-s\x00rabbit\x00boot"},
+			expected: false,
+		},
+		{
+			name:     "not beam",
+			details:  process.Details{ExePath: "/usr/bin/node", CmdLine: "node\x00/app/elixir-docs.js"},
+			expected: false,
+		},
+	}
+
+	inspector := &ElixirInspector{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang, detected := inspector.QuickScan(process.NewProcessContext(tt.details))
+			if detected != tt.expected {
+				t.Fatalf("QuickScan() detected = %v; want %v", detected, tt.expected)
+			}
+			if detected && lang != common.ElixirProgrammingLanguage {
+				t.Fatalf("QuickScan() language = %s; want %s", lang, common.ElixirProgrammingLanguage)
+			}
+		})
+	}
+}
+
+func TestGetRuntimeVersion(t *testing.T) {
+	inspector := &ElixirInspector{}
+
+	fromEnv := process.NewProcessContext(process.Details{
+		ExePath:      beamPath,
+		Environments: process.ProcessEnvs{DetailedEnvs: map[string]string{process.ElixirVersionConst: "v1.16.0"}},
+	})
+	if v := inspector.GetRuntimeVersion(fromEnv); v != "1.16.0" {
+		t.Errorf("GetRuntimeVersion() from env = %q; want %q", v, "1.16.0")
+	}
+
+	fromCmdLine := process.NewProcessContext(process.Details{
+		ExePath: beamPath,
+		CmdLine: "beam.smp\x00-pa\x00/app/lib/elixir-1.15.7/ebin",
+	})
+	if v := inspector.GetRuntimeVersion(fromCmdLine); v != "1.15.7" {
+		t.Errorf("GetRuntimeVersion() from cmdline = %q; want %q", v, "1.15.7")
+	}
+}
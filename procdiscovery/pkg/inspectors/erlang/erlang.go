@@ -0,0 +1,102 @@
+package erlang
+
+import (
+	"bufio"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+type ErlangInspector struct{}
+
+var (
+	// the BEAM virtual machine executables.
+	// erl / elixir / iex are shell scripts which exec into one of these.
+	beamExecutables = []string{"beam", "beam.smp", "beam.emu"}
+
+	// /root/.asdf/installs/erlang/26.2.1/erts-14.2.1/bin/beam.smp
+	// /home/app/.kerl/installs/26.2.1/erts-14.2.1/bin/beam.smp
+	otpPathVersionRe = regexp.MustCompile(`/(?:erlang|installs)/(\d+(?:\.\d+)+)/`)
+)
+
+// IsBeamProcess reports whether the process is running the BEAM virtual machine.
+// The BEAM is shared by Erlang and Elixir (and other BEAM languages), which are told apart
+// by the elixir inspector.
+func IsBeamProcess(pcx *process.ProcessContext) bool {
+	return slices.Contains(beamExecutables, filepath.Base(pcx.ExePath))
+}
+
+// IsElixirProcess reports whether a BEAM process is running an elixir application.
+// Elixir boots the VM with the elixir application on the command line (e.g. "-s elixir start_cli",
+// "-elixir ansi_enabled true" for releases, or the elixir ebin directory in the code path).
+func IsElixirProcess(pcx *process.ProcessContext) bool {
+	if _, exists := pcx.GetDetailedEnvsValue(process.ElixirVersionConst); exists {
+		return true
+	}
+	return strings.Contains(pcx.CmdLine, "elixir")
+}
+
+// IsRabbitMQProcess reports whether a BEAM process is a RabbitMQ node.
+// RabbitMQ is written in erlang, and is detected by its own inspector so it is not mistaken for an application.
+// The node is booted by the rabbitmq-server script with the "-s rabbit boot" arguments,
+// which tells it apart from client applications and scripts that merely mention rabbitmq.
+func IsRabbitMQProcess(pcx *process.ProcessContext) bool {
+	// cmdline arguments are separated by null bytes
+	args := strings.Split(pcx.CmdLine, "\x00")
+	for i := 0; i+1 < len(args); i++ {
+		if args[i] == "-s" && args[i+1] == "rabbit" {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *ErlangInspector) QuickScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	if IsBeamProcess(pcx) && !IsElixirProcess(pcx) && !IsRabbitMQProcess(pcx) {
+		return common.ErlangProgrammingLanguage, true
+	}
+
+	return "", false
+}
+
+func (n *ErlangInspector) DeepScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	return "", false
+}
+
+func (n *ErlangInspector) GetRuntimeVersion(pcx *process.ProcessContext) string {
+	return GetOtpVersion(pcx)
+}
+
+// GetOtpVersion returns the Erlang/OTP release the BEAM process is running, if it can be found.
+func GetOtpVersion(pcx *process.ProcessContext) string {
+	// official erlang and elixir images set the OTP version as env var
+	if value, exists := pcx.GetDetailedEnvsValue(process.OtpVersionConst); exists {
+		return value
+	}
+
+	// version managers (asdf, kerl) install each OTP release in a directory named after it
+	if m := otpPathVersionRe.FindStringSubmatch(pcx.ExePath); len(m) > 1 {
+		return m[1]
+	}
+
+	mapsFile, err := pcx.GetMapsFile()
+	if err != nil {
+		return ""
+	}
+	scanner := bufio.NewScanner(mapsFile)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.Contains(line, "erts-") {
+			continue
+		}
+		if m := otpPathVersionRe.FindStringSubmatch(line); len(m) > 1 {
+			return m[1]
+		}
+	}
+
+	return ""
+}
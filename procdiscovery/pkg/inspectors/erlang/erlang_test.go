@@ -0,0 +1,72 @@
+package erlang
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+func TestQuickScan(t *testing.T) {
+	tests := []struct {
+		name     string
+		details  process.Details
+		expected bool
+	}{
+		{
+			name:     "erlang beam",
+			details:  process.Details{ExePath: "/usr/local/lib/erlang/erts-14.2.1/bin/beam.smp", CmdLine: "/usr/local/lib/erlang/erts-14.2.1/bin/beam.smp\x00--\x00-root\x00/usr/local/lib/erlang"},
+			expected: true,
+		},
+		{
+			name:     "elixir beam",
+			details:  process.Details{ExePath: "/usr/local/lib/erlang/erts-14.2.1/bin/beam.smp", CmdLine: "beam.smp\x00-pa\x00/usr/local/lib/elixir/bin/../lib/elixir/ebin\x00-s\x00elixir\x00start_cli"},
+			expected: false,
+		},
+		{
+			name:     "rabbitmq node",
+			details:  process.Details{ExePath: "/usr/local/lib/erlang/erts-14.2.1/bin/beam.smp", CmdLine: "beam.smp\x00-pa\x00/opt/rabbitmq/ebin\x00-s\x00rabbit\x00boot"},
+			expected: false,
+		},
+		{
+			name:     "erlang application using a rabbitmq client",
+			details:  process.Details{ExePath: "/usr/local/lib/erlang/erts-14.2.1/bin/beam.smp", CmdLine: "beam.smp\x00-pa\x00/app/lib/rabbitmq_client/ebin\x00-s\x00orders"},
+			expected: true,
+		},
+		{
+			name:     "not beam",
+			details:  process.Details{ExePath: "/usr/bin/python3"},
+			expected: false,
+		},
+	}
+
+	inspector := &ErlangInspector{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang, detected := inspector.QuickScan(process.NewProcessContext(tt.details))
+			if detected != tt.expected {
+				t.Fatalf("QuickScan() detected = %v; want %v", detected, tt.expected)
+			}
+			if detected && lang != common.ErlangProgrammingLanguage {
+				t.Fatalf("QuickScan() language = %s; want %s", lang, common.ErlangProgrammingLanguage)
+			}
+		})
+	}
+}
+
+func TestGetOtpVersionFromEnvAndPath(t *testing.T) {
+	fromEnv := process.NewProcessContext(process.Details{
+		ExePath:      "/usr/local/lib/erlang/erts-14.2.1/bin/beam.smp",
+		Environments: process.ProcessEnvs{DetailedEnvs: map[string]string{process.OtpVersionConst: "26.2.1"}},
+	})
+	if v := GetOtpVersion(fromEnv); v != "26.2.1" {
+		t.Errorf("GetOtpVersion() from env = %q; want %q", v, "26.2.1")
+	}
+
+	fromPath := process.NewProcessContext(process.Details{
+		ExePath: "/root/.asdf/installs/erlang/25.3.2/erts-13.2.2/bin/beam.smp",
+	})
+	if v := GetOtpVersion(fromPath); v != "25.3.2" {
+		t.Errorf("GetOtpVersion() from path = %q; want %q", v, "25.3.2")
+	}
+}
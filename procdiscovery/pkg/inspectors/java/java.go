@@ -4,6 +4,8 @@ import (
 	"regexp"
 
 	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/elasticsearch"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/kafka"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/utils"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
 )
@@ -24,8 +26,14 @@ var (
 	javaHomeVersionRegex = regexp.MustCompile(`jdk(\d+(?:\.\d+)*)`)
 )
 
+// isJvmInfrastructure reports whether the JVM process is an infrastructure component
+// which is detected by its own inspector, and should not be treated as a java application.
+func isJvmInfrastructure(pcx *process.ProcessContext) bool {
+	return kafka.IsKafkaBroker(pcx) || elasticsearch.IsElasticsearch(pcx)
+}
+
 func (j *JavaInspector) QuickScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
-	if utils.IsProcessEqualProcessNames(pcx, processNames) {
+	if utils.IsProcessEqualProcessNames(pcx, processNames) && !isJvmInfrastructure(pcx) {
 		return common.JavaProgrammingLanguage, true
 	}
 
@@ -38,7 +46,7 @@ func (j *JavaInspector) DeepScan(pcx *process.ProcessContext) (common.Programmin
 		return "", false
 	}
 
-	if utils.IsMapsFileContainsBinary(mapsFile, binaries) {
+	if utils.IsMapsFileContainsBinary(mapsFile, binaries) && !isJvmInfrastructure(pcx) {
 		return common.JavaProgrammingLanguage, true
 	}
 
@@ -0,0 +1,60 @@
+package kafka
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+// This is an experimental feature, It is not a language
+// but in order to avoid huge refactoring we are adding it as a language for now
+type KafkaInspector struct{}
+
+var (
+	// kafka brokers run on the JVM, so they are identified by the main class on the command line.
+	// this excludes kafka clients, connect workers and streams applications which are regular java applications.
+	brokerMainClasses = []string{
+		"kafka.Kafka",
+		"io.confluent.support.metrics.SupportedKafka",
+	}
+
+	// /opt/kafka/libs/kafka_2.13-3.7.0.jar
+	kafkaJarVersionRe = regexp.MustCompile(`kafka_\d+\.\d+-(\d+\.\d+\.\d+)\.jar`)
+)
+
+// IsKafkaBroker reports whether the process is a kafka broker.
+// It is used by the java inspector to avoid detecting brokers as java applications.
+func IsKafkaBroker(pcx *process.ProcessContext) bool {
+	// cmdline arguments are separated by null bytes
+	for _, arg := range strings.Split(pcx.CmdLine, "\x00") {
+		for _, mainClass := range brokerMainClasses {
+			if arg == mainClass {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (n *KafkaInspector) QuickScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	if IsKafkaBroker(pcx) {
+		return common.KafkaProgrammingLanguage, true
+	}
+
+	return "", false
+}
+
+func (n *KafkaInspector) DeepScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	return "", false
+}
+
+func (n *KafkaInspector) GetRuntimeVersion(pcx *process.ProcessContext) string {
+	// the broker jar is part of the classpath on the command line
+	if m := kafkaJarVersionRe.FindStringSubmatch(pcx.CmdLine); len(m) > 1 {
+		return m[1]
+	}
+
+	return ""
+}
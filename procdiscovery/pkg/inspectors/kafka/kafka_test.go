@@ -0,0 +1,57 @@
+package kafka
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+func TestIsKafkaBroker(t *testing.T) {
+	tests := []struct {
+		name     string
+		cmdLine  []string
+		expected bool
+	}{
+		{
+			name:     "apache kafka broker",
+			cmdLine:  []string{"java", "-Xmx1G", "-cp", "/opt/kafka/libs/kafka_2.13-3.7.0.jar", "kafka.Kafka", "/opt/kafka/config/server.properties"},
+			expected: true,
+		},
+		{
+			name:     "confluent broker",
+			cmdLine:  []string{"java", "-cp", "/usr/share/java/kafka/*", "io.confluent.support.metrics.SupportedKafka", "/etc/kafka/kafka.properties"},
+			expected: true,
+		},
+		{
+			name:     "kafka connect worker",
+			cmdLine:  []string{"java", "-cp", "/opt/kafka/libs/kafka_2.13-3.7.0.jar", "org.apache.kafka.connect.cli.ConnectDistributed", "/opt/kafka/config/connect-distributed.properties"},
+			expected: false,
+		},
+		{
+			name:     "application with kafka client",
+			cmdLine:  []string{"java", "-jar", "/app/kafka.KafkaConsumerApp.jar"},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pcx := process.NewProcessContext(process.Details{ExePath: "/usr/bin/java", CmdLine: strings.Join(tt.cmdLine, "\x00")})
+			if result := IsKafkaBroker(pcx); result != tt.expected {
+				t.Errorf("IsKafkaBroker() = %v; want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetRuntimeVersion(t *testing.T) {
+	pcx := process.NewProcessContext(process.Details{
+		ExePath: "/usr/bin/java",
+		CmdLine: strings.Join([]string{"java", "-cp", "/opt/kafka/libs/kafka-clients-3.7.0.jar:/opt/kafka/libs/kafka_2.13-3.7.0.jar", "kafka.Kafka"}, "\x00"),
+	})
+	inspector := &KafkaInspector{}
+	if v := inspector.GetRuntimeVersion(pcx); v != "3.7.0" {
+		t.Errorf("GetRuntimeVersion() = %q; want %q", v, "3.7.0")
+	}
+}
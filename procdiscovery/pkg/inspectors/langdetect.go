@@ -3,22 +3,34 @@ package inspectors
 import (
 	"errors"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/odigos-io/odigos/common"
 	commonlogger "github.com/odigos-io/odigos/common/logger"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/cplusplus"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/custom"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/dotnet"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/elasticsearch"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/elixir"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/erlang"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/golang"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/java"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/kafka"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/lua"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/memcached"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/mongodb"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/mysql"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/nginx"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/nodejs"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/perl"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/php"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/postgres"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/python"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/rabbitmq"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/redis"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/ruby"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/rust"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/swift"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
 )
 
@@ -44,19 +56,77 @@ type VersionInspector interface {
 }
 
 var inspectorsByLanguage = map[common.ProgrammingLanguage]Inspector{
-	common.JavaProgrammingLanguage:       &java.JavaInspector{},
-	common.DotNetProgrammingLanguage:     &dotnet.DotnetInspector{},
-	common.GoProgrammingLanguage:         &golang.GolangInspector{},
-	common.PythonProgrammingLanguage:     &python.PythonInspector{},
-	common.JavascriptProgrammingLanguage: &nodejs.NodejsInspector{},
-	common.PhpProgrammingLanguage:        &php.PhpInspector{},
-	common.RubyProgrammingLanguage:       &ruby.RubyInspector{},
-	common.RustProgrammingLanguage:       &rust.RustInspector{},
-	common.CPlusPlusProgrammingLanguage:  &cplusplus.CPlusPlusInspector{},
-	common.MySQLProgrammingLanguage:      &mysql.MySQLInspector{},
-	common.NginxProgrammingLanguage:      &nginx.NginxInspector{},
-	common.RedisProgrammingLanguage:      &redis.RedisInspector{},
-	common.PostgresProgrammingLanguage:   &postgres.PostgresInspector{},
+	common.JavaProgrammingLanguage:          &java.JavaInspector{},
+	common.DotNetProgrammingLanguage:        &dotnet.DotnetInspector{},
+	common.GoProgrammingLanguage:            &golang.GolangInspector{},
+	common.PythonProgrammingLanguage:        &python.PythonInspector{},
+	common.JavascriptProgrammingLanguage:    &nodejs.NodejsInspector{},
+	common.PhpProgrammingLanguage:           &php.PhpInspector{},
+	common.RubyProgrammingLanguage:          &ruby.RubyInspector{},
+	common.RustProgrammingLanguage:          &rust.RustInspector{},
+	common.CPlusPlusProgrammingLanguage:     &cplusplus.CPlusPlusInspector{},
+	common.ErlangProgrammingLanguage:        &erlang.ErlangInspector{},
+	common.ElixirProgrammingLanguage:        &elixir.ElixirInspector{},
+	common.SwiftProgrammingLanguage:         &swift.SwiftInspector{},
+	common.PerlProgrammingLanguage:          &perl.PerlInspector{},
+	common.LuaProgrammingLanguage:           &lua.LuaInspector{},
+	common.MySQLProgrammingLanguage:         &mysql.MySQLInspector{},
+	common.NginxProgrammingLanguage:         &nginx.NginxInspector{},
+	common.RedisProgrammingLanguage:         &redis.RedisInspector{},
+	common.PostgresProgrammingLanguage:      &postgres.PostgresInspector{},
+	common.MongoDBProgrammingLanguage:       &mongodb.MongoDBInspector{},
+	common.KafkaProgrammingLanguage:         &kafka.KafkaInspector{},
+	common.RabbitMQProgrammingLanguage:      &rabbitmq.RabbitMQInspector{},
+	common.ElasticsearchProgrammingLanguage: &elasticsearch.ElasticsearchInspector{},
+	common.MemcachedProgrammingLanguage:     &memcached.MemcachedInspector{},
+}
+
+// customInspectors are configured at runtime, and evaluated in order before the built-in inspectors.
+var customInspectors atomic.Pointer[[]*custom.CustomInspector]
+
+// SetCustomInspectors replaces the custom inspectors used for language detection.
+// Invalid definitions are skipped and reported in the returned error, while the valid ones are still applied.
+func SetCustomInspectors(definitions []common.CustomInspector) error {
+	var errs error
+	compiled := make([]*custom.CustomInspector, 0, len(definitions))
+	envs := make(map[string]struct{})
+	for _, definition := range definitions {
+		inspector, err := custom.NewCustomInspector(definition)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		compiled = append(compiled, inspector)
+		for _, env := range inspector.EnvVars() {
+			envs[env] = struct{}{}
+		}
+	}
+
+	// the env vars need to be collected from the process, before the inspectors can use them
+	process.SetCustomDetectionEnvs(envs)
+	customInspectors.Store(&compiled)
+	return errs
+}
+
+func getCustomInspectors() []*custom.CustomInspector {
+	inspectors := customInspectors.Load()
+	if inspectors == nil {
+		return nil
+	}
+	return *inspectors
+}
+
+// runCustomInspectors returns the language of the first custom inspector matching the process.
+func runCustomInspectors(procContext *process.ProcessContext) (common.ProgramLanguageDetails, bool) {
+	for _, inspector := range getCustomInspectors() {
+		if inspector.Matches(procContext) {
+			return common.ProgramLanguageDetails{
+				Language:       inspector.Language(),
+				RuntimeVersion: inspector.GetRuntimeVersion(procContext),
+			}, true
+		}
+	}
+	return common.ProgramLanguageDetails{}, false
 }
 
 func runInspectionStage(procContext *process.ProcessContext, selectInspectionMethod func(Inspector) InspectFunc,
@@ -104,6 +174,11 @@ func DetectLanguage(proc process.Details) (common.ProgramLanguageDetails, error)
 // detectLanguageInContext runs language detection against an already-built
 // ProcessContext, so callers can share one context for language and other-agent detection.
 func detectLanguageInContext(procContext *process.ProcessContext, logger *commonlogger.OdigosLogger) (common.ProgramLanguageDetails, error) {
+	// Custom inspectors are user defined, and take precedence over the built-in ones
+	if detectedLanguage, ok := runCustomInspectors(procContext); ok {
+		return detectedLanguage, nil
+	}
+
 	// Try Quick Scan first
 	if detectedLanguage, err := runInspectionStage(procContext, func(inspector Inspector) InspectFunc {
 		return inspector.QuickScan
@@ -166,11 +241,6 @@ func resolveNonCpp(langs [2]common.ProgrammingLanguage) (common.ProgrammingLangu
 
 func VerifyLanguage(proc process.Details, lang common.ProgrammingLanguage) bool {
 	logger := commonlogger.LoggerCompat().With("subsystem", "langdetect")
-	inspector, ok := inspectorsByLanguage[lang]
-	if !ok {
-		return false
-	}
-
 	procContext := process.NewProcessContext(proc)
 	defer func() {
 		if err := procContext.CloseFiles(); err != nil {
@@ -178,6 +248,17 @@ func VerifyLanguage(proc process.Details, lang common.ProgrammingLanguage) bool
 		}
 	}()
 
+	for _, customInspector := range getCustomInspectors() {
+		if customInspector.Language() == lang && customInspector.Matches(procContext) {
+			return true
+		}
+	}
+
+	inspector, ok := inspectorsByLanguage[lang]
+	if !ok {
+		return false
+	}
+
 	_, quickDetected := inspector.QuickScan(procContext)
 	if quickDetected {
 		return true
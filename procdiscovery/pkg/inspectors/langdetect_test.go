@@ -102,3 +102,33 @@ func TestConflictWithCppPrefersOtherLanguage_DeepScan(t *testing.T) {
 		t.Fatalf("expected runtime version 1.19.5, got %v", res.RuntimeVersion)
 	}
 }
+
+func TestCustomInspectorTakesPrecedence(t *testing.T) {
+	orig := inspectorsByLanguage
+	defer func() { inspectorsByLanguage = orig }()
+	defer func() { _ = SetCustomInspectors(nil) }()
+
+	inspectorsByLanguage = map[common.ProgrammingLanguage]Inspector{
+		common.CPlusPlusProgrammingLanguage: &fakeInspector{lang: common.CPlusPlusProgrammingLanguage, quickDetected: true},
+	}
+
+	err := SetCustomInspectors([]common.CustomInspector{
+		{Name: "invalid", Language: common.JavaProgrammingLanguage},
+		{Name: "jvm-wrapper", Language: common.JavaProgrammingLanguage, ExePathGlobs: []string{"myjvm*"}},
+	})
+	if err == nil {
+		t.Fatalf("expected error for invalid custom inspector, got nil")
+	}
+
+	res, err := DetectLanguage(process.Details{ExePath: "/usr/local/bin/myjvm-17"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if res.Language != common.JavaProgrammingLanguage {
+		t.Fatalf("expected language %s, got %s", common.JavaProgrammingLanguage, res.Language)
+	}
+
+	if !VerifyLanguage(process.Details{ExePath: "/usr/local/bin/myjvm-17"}, common.JavaProgrammingLanguage) {
+		t.Fatalf("expected custom inspector to verify language %s", common.JavaProgrammingLanguage)
+	}
+}
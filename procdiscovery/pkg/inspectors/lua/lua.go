@@ -0,0 +1,71 @@
+package lua
+
+import (
+	"bufio"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/utils"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+// LuaInspector detects standalone lua interpreters, as well as OpenResty,
+// which is nginx with an embedded LuaJIT where the application logic is written in lua.
+type LuaInspector struct{}
+
+const (
+	// OpenResty installs its nginx binary under /usr/local/openresty/nginx/sbin/nginx
+	OpenRestyPathSubString = "/openresty/"
+)
+
+var (
+	processNames = []string{"lua", "luajit", "resty", "openresty"}
+
+	// lua5.4
+	luaExeVersionRe = regexp.MustCompile(`^lua(\d+\.\d+)$`)
+
+	// /usr/lib/x86_64-linux-gnu/liblua5.4.so.0.0.0
+	// /usr/local/openresty/luajit/lib/libluajit-5.1.so.2.1.0
+	luaSoVersionRe = regexp.MustCompile(`liblua(?:jit-)?(\d+\.\d+)`)
+)
+
+// IsOpenResty reports whether the process is the OpenResty flavor of nginx.
+func IsOpenResty(pcx *process.ProcessContext) bool {
+	return strings.Contains(pcx.ExePath, OpenRestyPathSubString)
+}
+
+func (n *LuaInspector) QuickScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	if IsOpenResty(pcx) || utils.IsProcessEqualProcessNamesWithVersion(pcx, processNames) {
+		return common.LuaProgrammingLanguage, true
+	}
+
+	return "", false
+}
+
+// DeepScan does not detect lua from the mapped liblua/libluajit, since many C/C++ hosts embed the interpreter
+// (e.g. nginx with the lua module, vim, redis) without being lua applications.
+// The mapped library is only used as a source for the runtime version.
+func (n *LuaInspector) DeepScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	return "", false
+}
+
+func (n *LuaInspector) GetRuntimeVersion(pcx *process.ProcessContext) string {
+	if m := luaExeVersionRe.FindStringSubmatch(filepath.Base(pcx.ExePath)); len(m) > 1 {
+		return m[1]
+	}
+
+	mapsFile, err := pcx.GetMapsFile()
+	if err != nil {
+		return ""
+	}
+	scanner := bufio.NewScanner(mapsFile)
+	for scanner.Scan() {
+		if m := luaSoVersionRe.FindStringSubmatch(scanner.Text()); len(m) > 1 {
+			return m[1]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,75 @@
+package lua
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+func TestQuickScan(t *testing.T) {
+	tests := []struct {
+		name     string
+		details  process.Details
+		expected bool
+	}{
+		{
+			name:     "lua interpreter",
+			details:  process.Details{ExePath: "/usr/bin/lua", CmdLine: "lua\x00app.lua"},
+			expected: true,
+		},
+		{
+			name:     "versioned lua interpreter",
+			details:  process.Details{ExePath: "/usr/bin/lua5.4", CmdLine: "lua5.4\x00app.lua"},
+			expected: true,
+		},
+		{
+			name:     "luajit",
+			details:  process.Details{ExePath: "/usr/local/bin/luajit", CmdLine: "luajit\x00app.lua"},
+			expected: true,
+		},
+		{
+			name:     "openresty nginx",
+			details:  process.Details{ExePath: "/usr/local/openresty/nginx/sbin/nginx", CmdLine: "nginx: master process /usr/local/openresty/nginx/sbin/nginx"},
+			expected: true,
+		},
+		{
+			name:     "plain nginx",
+			details:  process.Details{ExePath: "/usr/sbin/nginx", CmdLine: "nginx: master process /usr/sbin/nginx"},
+			expected: false,
+		},
+		{
+			name:     "executable with a lua prefix",
+			details:  process.Details{ExePath: "/usr/bin/luarocks", CmdLine: "luarocks\x00install\x00busted"},
+			expected: false,
+		},
+	}
+
+	inspector := &LuaInspector{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang, detected := inspector.QuickScan(process.NewProcessContext(tt.details))
+			if detected != tt.expected {
+				t.Fatalf("QuickScan() detected = %v; want %v", detected, tt.expected)
+			}
+			if detected && lang != common.LuaProgrammingLanguage {
+				t.Fatalf("QuickScan() language = %s; want %s", lang, common.LuaProgrammingLanguage)
+			}
+		})
+	}
+}
+
+func TestDeepScanWithoutMapsFile(t *testing.T) {
+	inspector := &LuaInspector{}
+	if _, detected := inspector.DeepScan(process.NewProcessContext(process.Details{ProcessID: -1})); detected {
+		t.Errorf("DeepScan() detected a process without a maps file")
+	}
+}
+
+func TestGetRuntimeVersionFromExe(t *testing.T) {
+	inspector := &LuaInspector{}
+	pcx := process.NewProcessContext(process.Details{ExePath: "/usr/bin/lua5.4"})
+	if v := inspector.GetRuntimeVersion(pcx); v != "5.4" {
+		t.Errorf("GetRuntimeVersion() = %q; want %q", v, "5.4")
+	}
+}
@@ -0,0 +1,36 @@
+package memcached
+
+import (
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/utils"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+// This is an experimental feature, It is not a language
+// but in order to avoid huge refactoring we are adding it as a language for now
+type MemcachedInspector struct{}
+
+var (
+	processNames = []string{"memcached"}
+)
+
+func (n *MemcachedInspector) QuickScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	if utils.IsProcessEqualProcessNames(pcx, processNames) {
+		return common.MemcachedProgrammingLanguage, true
+	}
+
+	return "", false
+}
+
+func (n *MemcachedInspector) DeepScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	return "", false
+}
+
+func (n *MemcachedInspector) GetRuntimeVersion(pcx *process.ProcessContext) string {
+	// set by the official memcached images
+	if value, exists := pcx.GetDetailedEnvsValue(process.MemcachedVersionConst); exists {
+		return value
+	}
+
+	return ""
+}
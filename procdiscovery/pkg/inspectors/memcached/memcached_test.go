@@ -0,0 +1,45 @@
+package memcached
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+func TestQuickScan(t *testing.T) {
+	tests := []struct {
+		name     string
+		details  process.Details
+		expected bool
+	}{
+		{
+			name:     "memcached server",
+			details:  process.Details{ExePath: "/usr/local/bin/memcached", CmdLine: "memcached\x00-m\x0064"},
+			expected: true,
+		},
+		{
+			name:     "memcached exporter",
+			details:  process.Details{ExePath: "/bin/memcached_exporter", CmdLine: "memcached_exporter\x00--memcached.address=localhost:11211"},
+			expected: false,
+		},
+		{
+			name:     "application with memcached in its arguments",
+			details:  process.Details{ExePath: "/usr/bin/python3", CmdLine: "python3\x00-m\x00memcached"},
+			expected: false,
+		},
+	}
+
+	inspector := &MemcachedInspector{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang, detected := inspector.QuickScan(process.NewProcessContext(tt.details))
+			if detected != tt.expected {
+				t.Fatalf("QuickScan() detected = %v; want %v", detected, tt.expected)
+			}
+			if detected && lang != common.MemcachedProgrammingLanguage {
+				t.Fatalf("QuickScan() language = %s; want %s", lang, common.MemcachedProgrammingLanguage)
+			}
+		})
+	}
+}
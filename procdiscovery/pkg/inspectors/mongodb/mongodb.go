@@ -0,0 +1,37 @@
+package mongodb
+
+import (
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/utils"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+// This is an experimental feature, It is not a language
+// but in order to avoid huge refactoring we are adding it as a language for now
+type MongoDBInspector struct{}
+
+var (
+	// mongod is the database server, mongos is the sharded cluster router.
+	processNames = []string{"mongod", "mongos"}
+)
+
+func (n *MongoDBInspector) QuickScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	if utils.IsProcessEqualProcessNames(pcx, processNames) {
+		return common.MongoDBProgrammingLanguage, true
+	}
+
+	return "", false
+}
+
+func (n *MongoDBInspector) DeepScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	return "", false
+}
+
+func (n *MongoDBInspector) GetRuntimeVersion(pcx *process.ProcessContext) string {
+	// set by the official mongo images
+	if value, exists := pcx.GetDetailedEnvsValue(process.MongoVersionConst); exists {
+		return value
+	}
+
+	return ""
+}
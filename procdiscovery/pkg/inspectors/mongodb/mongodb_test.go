@@ -0,0 +1,61 @@
+package mongodb
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+func TestQuickScan(t *testing.T) {
+	tests := []struct {
+		name     string
+		details  process.Details
+		expected bool
+	}{
+		{
+			name:     "database server",
+			details:  process.Details{ExePath: "/usr/bin/mongod", CmdLine: "mongod\x00--bind_ip_all"},
+			expected: true,
+		},
+		{
+			name:     "sharded cluster router",
+			details:  process.Details{ExePath: "/usr/bin/mongos", CmdLine: "mongos\x00--configdb\x00cfg/cfg-0:27019"},
+			expected: true,
+		},
+		{
+			name:     "mongo shell",
+			details:  process.Details{ExePath: "/usr/bin/mongosh", CmdLine: "mongosh\x00mongodb://db:27017"},
+			expected: false,
+		},
+		{
+			name:     "application connecting to mongodb",
+			details:  process.Details{ExePath: "/usr/local/bin/node", CmdLine: "node\x00/app/mongod-sync.js"},
+			expected: false,
+		},
+	}
+
+	inspector := &MongoDBInspector{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang, detected := inspector.QuickScan(process.NewProcessContext(tt.details))
+			if detected != tt.expected {
+				t.Fatalf("QuickScan() detected = %v; want %v", detected, tt.expected)
+			}
+			if detected && lang != common.MongoDBProgrammingLanguage {
+				t.Fatalf("QuickScan() language = %s; want %s", lang, common.MongoDBProgrammingLanguage)
+			}
+		})
+	}
+}
+
+func TestGetRuntimeVersion(t *testing.T) {
+	pcx := process.NewProcessContext(process.Details{
+		ExePath:      "/usr/bin/mongod",
+		Environments: process.ProcessEnvs{DetailedEnvs: map[string]string{process.MongoVersionConst: "7.0.12"}},
+	})
+	inspector := &MongoDBInspector{}
+	if v := inspector.GetRuntimeVersion(pcx); v != "7.0.12" {
+		t.Errorf("GetRuntimeVersion() = %q; want %q", v, "7.0.12")
+	}
+}
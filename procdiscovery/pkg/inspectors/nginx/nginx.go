@@ -4,6 +4,7 @@ import (
 	"path/filepath"
 
 	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/lua"
 	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
 )
 
@@ -18,7 +19,8 @@ const (
 
 func (j *NginxInspector) QuickScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
 	p := pcx.Details
-	if filepath.Base(p.ExePath) == NginxProcessName {
+	// OpenResty is also an nginx binary, but it is detected by the lua inspector
+	if filepath.Base(p.ExePath) == NginxProcessName && !lua.IsOpenResty(pcx) {
 		return common.NginxProgrammingLanguage, true
 	}
 
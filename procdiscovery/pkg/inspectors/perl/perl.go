@@ -0,0 +1,63 @@
+package perl
+
+import (
+	"bufio"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/utils"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+type PerlInspector struct{}
+
+var (
+	processNames = []string{"perl"}
+
+	// perl5.36.0
+	perlExeVersionRe = regexp.MustCompile(`^perl(\d+\.\d+\.\d+)$`)
+
+	// /usr/lib/x86_64-linux-gnu/perl/5.36.0/auto/POSIX/POSIX.so
+	// /usr/lib/x86_64-linux-gnu/libperl.so.5.36.0
+	perlMapsVersionRe = regexp.MustCompile(`(?:/perl5?/|libperl\.so\.)(\d+\.\d+\.\d+)`)
+)
+
+func (n *PerlInspector) QuickScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	if utils.IsProcessEqualProcessNamesWithVersion(pcx, processNames) {
+		return common.PerlProgrammingLanguage, true
+	}
+
+	return "", false
+}
+
+// DeepScan does not detect perl from the mapped libperl.so, since C/C++ hosts which embed the interpreter
+// (e.g. vim, or apache with mod_perl) are not perl applications.
+// The mapped library is only used as a source for the runtime version.
+func (n *PerlInspector) DeepScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	return "", false
+}
+
+func (n *PerlInspector) GetRuntimeVersion(pcx *process.ProcessContext) string {
+	if m := perlExeVersionRe.FindStringSubmatch(filepath.Base(pcx.ExePath)); len(m) > 1 {
+		return m[1]
+	}
+
+	mapsFile, err := pcx.GetMapsFile()
+	if err != nil {
+		return ""
+	}
+	scanner := bufio.NewScanner(mapsFile)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.Contains(line, "perl") {
+			continue
+		}
+		if m := perlMapsVersionRe.FindStringSubmatch(line); len(m) > 1 {
+			return m[1]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,65 @@
+package perl
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+func TestQuickScan(t *testing.T) {
+	tests := []struct {
+		name     string
+		details  process.Details
+		expected bool
+	}{
+		{
+			name:     "perl interpreter",
+			details:  process.Details{ExePath: "/usr/bin/perl", CmdLine: "perl\x00/app/server.pl"},
+			expected: true,
+		},
+		{
+			name:     "versioned perl interpreter",
+			details:  process.Details{ExePath: "/usr/bin/perl5.36.0", CmdLine: "perl5.36.0\x00/app/server.pl"},
+			expected: true,
+		},
+		{
+			name:     "executable with a perl prefix",
+			details:  process.Details{ExePath: "/usr/bin/perldoc", CmdLine: "perldoc\x00perlfunc"},
+			expected: false,
+		},
+		{
+			name:     "perl script path in the cmdline of another interpreter",
+			details:  process.Details{ExePath: "/usr/bin/python3", CmdLine: "python3\x00/app/perl/run.py"},
+			expected: false,
+		},
+	}
+
+	inspector := &PerlInspector{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang, detected := inspector.QuickScan(process.NewProcessContext(tt.details))
+			if detected != tt.expected {
+				t.Fatalf("QuickScan() detected = %v; want %v", detected, tt.expected)
+			}
+			if detected && lang != common.PerlProgrammingLanguage {
+				t.Fatalf("QuickScan() language = %s; want %s", lang, common.PerlProgrammingLanguage)
+			}
+		})
+	}
+}
+
+func TestDeepScanWithoutMapsFile(t *testing.T) {
+	inspector := &PerlInspector{}
+	if _, detected := inspector.DeepScan(process.NewProcessContext(process.Details{ProcessID: -1})); detected {
+		t.Errorf("DeepScan() detected a process without a maps file")
+	}
+}
+
+func TestGetRuntimeVersionFromExe(t *testing.T) {
+	inspector := &PerlInspector{}
+	pcx := process.NewProcessContext(process.Details{ExePath: "/usr/bin/perl5.36.0"})
+	if v := inspector.GetRuntimeVersion(pcx); v != "5.36.0" {
+		t.Errorf("GetRuntimeVersion() = %q; want %q", v, "5.36.0")
+	}
+}
@@ -0,0 +1,32 @@
+package rabbitmq
+
+import (
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/erlang"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+// This is an experimental feature, It is not a language
+// but in order to avoid huge refactoring we are adding it as a language for now
+type RabbitMQInspector struct{}
+
+func (n *RabbitMQInspector) QuickScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	if erlang.IsBeamProcess(pcx) && erlang.IsRabbitMQProcess(pcx) {
+		return common.RabbitMQProgrammingLanguage, true
+	}
+
+	return "", false
+}
+
+func (n *RabbitMQInspector) DeepScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	return "", false
+}
+
+func (n *RabbitMQInspector) GetRuntimeVersion(pcx *process.ProcessContext) string {
+	// set by the official rabbitmq images
+	if value, exists := pcx.GetDetailedEnvsValue(process.RabbitMQVersionConst); exists {
+		return value
+	}
+
+	return ""
+}
@@ -0,0 +1,58 @@
+package rabbitmq
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+const beamPath = "/usr/local/lib/erlang/erts-14.2.1/bin/beam.smp"
+
+func TestQuickScan(t *testing.T) {
+	tests := []struct {
+		name     string
+		details  process.Details
+		expected bool
+	}{
+		{
+			name:     "rabbitmq node",
+			details:  process.Details{ExePath: beamPath, CmdLine: "beam.smp\x00-W\x00w\x00-pa\x00/opt/rabbitmq/ebin\x00-s\x00rabbit\x00boot"},
+			expected: true,
+		},
+		{
+			name:     "erlang application with rabbitmq in its paths",
+			details:  process.Details{ExePath: beamPath, CmdLine: "beam.smp\x00-pa\x00/app/deps/rabbitmq_client/ebin\x00-s\x00orders"},
+			expected: false,
+		},
+		{
+			name:     "script mentioning rabbitmq",
+			details:  process.Details{ExePath: "/bin/bash", CmdLine: "bash\x00/scripts/wait-for-rabbitmq.sh\x00-s\x00rabbit"},
+			expected: false,
+		},
+	}
+
+	inspector := &RabbitMQInspector{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang, detected := inspector.QuickScan(process.NewProcessContext(tt.details))
+			if detected != tt.expected {
+				t.Fatalf("QuickScan() detected = %v; want %v", detected, tt.expected)
+			}
+			if detected && lang != common.RabbitMQProgrammingLanguage {
+				t.Fatalf("QuickScan() language = %s; want %s", lang, common.RabbitMQProgrammingLanguage)
+			}
+		})
+	}
+}
+
+func TestGetRuntimeVersion(t *testing.T) {
+	pcx := process.NewProcessContext(process.Details{
+		ExePath:      beamPath,
+		Environments: process.ProcessEnvs{DetailedEnvs: map[string]string{process.RabbitMQVersionConst: "3.13.2"}},
+	})
+	inspector := &RabbitMQInspector{}
+	if v := inspector.GetRuntimeVersion(pcx); v != "3.13.2" {
+		t.Errorf("GetRuntimeVersion() = %q; want %q", v, "3.13.2")
+	}
+}
@@ -0,0 +1,65 @@
+package swift
+
+import (
+	"bufio"
+	"regexp"
+	"strings"
+
+	"github.com/odigos-io/odigos/common"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/inspectors/utils"
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+type SwiftInspector struct{}
+
+var (
+	// swift compiles to native code. unless the standard library is linked statically,
+	// the swift runtime is loaded as a shared library.
+	binaries = []string{"libswiftCore.so"}
+
+	// SWIFT_VERSION=swift-5.10.1-RELEASE
+	// /opt/swift-5.10.1-RELEASE-ubuntu22.04/usr/lib/swift/linux/libswiftCore.so
+	swiftVersionRe = regexp.MustCompile(`swift-(\d+\.\d+(?:\.\d+)?)`)
+)
+
+func (n *SwiftInspector) QuickScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	return "", false
+}
+
+func (n *SwiftInspector) DeepScan(pcx *process.ProcessContext) (common.ProgrammingLanguage, bool) {
+	mapsFile, err := pcx.GetMapsFile()
+	if err != nil {
+		return "", false
+	}
+
+	if utils.IsMapsFileContainsBinary(mapsFile, binaries) {
+		return common.SwiftProgrammingLanguage, true
+	}
+
+	return "", false
+}
+
+func (n *SwiftInspector) GetRuntimeVersion(pcx *process.ProcessContext) string {
+	if value, exists := pcx.GetDetailedEnvsValue(process.SwiftVersionConst); exists {
+		if m := swiftVersionRe.FindStringSubmatch(value); len(m) > 1 {
+			return m[1]
+		}
+	}
+
+	mapsFile, err := pcx.GetMapsFile()
+	if err != nil {
+		return ""
+	}
+	scanner := bufio.NewScanner(mapsFile)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.Contains(line, "libswiftCore.so") {
+			continue
+		}
+		if m := swiftVersionRe.FindStringSubmatch(line); len(m) > 1 {
+			return m[1]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,44 @@
+package swift
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/procdiscovery/pkg/process"
+)
+
+func TestScanWithoutSwiftRuntime(t *testing.T) {
+	inspector := &SwiftInspector{}
+	// swift binaries are native executables, and are only recognized by the swift runtime library they load
+	pcx := process.NewProcessContext(process.Details{ProcessID: -1, ExePath: "/app/.build/release/Server", CmdLine: "/app/.build/release/Server"})
+	if _, detected := inspector.QuickScan(pcx); detected {
+		t.Errorf("QuickScan() detected a swift process by its executable")
+	}
+	if _, detected := inspector.DeepScan(pcx); detected {
+		t.Errorf("DeepScan() detected a process without a maps file")
+	}
+}
+
+func TestGetRuntimeVersionFromEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{name: "release", value: "swift-5.10.1-RELEASE", expected: "5.10.1"},
+		{name: "minor release", value: "swift-6.0-RELEASE", expected: "6.0"},
+		{name: "unrecognized value", value: "latest", expected: ""},
+	}
+
+	inspector := &SwiftInspector{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pcx := process.NewProcessContext(process.Details{
+				ProcessID:    -1,
+				Environments: process.ProcessEnvs{DetailedEnvs: map[string]string{process.SwiftVersionConst: tt.value}},
+			})
+			if v := inspector.GetRuntimeVersion(pcx); v != tt.expected {
+				t.Errorf("GetRuntimeVersion() = %q; want %q", v, tt.expected)
+			}
+		})
+	}
+}
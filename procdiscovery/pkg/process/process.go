@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/odigos-io/odigos/common/otheragent"
 )
@@ -19,6 +20,13 @@ const (
 	JavaHomeConst      = "JAVA_HOME"
 	PhpVersionConst    = "PHP_VERSION"
 	RubyVersionConst   = "RUBY_VERSION"
+	OtpVersionConst    = "OTP_VERSION"
+	ElixirVersionConst = "ELIXIR_VERSION"
+	SwiftVersionConst  = "SWIFT_VERSION"
+
+	MongoVersionConst     = "MONGO_VERSION"
+	RabbitMQVersionConst  = "RABBITMQ_VERSION"
+	MemcachedVersionConst = "MEMCACHED_VERSION"
 )
 
 const (
@@ -48,6 +56,32 @@ var LangsVersionEnvs = map[string]struct{}{
 	JavaHomeConst:      {},
 	PhpVersionConst:    {},
 	RubyVersionConst:   {},
+	OtpVersionConst:    {},
+	ElixirVersionConst: {},
+	SwiftVersionConst:  {},
+
+	MongoVersionConst:     {},
+	RabbitMQVersionConst:  {},
+	MemcachedVersionConst: {},
+}
+
+var (
+	customDetectionEnvsMu sync.RWMutex
+	customDetectionEnvs   map[string]struct{}
+)
+
+// SetCustomDetectionEnvs sets additional environment variables to collect into DetailedEnvs,
+// on top of LangsVersionEnvs. It is used by inspectors which are configured at runtime.
+func SetCustomDetectionEnvs(envs map[string]struct{}) {
+	customDetectionEnvsMu.Lock()
+	defer customDetectionEnvsMu.Unlock()
+	customDetectionEnvs = envs
+}
+
+func getCustomDetectionEnvs() map[string]struct{} {
+	customDetectionEnvsMu.RLock()
+	defer customDetectionEnvsMu.RUnlock()
+	return customDetectionEnvs
 }
 
 type Details struct {
@@ -296,6 +330,7 @@ func getRelevantEnvVars(pid int, runtimeDetectionEnvs map[string]struct{}) Proce
 
 	overWriteEnvsResult := make(map[string]string)
 	detailedEnvsResult := make(map[string]string)
+	customEnvs := getCustomDetectionEnvs()
 
 	for {
 		// The entries are  separated  by
@@ -326,6 +361,10 @@ func getRelevantEnvVars(pid int, runtimeDetectionEnvs map[string]struct{}) Proce
 			detailedEnvsResult[envName] = envDetectionValue
 		}
 
+		if _, ok := customEnvs[envName]; ok {
+			detailedEnvsResult[envName] = envDetectionValue
+		}
+
 		// Collect the keys the shared other-agent detector inspects, so detection
 		// can read them off the process env without any caller wiring.
 		if _, ok := otheragent.AgentDetectionEnvKeys[envName]; ok {
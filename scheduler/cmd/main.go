@@ -141,6 +141,9 @@ func main() {
 				&odigosv1.Sampling{}: {
 					Field: nsSelector,
 				},
+				&odigosv1.ServiceLevelObjective{}: {
+					Field: nsSelector,
+				},
 			},
 		},
 		HealthProbeBindAddress: probeAddr,
@@ -231,7 +231,10 @@ func updateMetricsSettingsForDestination(metricsConfig *odigosv1.CollectorsGroup
 // getOwnMetricsSettings returns the own metrics settings for the node collector group.
 // Returns nil if own metrics collection is disabled.
 // The node collector only needs the interval since the cluster collector handles routing to destinations.
-func getOwnMetricsSettings(odigosConfiguration *common.OdigosConfiguration) *odigosv1.OdigosOwnMetricsSettings {
+// Span metrics are also sent to the metrics store when anomaly detection or service level objectives read them from there.
+// The kubelet stats of the workloads and the runtime metrics of the agents are sent to the metrics store
+// when the agent overhead is measured from them.
+func getOwnMetricsSettings(odigosConfiguration *common.OdigosConfiguration, hasServiceLevelObjectives bool) *odigosv1.OdigosOwnMetricsSettings {
 	ownMetricsEnabled := odigosConfiguration.OdigosOwnTelemetryStore == nil ||
 		odigosConfiguration.OdigosOwnTelemetryStore.MetricsStoreDisabled == nil ||
 		!*odigosConfiguration.OdigosOwnTelemetryStore.MetricsStoreDisabled
@@ -247,11 +250,14 @@ func getOwnMetricsSettings(odigosConfiguration *common.OdigosConfiguration) *odi
 	}
 
 	return &odigosv1.OdigosOwnMetricsSettings{
-		Interval: ownMetricsInterval,
+		Interval:                            ownMetricsInterval,
+		SendSpanMetricsToOdigosMetricsStore: common.AnomalyDetectionActive(odigosConfiguration.OdigosOwnTelemetryStore) || hasServiceLevelObjectives,
+		SendWorkloadsKubeletStatsToOdigosMetricsStore: common.AgentOverheadAccountingActive(odigosConfiguration.OdigosOwnTelemetryStore),
+		SendAgentRuntimeMetricsToOdigosMetricsStore:   common.AgentOverheadAccountingActive(odigosConfiguration.OdigosOwnTelemetryStore),
 	}
 }
 
-func newNodeCollectorGroup(odigosConfiguration common.OdigosConfiguration, allDestinations odigosv1.DestinationList) *odigosv1.CollectorsGroup {
+func newNodeCollectorGroup(odigosConfiguration common.OdigosConfiguration, allDestinations odigosv1.DestinationList, hasServiceLevelObjectives bool) *odigosv1.CollectorsGroup {
 
 	var metricsConfig *odigosv1.CollectorsGroupMetricsCollectionSettings
 
@@ -279,7 +285,7 @@ func newNodeCollectorGroup(odigosConfiguration common.OdigosConfiguration, allDe
 		updateMetricsSettingsForDestination(metricsConfig, &odigosConfiguration, destination, destinationTypeManifest)
 	}
 
-	ownMetricsSettings := getOwnMetricsSettings(&odigosConfiguration)
+	ownMetricsSettings := getOwnMetricsSettings(&odigosConfiguration, hasServiceLevelObjectives)
 	if ownMetricsSettings != nil {
 		if metricsConfig == nil {
 			metricsConfig = &odigosv1.CollectorsGroupMetricsCollectionSettings{}
@@ -358,7 +364,13 @@ func sync(ctx context.Context, c client.Client, scheme *runtime.Scheme) error {
 		return err // list will return empty list if no destinations are found and not error
 	}
 
-	nodeCollectorGroup := newNodeCollectorGroup(odigosConfiguration, allDestinations)
+	slos := odigosv1.ServiceLevelObjectiveList{}
+	err = c.List(ctx, &slos, client.InNamespace(namespace))
+	if err != nil {
+		return err
+	}
+
+	nodeCollectorGroup := newNodeCollectorGroup(odigosConfiguration, allDestinations, len(slos.Items) > 0)
 	err = utils.SetOwnerControllerToSchedulerDeployment(ctx, c, nodeCollectorGroup, scheme)
 	if err != nil {
 		return err
@@ -59,5 +59,18 @@ func SetupWithManager(mgr ctrl.Manager) error {
 		return err
 	}
 
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&odigosv1.ServiceLevelObjective{}).
+		Named("nodecollectorgroup-servicelevelobjectives").
+		// span metrics are sent to the odigos metrics store while any objective exists
+		WithEventFilter(&odigospredicates.ExistencePredicate{}).
+		Complete(&ServiceLevelObjectivesReconciler{
+			Client: mgr.GetClient(),
+			Scheme: mgr.GetScheme(),
+		})
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
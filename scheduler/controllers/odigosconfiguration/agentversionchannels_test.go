@@ -0,0 +1,41 @@
+package odigosconfiguration
+
+import (
+	"testing"
+
+	"github.com/odigos-io/odigos/common"
+)
+
+func TestResolveAgentVersionChannels(t *testing.T) {
+	config := &common.OdigosConfiguration{
+		AgentVersionChannels: map[common.AgentVersionChannel]common.AgentVersionChannelConfiguration{
+			common.StableAgentVersionChannel:   {Version: "v1.11.0", Image: "registry.odigos.io/odigos-agents:v1.11.0"},
+			common.PreviousAgentVersionChannel: {Version: "v1.10.0", Image: "registry.odigos.io/odigos-agents:v1.10.0"},
+			common.CanaryAgentVersionChannel:   {Version: "v1.12.0"},
+			"nightly":                          {Version: "v1.13.0", Image: "registry.odigos.io/odigos-agents:v1.13.0"},
+		},
+	}
+
+	resolveAgentVersionChannels(config)
+
+	if len(config.AgentVersionChannels) != 1 {
+		t.Fatalf("expected only the previous channel to be kept, got %v", config.AgentVersionChannels)
+	}
+	if config.AgentVersionChannels[common.PreviousAgentVersionChannel].Version != "v1.10.0" {
+		t.Errorf("previous channel = %+v, want version v1.10.0", config.AgentVersionChannels[common.PreviousAgentVersionChannel])
+	}
+}
+
+func TestResolveAgentVersionChannels_NoServableChannels(t *testing.T) {
+	config := &common.OdigosConfiguration{
+		AgentVersionChannels: map[common.AgentVersionChannel]common.AgentVersionChannelConfiguration{
+			common.CanaryAgentVersionChannel: {Version: "v1.12.0"},
+		},
+	}
+
+	resolveAgentVersionChannels(config)
+
+	if config.AgentVersionChannels != nil {
+		t.Errorf("expected no channels, got %v", config.AgentVersionChannels)
+	}
+}
@@ -133,6 +133,7 @@ func (r *odigosConfigurationController) Reconcile(ctx context.Context, _ ctrl.Re
 	// I want to preserve that user input (specific request or empty), and persist the resolved value in effective config.
 	resolveMountMethod(&odigosConfiguration)
 	resolveEnvInjectionMethod(&odigosConfiguration)
+	resolveAgentVersionChannels(&odigosConfiguration)
 
 	err = verifyMetricsConfig(&odigosConfiguration)
 	if err != nil {
@@ -230,6 +231,10 @@ func mergeConfigs(baseConfig *common.OdigosConfiguration, addtionalConfig *commo
 		baseConfig.AgentEnvVarsInjectionMethod = addtionalConfig.AgentEnvVarsInjectionMethod
 	}
 
+	if addtionalConfig.ImageVolumeEnabled != nil {
+		baseConfig.ImageVolumeEnabled = addtionalConfig.ImageVolumeEnabled
+	}
+
 	if addtionalConfig.CheckDeviceHealthBeforeInjection != nil {
 		baseConfig.CheckDeviceHealthBeforeInjection = addtionalConfig.CheckDeviceHealthBeforeInjection
 	}
@@ -677,6 +682,10 @@ func resolveMountMethod(odigosConfiguration *common.OdigosConfiguration) {
 		return
 	case common.K8sCsiDriverMountMethod:
 		return
+	case common.K8sImageVolumeMountMethod:
+		// the init container resources are used when the pods webhook falls back to k8s-init-container
+		odigosConfiguration.AgentsInitContainerResources = getInitContainerResources(odigosConfiguration)
+		return
 	default:
 		// any illegal value will be defaulted to host-path
 		// TODO: emit some error here and think how to handle it
@@ -684,6 +693,30 @@ func resolveMountMethod(odigosConfiguration *common.OdigosConfiguration) {
 	}
 }
 
+// resolveAgentVersionChannels keeps only the agent version channels which can be served.
+// the stable channel is always the installed odigos version and cannot be configured,
+// and a channel without an image has no agents to inject, so workloads selecting it use the stable channel.
+func resolveAgentVersionChannels(odigosConfig *common.OdigosConfiguration) {
+	if len(odigosConfig.AgentVersionChannels) == 0 {
+		odigosConfig.AgentVersionChannels = nil
+		return
+	}
+
+	channels := make(map[common.AgentVersionChannel]common.AgentVersionChannelConfiguration, len(odigosConfig.AgentVersionChannels))
+	for channel, channelConfig := range odigosConfig.AgentVersionChannels {
+		switch channel {
+		case common.PreviousAgentVersionChannel, common.CanaryAgentVersionChannel:
+			if channelConfig.Image != "" {
+				channels[channel] = channelConfig
+			}
+		}
+	}
+	if len(channels) == 0 {
+		channels = nil
+	}
+	odigosConfig.AgentVersionChannels = channels
+}
+
 func resolveEnvInjectionMethod(odigosConfig *common.OdigosConfiguration) {
 	defaultInjectionMethod := common.LoaderFallbackToPodManifestInjectionMethod
 
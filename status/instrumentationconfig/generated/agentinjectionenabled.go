@@ -38,6 +38,7 @@ const (
 	AgentEnabledReasonRuntimeDetailsUnavailable      AgentEnabledReason = "RuntimeDetailsUnavailable"
 	AgentEnabledReasonCrashLoopBackOff               AgentEnabledReason = "CrashLoopBackOff"
 	AgentEnabledReasonImagePullBackOff               AgentEnabledReason = "ImagePullBackOff"
+	AgentEnabledReasonRollbackFallbackToEbpf         AgentEnabledReason = "RollbackFallbackToEbpf"
 )
 
 var (
@@ -188,6 +189,15 @@ var (
 		K8sConditionStatus: metav1.ConditionFalse,
 		OdigosSeverity:     status.OdigosSeverityNotice,
 	})
+	AgentEnabledRollbackFallbackToEbpf = status.WithMessageTemplate(status.Reason{
+		Name:               string(AgentEnabledReasonRollbackFallbackToEbpf),
+		Title:              "Rolled Back to eBPF Instrumentation",
+		Summary:            "The language SDK agent was rolled back after pods crashed, and the source is instrumented with eBPF instead.",
+		Description:        "After instrumentation was applied, pods entered CrashLoopBackOff or ImagePullBackOff.\nSince the eBPF fallback is enabled, Odigos stopped injecting the language SDK agent and\ninstruments the source with the OpenTelemetry eBPF Instrumentation distro, which does not\nrequire any in-process injection. Investigate the pod failures, then recover from rollback\nto retry the SDK agent.\n",
+		Message:            "pods entered backoff with the SDK agent; falling back to eBPF instrumentation",
+		K8sConditionStatus: metav1.ConditionTrue,
+		OdigosSeverity:     status.OdigosSeverityNotice,
+	})
 
 	AgentEnabledByReason = map[string]status.Reason{
 		string(AgentEnabledReasonEnabledSuccessfully):            AgentEnabledEnabledSuccessfully,
@@ -205,6 +215,7 @@ var (
 		string(AgentEnabledReasonRuntimeDetailsUnavailable):      AgentEnabledRuntimeDetailsUnavailable,
 		string(AgentEnabledReasonCrashLoopBackOff):               AgentEnabledCrashLoopBackOff,
 		string(AgentEnabledReasonImagePullBackOff):               AgentEnabledImagePullBackOff,
+		string(AgentEnabledReasonRollbackFallbackToEbpf):         AgentEnabledRollbackFallbackToEbpf,
 	}
 )
 